@@ -428,9 +428,14 @@ type GatewayProxy struct {
 	XdsServicePort                 *uint32                      `json:"xdsServicePort,omitempty" desc:"The k8s service port for the xds server. Defaults to the value from .Values.gloo.deployment.xdsPort, but can be overridden to use, for example, xds-relay."`
 	TcpKeepaliveTimeSeconds        *uint32                      `json:"tcpKeepaliveTimeSeconds,omitempty" desc:"The amount of time in seconds for connections to be idle before sending keep-alive probes. Defaults to 60. See here: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/address.proto#envoy-v3-api-msg-config-core-v3-tcpkeepalive"`
 	DisableCoreDumps               *bool                        `json:"disableCoreDumps,omitempty" desc:"If set to true, Envoy will not generate core dumps in the event of a crash. Defaults to false"`
+	ZoneAwareRouting               *ZoneAwareRouting            `json:"zoneAwareRouting,omitempty"`
 	*KubeResourceOverride
 }
 
+type ZoneAwareRouting struct {
+	Enabled *bool `json:"enabled,omitempty" desc:"Sets the zone of the node of each gateway proxy pod as its locality, and the pods of the gateway proxy as its local cluster, for the upstreams with preferSameZone to send the requests to their endpoints in the zone of the pod. Requires the gateway-proxy service to be discovered as an upstream, and localityFromNodeLabels in the kubernetes settings. Defaults to false"`
+}
+
 type GatewayProxyGatewaySettings struct {
 	Enabled                  *bool                  `json:"enabled,omitempty" desc:"enable/disable default gateways"`
 	DisableGeneratedGateways *bool                  `json:"disableGeneratedGateways,omitempty" desc:"set to true to disable the gateway generation for a gateway proxy"`
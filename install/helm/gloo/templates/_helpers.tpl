@@ -151,3 +151,20 @@ Returns the unique Gateway namespaces as defined by the helm values.
 {{- $proxyNamespaces = $proxyNamespaces | uniq -}}
 {{ toJson $proxyNamespaces }}
 {{- end -}}
+
+{{/*
+Returns the unique namespaces of the Gateway proxies with zone aware routing enabled, whose
+service accounts read the zone of their node.
+*/}}
+{{- define "gloo.zoneAwareRoutingNamespaces" -}}
+{{- $proxyNamespaces := list -}}
+{{- range $key, $gatewaySpec := .Values.gatewayProxies -}}
+  {{- $spec := deepCopy $gatewaySpec | mergeOverwrite (deepCopy $.Values.gatewayProxies.gatewayProxy) -}}
+  {{- if and (not $spec.disabled) $spec.zoneAwareRouting.enabled -}}
+    {{- $ns := $spec.namespace | default $.Release.Namespace -}}
+    {{- $proxyNamespaces = append $proxyNamespaces $ns -}}
+  {{- end -}}
+{{- end -}}
+{{- $proxyNamespaces = $proxyNamespaces | uniq -}}
+{{ toJson $proxyNamespaces }}
+{{- end -}}
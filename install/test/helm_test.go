@@ -30,6 +30,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	jobsv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -2782,6 +2783,55 @@ spec:
 							})
 						})
 
+						It("can enable zone aware routing", func() {
+							prepareMakefile(namespace, helmValues{
+								valuesArgs: []string{"gatewayProxies.gatewayProxy.zoneAwareRouting.enabled=true"},
+							})
+							deploymentUns := testManifest.ExpectCustomResource("Deployment", namespace, "gateway-proxy")
+							deploymentObject, err := kuberesource.ConvertUnstructured(deploymentUns)
+							Expect(err).NotTo(HaveOccurred())
+							structuredDeployment := deploymentObject.(*appsv1.Deployment)
+							Expect(structuredDeployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(v1.EnvVar{
+								Name: "NODE_NAME",
+								ValueFrom: &v1.EnvVarSource{
+									FieldRef: &v1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+								},
+							}))
+
+							envoyConfig := getConfigMap(testManifest, namespace, "gateway-proxy-envoy-config").Data["envoy.yaml"]
+							Expect(envoyConfig).To(ContainSubstring(`
+  locality:
+    zone: "{{.NodeZone}}"`))
+							Expect(envoyConfig).To(ContainSubstring(`
+  - name: gloo_local_cluster
+    connect_timeout: 5.000s
+    type: EDS
+    eds_cluster_config:
+      eds_config:
+        resource_api_version: V3
+        ads: {}
+        initial_fetch_timeout: 1s`))
+							Expect(envoyConfig).To(ContainSubstring(`
+cluster_manager:
+  local_cluster_name: gloo_local_cluster`))
+
+							testManifest.ExpectCustomResource("ClusterRole", "", "gateway-proxy-node-reader-"+namespace)
+							bindingUns := testManifest.ExpectCustomResource("ClusterRoleBinding", "", "gateway-proxy-node-reader-binding-"+namespace)
+							bindingObject, err := kuberesource.ConvertUnstructured(bindingUns)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(bindingObject.(*rbacv1.ClusterRoleBinding).Subjects).To(ConsistOf(rbacv1.Subject{
+								Kind:      "ServiceAccount",
+								Name:      "gateway-proxy",
+								Namespace: namespace,
+							}))
+						})
+
+						It("does not read the nodes without zone aware routing", func() {
+							prepareMakefile(namespace, helmValues{})
+							testManifest.ExpectUnstructured("ClusterRole", "", "gateway-proxy-node-reader-"+namespace).To(BeNil())
+							testManifest.ExpectUnstructured("ClusterRoleBinding", "", "gateway-proxy-node-reader-binding-"+namespace).To(BeNil())
+						})
+
 						It("can explicitly disable hostNetwork", func() {
 							daemonSet.Spec.Template.Spec.HostNetwork = false
 							prepareMakefile(namespace, helmValues{
@@ -118,6 +118,11 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -138,6 +143,11 @@ var _ = Describe("RBAC Test", func() {
 				Context("cluster scope", func() {
 					It("role", func() {
 						resourceBuilder.Name += "-" + namespace
+						resourceBuilder.Rules = append(resourceBuilder.Rules, rbacv1.PolicyRule{
+							APIGroups: []string{""},
+							Resources: []string{"nodes"},
+							Verbs:     []string{"get", "list", "watch"},
+						})
 						prepareMakefile("global.glooRbac.namespaced=false")
 						testManifest.ExpectClusterRole(resourceBuilder.GetClusterRole())
 					})
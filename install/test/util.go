@@ -12,6 +12,21 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	if namespace == "" {
+		// nodes are cluster-scoped, so they can only be watched by cluster-scoped gloo
+		permissions.AddExpectedPermission(
+			"gloo-system.gloo",
+			namespace,
+			[]string{""},
+			[]string{"nodes"},
+			[]string{"get", "list", "watch"})
+	}
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
@@ -76,6 +91,21 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	if namespace == "" {
+		// nodes are cluster-scoped, so they can only be watched by cluster-scoped gloo
+		permissions.AddExpectedPermission(
+			"gloo-system.discovery",
+			namespace,
+			[]string{""},
+			[]string{"nodes"},
+			[]string{"get", "list", "watch"})
+	}
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
@@ -3,6 +3,7 @@ package api_conversion
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	envoyroute_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/route"
@@ -126,6 +127,14 @@ func ToGlooHeader(header *envoy_config_route_v3.HeaderMatcher) *envoyroute_gloo.
 		h.HeaderMatchSpecifier = &envoyroute_gloo.HeaderMatcher_SuffixMatch{
 			SuffixMatch: specificHeaderSpecifier.SuffixMatch,
 		}
+	case *envoy_config_route_v3.HeaderMatcher_ContainsMatch:
+		h.HeaderMatchSpecifier = &envoyroute_gloo.HeaderMatcher_ContainsMatch{
+			ContainsMatch: specificHeaderSpecifier.ContainsMatch,
+		}
+	case *envoy_config_route_v3.HeaderMatcher_StringMatch:
+		h.HeaderMatchSpecifier = &envoyroute_gloo.HeaderMatcher_StringMatch{
+			StringMatch: ToGlooStringMatcher(specificHeaderSpecifier.StringMatch),
+		}
 	}
 	return h
 }
@@ -165,6 +174,19 @@ func ToGlooQueryParameterMatcher(queryParamMatcher *envoy_config_route_v3.QueryP
 			Value: true,
 		}
 	}
+
+	// matches which cannot be expressed as an exact or regex value are kept as string matchers
+	stringMatch := queryParamMatcher.GetStringMatch()
+	switch stringMatch.GetMatchPattern().(type) {
+	case *envoy_type_matcher_v3.StringMatcher_Prefix,
+		*envoy_type_matcher_v3.StringMatcher_Suffix,
+		*envoy_type_matcher_v3.StringMatcher_Contains:
+		qpm.StringMatch = ToGlooStringMatcher(stringMatch)
+	default:
+		if stringMatch.GetIgnoreCase() {
+			qpm.StringMatch = ToGlooStringMatcher(stringMatch)
+		}
+	}
 	return qpm
 }
 
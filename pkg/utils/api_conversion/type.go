@@ -2,8 +2,10 @@ package api_conversion
 
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	envoytype_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type"
+	envoymatcher_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -46,6 +48,41 @@ func ToEnvoyInt64Range(int64Range *envoytype_gloo.Int64Range) *envoy_type_v3.Int
 	}
 }
 
+func ToGlooStringMatcher(matcher *envoy_type_matcher_v3.StringMatcher) *envoymatcher_gloo.StringMatcher {
+	if matcher == nil {
+		return nil
+	}
+	m := &envoymatcher_gloo.StringMatcher{
+		MatchPattern: nil, // gets set later in function
+		IgnoreCase:   matcher.GetIgnoreCase(),
+	}
+	switch pattern := matcher.GetMatchPattern().(type) {
+	case *envoy_type_matcher_v3.StringMatcher_Exact:
+		m.MatchPattern = &envoymatcher_gloo.StringMatcher_Exact{
+			Exact: pattern.Exact,
+		}
+	case *envoy_type_matcher_v3.StringMatcher_Prefix:
+		m.MatchPattern = &envoymatcher_gloo.StringMatcher_Prefix{
+			Prefix: pattern.Prefix,
+		}
+	case *envoy_type_matcher_v3.StringMatcher_Suffix:
+		m.MatchPattern = &envoymatcher_gloo.StringMatcher_Suffix{
+			Suffix: pattern.Suffix,
+		}
+	case *envoy_type_matcher_v3.StringMatcher_Contains:
+		m.MatchPattern = &envoymatcher_gloo.StringMatcher_Contains{
+			Contains: pattern.Contains,
+		}
+	case *envoy_type_matcher_v3.StringMatcher_SafeRegex:
+		m.MatchPattern = &envoymatcher_gloo.StringMatcher_SafeRegex{
+			SafeRegex: &envoymatcher_gloo.RegexMatcher{
+				Regex: pattern.SafeRegex.GetRegex(),
+			},
+		}
+	}
+	return m
+}
+
 func ToEnvoyHeaderValueOptionList(option []*envoycore_sk.HeaderValueOption, secrets *v1.SecretList) ([]*envoy_config_core_v3.HeaderValueOption, error) {
 	result := make([]*envoy_config_core_v3.HeaderValueOption, 0)
 	var err error
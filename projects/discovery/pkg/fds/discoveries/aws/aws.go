@@ -6,11 +6,13 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/contextutils"
 
@@ -18,6 +20,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	awsutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/aws"
 )
 
@@ -27,28 +30,48 @@ const (
 	AWS_REGION                  = "AWS_REGION"
 )
 
-func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
+const (
+	DefaultPollingTime = time.Second
+
+	// the tags and aliases of functions take one request per function, so they are listed less often than the functions
+	functionMetadataRefreshInterval = time.Minute
+)
+
+func NewFunctionDiscoveryFactory(opts bootstrap.Opts) fds.FunctionDiscoveryFactory {
+	fdsOptions := opts.Settings.GetDiscovery().GetFdsOptions()
+	pollingTime := DefaultPollingTime
+	if interval := fdsOptions.GetAwsLambdaPollingInterval().AsDuration(); interval > 0 {
+		pollingTime = interval
+	}
 	return &AWSLambdaFunctionDiscoveryFactory{
-		PollingTime: time.Second,
+		PollingTime:    pollingTime,
+		LambdaEndpoint: fdsOptions.GetAwsLambdaEndpoint(),
 	}
 }
 
 // AWSLambdaFunctionDiscoveryFactory represents a factory for AWS Lambda function discovery.
 type AWSLambdaFunctionDiscoveryFactory struct {
 	PollingTime time.Duration
+	// overrides the endpoint of the region of the upstream, e.g. to use a local mock of AWS Lambda
+	LambdaEndpoint string
 }
 
 func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &AWSLambdaFunctionDiscovery{
-		timeToWait: f.PollingTime,
-		upstream:   u,
+		timeToWait:     f.PollingTime,
+		upstream:       u,
+		lambdaEndpoint: f.LambdaEndpoint,
+		metadata:       newFunctionMetadataCache(functionMetadataRefreshInterval),
 	}
 }
 
 // AWSLambdaFunctionDiscovery is a discovery that polls AWS Lambda for function discovery.
 type AWSLambdaFunctionDiscovery struct {
-	timeToWait time.Duration
-	upstream   *v1.Upstream
+	timeToWait     time.Duration
+	upstream       *v1.Upstream
+	lambdaEndpoint string
+	// the tags and aliases of the functions, kept between polls
+	metadata *functionMetadataCache
 }
 
 func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
@@ -107,12 +130,8 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		// only log other errors as we would like to continue forever.
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform aws function discovery for upstream %s in namespace %s, error: ",
-			f.upstream.GetMetadata().GetName(),
-			f.upstream.GetMetadata().GetNamespace(),
-			err.Error(),
-		)
+		// the updater records the error on the upstream and retries with a backoff
+		return err
 	}
 
 	// sleep so we are not hogging
@@ -134,11 +153,17 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 	if awsRegion == "" {
 		awsRegion = os.Getenv(AWS_REGION)
 	}
-	sess, err := awsutils.GetAwsSession(lambdaSpec.GetSecretRef(), secrets, &aws.Config{Region: aws.String(lambdaSpec.GetRegion())})
+	sess, err := awsutils.GetAwsSession(lambdaSpec.GetSecretRef(), secrets, &aws.Config{Region: aws.String(awsRegion)})
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create AWS session")
 	}
 
+	// the endpoint is only overridden for lambda, and not for the STS requests of the session
+	lambdaConfig := aws.NewConfig()
+	if f.lambdaEndpoint != "" {
+		lambdaConfig = lambdaConfig.WithEndpoint(f.lambdaEndpoint)
+	}
+
 	var svc *lambda.Lambda
 
 	tokenPath := os.Getenv(AWS_WEB_IDENTITY_TOKEN_FILE)
@@ -151,37 +176,166 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 		}
 		contextutils.LoggerFrom(ctx).Debugf("Discovering lambda functions using assumed role [%s]", roleArn)
 		webProvider := stscreds.NewWebIdentityCredentials(sess, roleArn, "", tokenPath)
-		svc = lambda.New(sess, aws.NewConfig().WithCredentials(webProvider))
+		svc = lambda.New(sess, lambdaConfig.WithCredentials(webProvider))
 	} else {
-		svc = lambda.New(sess)
+		svc = lambda.New(sess, lambdaConfig)
 	}
 
-	var newfunctions []*glooaws.LambdaFunctionSpec
+	return discoverFunctions(ctx, svc, lambdaSpec.GetFunctionDiscoveryOptions(), f.metadata)
+}
 
-	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
-	err = svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
+// discoverFunctions returns the latest version, the published versions and the aliases of the functions
+// selected by the options. The tags and aliases of the functions are taken from the cache when they are fresh.
+func discoverFunctions(ctx context.Context, svc lambdaiface.LambdaAPI, options *glooaws.LambdaFunctionDiscoveryOptions, cache *functionMetadataCache) ([]*glooaws.LambdaFunctionSpec, error) {
+	// the versions of the selected functions, in the order of the functions
+	var functionNames []string
+	versions := make(map[string][]string)
+	arns := make(map[string]string)
 
+	listInput := &lambda.ListFunctionsInput{FunctionVersion: aws.String(lambda.FunctionVersionAll)}
+	err := svc.ListFunctionsPagesWithContext(ctx, listInput, func(results *lambda.ListFunctionsOutput, _ bool) bool {
 		for _, f := range results.Functions {
-			version := aws.StringValue(f.Version)
 			name := aws.StringValue(f.FunctionName)
-
-			logicalName := fmt.Sprintf("%s:%s", name, version)
-			if version == "$LATEST" {
-				logicalName = name
+			if !strings.HasPrefix(name, options.GetFunctionNamePrefix()) {
+				continue
 			}
-
-			newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
-				LambdaFunctionName: name,
-				Qualifier:          version,
-				LogicalName:        logicalName,
-			})
+			if _, ok := versions[name]; !ok {
+				functionNames = append(functionNames, name)
+				arns[name] = unqualifiedArn(aws.StringValue(f.FunctionArn))
+			}
+			versions[name] = append(versions[name], aws.StringValue(f.Version))
 		}
-
 		return true
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
 	}
 
+	// forget the functions which no longer exist or are no longer selected
+	cache.retain(functionNames)
+
+	var newfunctions []*glooaws.LambdaFunctionSpec
+	for _, name := range functionNames {
+		metadata := cache.get(name, versions[name])
+
+		if len(options.GetTagSelector()) > 0 {
+			if metadata.tags == nil {
+				tags, err := svc.ListTagsWithContext(ctx, &lambda.ListTagsInput{Resource: aws.String(arns[name])})
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to get tags of function %s from AWS", name)
+				}
+				metadata.tags = tags.Tags
+				if metadata.tags == nil {
+					metadata.tags = map[string]*string{}
+				}
+			}
+			if !matchesTags(metadata.tags, options.GetTagSelector()) {
+				continue
+			}
+		}
+
+		if metadata.aliases == nil {
+			aliases := []string{}
+			aliasesInput := &lambda.ListAliasesInput{FunctionName: aws.String(name)}
+			err = svc.ListAliasesPagesWithContext(ctx, aliasesInput, func(results *lambda.ListAliasesOutput, _ bool) bool {
+				for _, alias := range results.Aliases {
+					aliases = append(aliases, aws.StringValue(alias.Name))
+				}
+				return true
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get list of aliases of function %s from AWS", name)
+			}
+			metadata.aliases = aliases
+		}
+
+		for _, version := range versions[name] {
+			newfunctions = append(newfunctions, lambdaFunctionSpec(name, version))
+		}
+		for _, alias := range metadata.aliases {
+			newfunctions = append(newfunctions, lambdaFunctionSpec(name, alias))
+		}
+	}
+
 	return newfunctions, nil
 }
+
+// functionMetadata holds the tags and aliases of a function, which are nil until they are listed.
+type functionMetadata struct {
+	// the versions of the function when the metadata was listed
+	versions string
+	listedAt time.Time
+	tags     map[string]*string
+	aliases  []string
+}
+
+// functionMetadataCache keeps the tags and aliases of functions between polls. They are listed again once they are
+// older than the refresh interval, or when the versions of the function change, e.g. when a version is published
+// along with an alias.
+type functionMetadataCache struct {
+	refreshInterval time.Duration
+	now             func() time.Time
+	functions       map[string]*functionMetadata
+}
+
+func newFunctionMetadataCache(refreshInterval time.Duration) *functionMetadataCache {
+	return &functionMetadataCache{
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+		functions:       make(map[string]*functionMetadata),
+	}
+}
+
+// get returns the cached metadata of the function, or empty metadata to fill if they are stale.
+func (c *functionMetadataCache) get(name string, versions []string) *functionMetadata {
+	joinedVersions := strings.Join(versions, ",")
+	now := c.now()
+	metadata, ok := c.functions[name]
+	if !ok || metadata.versions != joinedVersions || now.Sub(metadata.listedAt) >= c.refreshInterval {
+		metadata = &functionMetadata{versions: joinedVersions, listedAt: now}
+		c.functions[name] = metadata
+	}
+	return metadata
+}
+
+func (c *functionMetadataCache) retain(names []string) {
+	retained := make(map[string]*functionMetadata, len(names))
+	for _, name := range names {
+		if metadata, ok := c.functions[name]; ok {
+			retained[name] = metadata
+		}
+	}
+	c.functions = retained
+}
+
+func lambdaFunctionSpec(name, qualifier string) *glooaws.LambdaFunctionSpec {
+	logicalName := fmt.Sprintf("%s:%s", name, qualifier)
+	if qualifier == "$LATEST" {
+		logicalName = name
+	}
+	return &glooaws.LambdaFunctionSpec{
+		LambdaFunctionName: name,
+		Qualifier:          qualifier,
+		LogicalName:        logicalName,
+	}
+}
+
+// unqualifiedArn strips the version from the arn of a function version, as tags are only supported on functions,
+// e.g. arn:aws:lambda:us-east-1:123456789012:function:my-function:1
+func unqualifiedArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > 7 {
+		return strings.Join(parts[:7], ":")
+	}
+	return arn
+}
+
+func matchesTags(tags map[string]*string, selector map[string]string) bool {
+	for key, value := range selector {
+		tag, ok := tags[key]
+		if !ok || aws.StringValue(tag) != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+)
+
+const testArnPrefix = "arn:aws:lambda:us-east-1:123456789012:function:"
+
+var _ = Describe("AWS Lambda function discovery", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		server   *httptest.Server
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+
+		requestsLock    sync.Mutex
+		taggedResources []string
+		aliasRequests   int
+		authorization   string
+		// a version published after the first poll
+		publishedVersion string
+	)
+
+	writeJson := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		Expect(json.NewEncoder(w).Encode(v)).To(Succeed())
+	}
+
+	function := func(name, version string) map[string]string {
+		arn := testArnPrefix + name
+		if version != "$LATEST" {
+			arn += ":" + version
+		}
+		return map[string]string{"FunctionName": name, "FunctionArn": arn, "Version": version}
+	}
+
+	newDiscovery := func() *AWSLambdaFunctionDiscovery {
+		factory := &AWSLambdaFunctionDiscoveryFactory{LambdaEndpoint: server.URL}
+		return factory.NewFunctionDiscovery(upstream, fds.AdditionalClients{}).(*AWSLambdaFunctionDiscovery)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		taggedResources = nil
+		aliasRequests = 0
+		authorization = ""
+		publishedVersion = ""
+
+		// a mock of the AWS Lambda API, which pages functions and aliases
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2015-03-31/functions/", func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			requestsLock.Lock()
+			authorization = r.Header.Get("Authorization")
+			if strings.HasSuffix(r.URL.Path, "/aliases") {
+				aliasRequests++
+			}
+			requestsLock.Unlock()
+
+			marker := r.URL.Query().Get("Marker")
+			switch {
+			case r.URL.Path == "/2015-03-31/functions/" && marker == "":
+				Expect(r.URL.Query().Get("FunctionVersion")).To(Equal("ALL"))
+				writeJson(w, map[string]interface{}{
+					"Functions": []map[string]string{
+						function("orders-create", "$LATEST"),
+						function("orders-create", "1"),
+						function("billing", "$LATEST"),
+					},
+					"NextMarker": "functions-2",
+				})
+			case r.URL.Path == "/2015-03-31/functions/" && marker == "functions-2":
+				functions := []map[string]string{
+					function("orders-create", "2"),
+					function("orders-list", "$LATEST"),
+				}
+				if publishedVersion != "" {
+					functions = append(functions, function("orders-list", publishedVersion))
+				}
+				writeJson(w, map[string]interface{}{"Functions": functions})
+			case r.URL.Path == "/2015-03-31/functions/orders-create/aliases" && marker == "":
+				writeJson(w, map[string]interface{}{
+					"Aliases":    []map[string]string{{"Name": "live", "FunctionVersion": "2"}},
+					"NextMarker": "aliases-2",
+				})
+			case r.URL.Path == "/2015-03-31/functions/orders-create/aliases" && marker == "aliases-2":
+				writeJson(w, map[string]interface{}{
+					"Aliases": []map[string]string{{"Name": "canary", "FunctionVersion": "1"}},
+				})
+			case strings.HasSuffix(r.URL.Path, "/aliases"):
+				writeJson(w, map[string]interface{}{"Aliases": []map[string]string{}})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		mux.HandleFunc("/2017-03-31/tags/", func(w http.ResponseWriter, r *http.Request) {
+			resource := strings.TrimPrefix(r.URL.Path, "/2017-03-31/tags/")
+			requestsLock.Lock()
+			taggedResources = append(taggedResources, resource)
+			requestsLock.Unlock()
+
+			team := "billing"
+			if strings.HasPrefix(resource, testArnPrefix+"orders-") {
+				team = "orders"
+			}
+			writeJson(w, map[string]interface{}{
+				"Tags": map[string]string{"team": team, "env": "prod"},
+			})
+		})
+		server = httptest.NewServer(mux)
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "aws-upstream", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Aws{
+				Aws: &glooaws.UpstreamSpec{
+					Region:    "us-east-1",
+					SecretRef: &core.ResourceRef{Name: "aws-secret", Namespace: "gloo-system"},
+				},
+			},
+		}
+		secrets = v1.SecretList{{
+			Metadata: &core.Metadata{Name: "aws-secret", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Aws{
+				Aws: &v1.AwsSecret{AccessKey: "access-key", SecretKey: "secret-key"},
+			},
+		}}
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	spec := func(logicalName, name, qualifier string) *glooaws.LambdaFunctionSpec {
+		return &glooaws.LambdaFunctionSpec{LogicalName: logicalName, LambdaFunctionName: name, Qualifier: qualifier}
+	}
+
+	It("discovers the versions and aliases of all functions, across pages", func() {
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal([]*glooaws.LambdaFunctionSpec{
+			spec("orders-create", "orders-create", "$LATEST"),
+			spec("orders-create:1", "orders-create", "1"),
+			spec("orders-create:2", "orders-create", "2"),
+			spec("orders-create:live", "orders-create", "live"),
+			spec("orders-create:canary", "orders-create", "canary"),
+			spec("billing", "billing", "$LATEST"),
+			spec("orders-list", "orders-list", "$LATEST"),
+		}))
+		// tags are only listed when selecting functions by tags
+		Expect(taggedResources).To(BeEmpty())
+	})
+
+	It("selects functions by name prefix", func() {
+		upstream.GetAws().FunctionDiscoveryOptions = &glooaws.LambdaFunctionDiscoveryOptions{
+			FunctionNamePrefix: "orders-",
+		}
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		for _, f := range functions {
+			Expect(f.GetLambdaFunctionName()).To(HavePrefix("orders-"))
+		}
+		Expect(functions).To(ContainElement(spec("orders-list", "orders-list", "$LATEST")))
+	})
+
+	It("selects functions by tags", func() {
+		upstream.GetAws().FunctionDiscoveryOptions = &glooaws.LambdaFunctionDiscoveryOptions{
+			TagSelector: map[string]string{"team": "billing", "env": "prod"},
+		}
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal([]*glooaws.LambdaFunctionSpec{
+			spec("billing", "billing", "$LATEST"),
+		}))
+		// the tags of each function are listed once, by the arn of the function
+		Expect(taggedResources).To(ConsistOf(
+			testArnPrefix+"orders-create",
+			testArnPrefix+"billing",
+			testArnPrefix+"orders-list",
+		))
+	})
+
+	It("caches the tags and aliases of the functions between polls", func() {
+		upstream.GetAws().FunctionDiscoveryOptions = &glooaws.LambdaFunctionDiscoveryOptions{
+			TagSelector: map[string]string{"team": "orders"},
+		}
+		discovery := newDiscovery()
+		functions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taggedResources).To(HaveLen(3))
+		// the aliases of orders-create take two pages, and billing is not selected
+		Expect(aliasRequests).To(Equal(3))
+
+		By("not listing them again while they are fresh")
+		cachedFunctions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedFunctions).To(Equal(functions))
+		Expect(taggedResources).To(HaveLen(3))
+		Expect(aliasRequests).To(Equal(3))
+
+		By("listing them again for a function with a new version")
+		publishedVersion = "1"
+		functions, err = discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(ContainElement(spec("orders-list:1", "orders-list", "1")))
+		Expect(taggedResources).To(HaveLen(4))
+		Expect(taggedResources[3]).To(Equal(testArnPrefix + "orders-list"))
+		Expect(aliasRequests).To(Equal(4))
+
+		By("listing them again once they are stale")
+		discovery.metadata.now = func() time.Time {
+			return time.Now().Add(functionMetadataRefreshInterval)
+		}
+		_, err = discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taggedResources).To(HaveLen(7))
+		Expect(aliasRequests).To(Equal(7))
+	})
+
+	It("uses the AWS_REGION of the pod when the upstream has no region", func() {
+		previousRegion, hadRegion := os.LookupEnv(AWS_REGION)
+		Expect(os.Setenv(AWS_REGION, "eu-west-1")).To(Succeed())
+		defer func() {
+			if hadRegion {
+				os.Setenv(AWS_REGION, previousRegion)
+			} else {
+				os.Unsetenv(AWS_REGION)
+			}
+		}()
+		upstream.GetAws().Region = ""
+
+		_, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		// the requests are signed for the region
+		Expect(authorization).To(ContainSubstring("/eu-west-1/lambda/aws4_request"))
+
+		By("preferring the region of the upstream")
+		upstream.GetAws().Region = "us-east-1"
+		_, err = newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorization).To(ContainSubstring("/us-east-1/lambda/aws4_request"))
+	})
+
+	It("returns the errors of the AWS API", func() {
+		upstream.GetAws().FunctionDiscoveryOptions = &glooaws.LambdaFunctionDiscoveryOptions{
+			FunctionNamePrefix: "orders-",
+		}
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Amzn-Errortype", "AccessDeniedException")
+			w.WriteHeader(http.StatusForbidden)
+			writeJson(w, map[string]string{"message": "not allowed to list functions"})
+		})
+		_, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).To(MatchError(ContainSubstring("unable to get list of functions from AWS")))
+		Expect(err).To(MatchError(ContainSubstring("AccessDeniedException")))
+	})
+
+	It("falls back to the default polling interval when the configured one is not positive", func() {
+		newFactory := func(interval time.Duration) *AWSLambdaFunctionDiscoveryFactory {
+			return NewFunctionDiscoveryFactory(bootstrap.Opts{
+				Settings: &v1.Settings{
+					Discovery: &v1.Settings_DiscoveryOptions{
+						FdsOptions: &v1.Settings_DiscoveryOptions_FdsOptions{
+							AwsLambdaPollingInterval: durationpb.New(interval),
+						},
+					},
+				},
+			}).(*AWSLambdaFunctionDiscoveryFactory)
+		}
+		Expect(newFactory(5 * time.Second).PollingTime).To(Equal(5 * time.Second))
+		Expect(newFactory(0).PollingTime).To(Equal(DefaultPollingTime))
+		Expect(newFactory(-time.Second).PollingTime).To(Equal(DefaultPollingTime))
+	})
+})
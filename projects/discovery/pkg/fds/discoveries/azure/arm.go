@@ -0,0 +1,193 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// the version of the Microsoft.Web resource provider API
+const armApiVersion = "2022-03-01"
+
+// errStopListing is returned by the callback of list to skip the remaining pages
+var errStopListing = errors.New("stop listing")
+
+// armClient lists the resources of the Azure Resource Manager API needed for function discovery.
+type armClient struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+func newArmClient(ctx context.Context, managementEndpoint, loginEndpoint string, secret *v1.AzureSecret) (*armClient, error) {
+	if secret.GetTenantId() == "" || secret.GetClientId() == "" || secret.GetClientSecret() == "" {
+		return nil, errors.New("azure secret has no service principal credentials (tenant id, client id and client secret)")
+	}
+	config := clientcredentials.Config{
+		ClientID:     secret.GetClientId(),
+		ClientSecret: secret.GetClientSecret(),
+		TokenURL:     fmt.Sprintf("%s/%s/oauth2/v2.0/token", loginEndpoint, url.PathEscape(secret.GetTenantId())),
+		Scopes:       []string{managementEndpoint + "/.default"},
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	return &armClient{
+		httpClient: config.Client(ctx),
+		endpoint:   managementEndpoint,
+	}, nil
+}
+
+type armSite struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// the kind of function apps is e.g. "functionapp" or "functionapp,linux"
+func (s *armSite) isFunctionApp() bool {
+	return strings.Contains(strings.ToLower(s.Kind), "functionapp")
+}
+
+type armFunction struct {
+	// the name of a function is prefixed by the name of its function app, e.g. "my-app/my-function"
+	Name       string `json:"name"`
+	Properties struct {
+		Name       string `json:"name"`
+		IsDisabled bool   `json:"isDisabled"`
+		Config     struct {
+			Bindings []armBinding `json:"bindings"`
+		} `json:"config"`
+	} `json:"properties"`
+}
+
+type armBinding struct {
+	Type      string `json:"type"`
+	Direction string `json:"direction"`
+	AuthLevel string `json:"authLevel"`
+}
+
+func (f *armFunction) functionName() string {
+	if f.Properties.Name != "" {
+		return f.Properties.Name
+	}
+	return f.Name[strings.LastIndex(f.Name, "/")+1:]
+}
+
+func (f *armFunction) httpTrigger() *armBinding {
+	for i, binding := range f.Properties.Config.Bindings {
+		if strings.EqualFold(binding.Type, "httpTrigger") {
+			return &f.Properties.Config.Bindings[i]
+		}
+	}
+	return nil
+}
+
+// findFunctionApp returns the function app with the given name, in the resource group if one is given,
+// or else in the whole subscription. The names of the function apps are globally unique, so the pages
+// after the one of the function app are not listed.
+func (c *armClient) findFunctionApp(ctx context.Context, subscriptionId, resourceGroup, name string) (*armSite, error) {
+	scope := "/subscriptions/" + url.PathEscape(subscriptionId)
+	if resourceGroup != "" {
+		scope += "/resourceGroups/" + url.PathEscape(resourceGroup)
+	}
+	var found *armSite
+	err := c.list(ctx, scope+"/providers/Microsoft.Web/sites", func(value json.RawMessage) error {
+		var sites []*armSite
+		if err := json.Unmarshal(value, &sites); err != nil {
+			return err
+		}
+		for _, site := range sites {
+			if site.isFunctionApp() && strings.EqualFold(site.Name, name) {
+				found = site
+				return errStopListing
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing function apps")
+	}
+	if found == nil {
+		return nil, errors.Errorf("function app %s not found in %s", name, scope)
+	}
+	return found, nil
+}
+
+// listFunctions returns the functions of the function app with the given resource id.
+func (c *armClient) listFunctions(ctx context.Context, appId string) ([]*armFunction, error) {
+	var functions []*armFunction
+	err := c.list(ctx, appId+"/functions", func(value json.RawMessage) error {
+		var page []*armFunction
+		if err := json.Unmarshal(value, &page); err != nil {
+			return err
+		}
+		functions = append(functions, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing functions of function app %s", appId)
+	}
+	return functions, nil
+}
+
+type armPage struct {
+	Value    json.RawMessage `json:"value"`
+	NextLink string          `json:"nextLink"`
+}
+
+type armError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// list calls addPage with the value of each page of the list of resources at the given path,
+// until addPage returns errStopListing.
+func (c *armClient) list(ctx context.Context, path string, addPage func(value json.RawMessage) error) error {
+	next := c.endpoint + path + "?api-version=" + armApiVersion
+	for next != "" {
+		var page armPage
+		if err := c.get(ctx, next, &page); err != nil {
+			return err
+		}
+		if err := addPage(page.Value); err != nil {
+			if err == errStopListing {
+				return nil
+			}
+			return err
+		}
+		next = page.NextLink
+	}
+	return nil
+}
+
+func (c *armClient) get(ctx context.Context, resourceUrl string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		var armErr armError
+		if json.Unmarshal(body, &armErr) == nil && armErr.Error.Code != "" {
+			return errors.Errorf("%s: %s: %s", res.Status, armErr.Error.Code, armErr.Error.Message)
+		}
+		return errors.Errorf("%s: %s", res.Status, body)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,185 @@
+package azure
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+)
+
+const (
+	DefaultManagementEndpoint = "https://management.azure.com"
+	DefaultLoginEndpoint      = "https://login.microsoftonline.com"
+)
+
+func NewFunctionDiscoveryFactory(opts bootstrap.Opts) fds.FunctionDiscoveryFactory {
+	fdsOptions := opts.Settings.GetDiscovery().GetFdsOptions()
+	return &AzureFunctionDiscoveryFactory{
+		PollingTime:        time.Minute,
+		ManagementEndpoint: fdsOptions.GetAzureManagementEndpoint(),
+		LoginEndpoint:      fdsOptions.GetAzureLoginEndpoint(),
+	}
+}
+
+// AzureFunctionDiscoveryFactory represents a factory for Azure Functions function discovery.
+type AzureFunctionDiscoveryFactory struct {
+	PollingTime time.Duration
+	// the Azure Resource Manager endpoint, defaults to DefaultManagementEndpoint
+	ManagementEndpoint string
+	// the Azure Active Directory endpoint, defaults to DefaultLoginEndpoint
+	LoginEndpoint string
+}
+
+func (f *AzureFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
+	managementEndpoint := f.ManagementEndpoint
+	if managementEndpoint == "" {
+		managementEndpoint = DefaultManagementEndpoint
+	}
+	loginEndpoint := f.LoginEndpoint
+	if loginEndpoint == "" {
+		loginEndpoint = DefaultLoginEndpoint
+	}
+	return &AzureFunctionDiscovery{
+		timeToWait:         f.PollingTime,
+		upstream:           u,
+		managementEndpoint: strings.TrimSuffix(managementEndpoint, "/"),
+		loginEndpoint:      strings.TrimSuffix(loginEndpoint, "/"),
+	}
+}
+
+// AzureFunctionDiscovery is a discovery that polls the Azure Resource Manager API for the HTTP-triggered functions
+// of a Function App.
+type AzureFunctionDiscovery struct {
+	timeToWait         time.Duration
+	upstream           *v1.Upstream
+	managementEndpoint string
+	loginEndpoint      string
+
+	// the client of the last poll, which keeps its token until it expires, and the secret it was built with
+	client       *armClient
+	clientSecret *v1.AzureSecret
+}
+
+func (f *AzureFunctionDiscovery) IsFunctional() bool {
+	azureSpec, ok := f.upstream.GetUpstreamType().(*v1.Upstream_Azure)
+	// the subscription is required to find the function app
+	return ok && azureSpec.Azure.GetSubscriptionId() != ""
+}
+
+func (f *AzureFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *AzureFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	newFunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+
+	err = updatecb(func(out *v1.Upstream) error {
+		azureSpec, ok := out.GetUpstreamType().(*v1.Upstream_Azure)
+		if !ok {
+			return errors.New("not azure upstream")
+		}
+		azureSpec.Azure.Functions = newFunctions
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to update upstream")
+	}
+
+	// sleep so we are not hogging
+	return contextutils.Sleep(ctx, f.timeToWait)
+}
+
+// DetectFunctionsOnce returns the HTTP-triggered functions of the Function App of the upstream, sorted by name.
+func (f *AzureFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooazure.UpstreamSpec_FunctionSpec, error) {
+	azureSpec, ok := f.upstream.GetUpstreamType().(*v1.Upstream_Azure)
+	if !ok {
+		return nil, errors.New("not an azure upstream spec")
+	}
+	spec := azureSpec.Azure
+	if spec.GetSubscriptionId() == "" {
+		return nil, errors.New("azure upstream has no subscription id")
+	}
+
+	secret, err := secrets.Find(spec.GetSecretRef().Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "azure secret for ref %v not found", spec.GetSecretRef())
+	}
+	azureSecret, ok := secret.GetKind().(*v1.Secret_Azure)
+	if !ok {
+		return nil, errors.Errorf("secret %v is not an Azure secret", secret.GetMetadata().Ref())
+	}
+
+	client, err := f.clientFor(ctx, azureSecret.Azure)
+	if err != nil {
+		return nil, err
+	}
+	app, err := client.findFunctionApp(ctx, spec.GetSubscriptionId(), spec.GetResourceGroup(), spec.GetFunctionAppName())
+	if err != nil {
+		return nil, err
+	}
+	functions, err := client.listFunctions(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var newFunctions []*glooazure.UpstreamSpec_FunctionSpec
+	for _, fn := range functions {
+		if fn.Properties.IsDisabled {
+			continue
+		}
+		trigger := fn.httpTrigger()
+		if trigger == nil {
+			continue
+		}
+		newFunctions = append(newFunctions, &glooazure.UpstreamSpec_FunctionSpec{
+			FunctionName: fn.functionName(),
+			AuthLevel:    authLevel(trigger.AuthLevel),
+		})
+	}
+
+	// sort for idempotency
+	sort.Slice(newFunctions, func(i, j int) bool {
+		return newFunctions[i].GetFunctionName() < newFunctions[j].GetFunctionName()
+	})
+	return newFunctions, nil
+}
+
+// clientFor returns the client of the previous poll, unless the secret changed since.
+func (f *AzureFunctionDiscovery) clientFor(ctx context.Context, secret *v1.AzureSecret) (*armClient, error) {
+	if f.client != nil && f.clientSecret.Equal(secret) {
+		return f.client, nil
+	}
+	client, err := newArmClient(ctx, f.managementEndpoint, f.loginEndpoint, secret)
+	if err != nil {
+		return nil, err
+	}
+	f.client, f.clientSecret = client, secret
+	return client, nil
+}
+
+// authLevel converts the authLevel of an HTTP trigger binding. Azure defaults to the function level.
+func authLevel(level string) glooazure.UpstreamSpec_FunctionSpec_AuthLevel {
+	switch strings.ToLower(level) {
+	case "anonymous":
+		return glooazure.UpstreamSpec_FunctionSpec_Anonymous
+	case "admin":
+		return glooazure.UpstreamSpec_FunctionSpec_Admin
+	}
+	return glooazure.UpstreamSpec_FunctionSpec_Function
+}
@@ -0,0 +1,272 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+)
+
+const (
+	testToken        = "test-token"
+	testSubscription = "test-subscription"
+	testAppId        = "/subscriptions/test-subscription/resourceGroups/test-rg/providers/Microsoft.Web/sites/my-app"
+)
+
+var _ = Describe("Azure function discovery", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		server   *httptest.Server
+		mux      *http.ServeMux
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+
+		tokenRequests     int32
+		sitesPageRequests int32
+	)
+
+	writeJson := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		Expect(json.NewEncoder(w).Encode(v)).To(Succeed())
+	}
+
+	// serves the resources of the ARM API, after checking the token of the request
+	handleArm := func(path string, handler http.HandlerFunc) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer " + testToken))
+			Expect(r.URL.Query().Get("api-version")).To(Equal(armApiVersion))
+			handler(w, r)
+		})
+	}
+
+	newDiscovery := func() *AzureFunctionDiscovery {
+		factory := &AzureFunctionDiscoveryFactory{
+			ManagementEndpoint: server.URL,
+			LoginEndpoint:      server.URL + "/",
+		}
+		return factory.NewFunctionDiscovery(upstream, fds.AdditionalClients{}).(*AzureFunctionDiscovery)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		tokenRequests = 0
+		sitesPageRequests = 0
+
+		mux.HandleFunc("/test-tenant/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			atomic.AddInt32(&tokenRequests, 1)
+			Expect(r.ParseForm()).To(Succeed())
+			Expect(r.PostForm.Get("grant_type")).To(Equal("client_credentials"))
+			Expect(r.PostForm.Get("client_id")).To(Equal("test-client"))
+			Expect(r.PostForm.Get("client_secret")).To(Equal("test-secret"))
+			Expect(r.PostForm.Get("scope")).To(Equal(server.URL + "/.default"))
+			writeJson(w, map[string]interface{}{
+				"access_token": testToken,
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		})
+
+		// the function app is on the second page of the function apps of the subscription
+		handleArm("/subscriptions/test-subscription/providers/Microsoft.Web/sites", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&sitesPageRequests, 1)
+			if r.URL.Query().Get("page") == "" {
+				writeJson(w, map[string]interface{}{
+					"value": []map[string]string{
+						{"id": "/subscriptions/test-subscription/resourceGroups/test-rg/providers/Microsoft.Web/sites/my-app-site", "name": "my-app", "kind": "app"},
+						{"id": "/subscriptions/test-subscription/resourceGroups/test-rg/providers/Microsoft.Web/sites/other-app", "name": "other-app", "kind": "functionapp"},
+					},
+					"nextLink": server.URL + r.URL.Path + "?api-version=" + armApiVersion + "&page=2",
+				})
+				return
+			}
+			writeJson(w, map[string]interface{}{
+				"value": []map[string]string{
+					{"id": testAppId, "name": "my-app", "kind": "functionapp,linux"},
+				},
+			})
+		})
+
+		handleArm(testAppId+"/functions", func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, map[string]interface{}{
+				"value": []interface{}{
+					armFunctionJson("my-app/public", false, map[string]string{"type": "httpTrigger", "direction": "in", "authLevel": "anonymous"}),
+					armFunctionJson("my-app/admin", false, map[string]string{"type": "httpTrigger", "direction": "in", "authLevel": "admin"}),
+					armFunctionJson("my-app/default", false, map[string]string{"type": "httpTrigger", "direction": "in"}),
+					armFunctionJson("my-app/queue", false, map[string]string{"type": "queueTrigger", "direction": "in"}),
+					armFunctionJson("my-app/disabled", true, map[string]string{"type": "httpTrigger", "direction": "in", "authLevel": "function"}),
+				},
+			})
+		})
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "azure-upstream", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Azure{
+				Azure: &glooazure.UpstreamSpec{
+					FunctionAppName: "my-app",
+					SecretRef:       &core.ResourceRef{Name: "azure-secret", Namespace: "gloo-system"},
+					SubscriptionId:  testSubscription,
+				},
+			},
+		}
+		secrets = v1.SecretList{{
+			Metadata: &core.Metadata{Name: "azure-secret", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Azure{
+				Azure: &v1.AzureSecret{
+					TenantId:     "test-tenant",
+					ClientId:     "test-client",
+					ClientSecret: "test-secret",
+				},
+			},
+		}}
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	expectedFunctions := []*glooazure.UpstreamSpec_FunctionSpec{
+		{FunctionName: "admin", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Admin},
+		{FunctionName: "default", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Function},
+		{FunctionName: "public", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Anonymous},
+	}
+
+	It("is functional only for azure upstreams with a subscription", func() {
+		Expect(newDiscovery().IsFunctional()).To(BeTrue())
+
+		upstream.GetAzure().SubscriptionId = ""
+		Expect(newDiscovery().IsFunctional()).To(BeFalse())
+
+		upstream.UpstreamType = &v1.Upstream_Aws{}
+		Expect(newDiscovery().IsFunctional()).To(BeFalse())
+	})
+
+	It("discovers the enabled HTTP-triggered functions of the function app", func() {
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal(expectedFunctions))
+	})
+
+	It("stops listing the function apps once the function app is found", func() {
+		handleArm("/subscriptions/test-subscription/resourceGroups/test-rg/providers/Microsoft.Web/sites/other-app/functions", func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, map[string]interface{}{"value": []interface{}{}})
+		})
+		upstream.GetAzure().FunctionAppName = "other-app"
+
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(BeEmpty())
+		Expect(atomic.LoadInt32(&sitesPageRequests)).To(Equal(int32(1)))
+	})
+
+	It("reuses the token between polls, until the secret changes", func() {
+		discovery := newDiscovery()
+		for i := 0; i < 3; i++ {
+			_, err := discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(atomic.LoadInt32(&tokenRequests)).To(Equal(int32(1)))
+
+		mux.HandleFunc("/moved-tenant/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&tokenRequests, 1)
+			writeJson(w, map[string]interface{}{
+				"access_token": testToken,
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		})
+		secrets = v1.SecretList{secrets[0].Clone().(*v1.Secret)}
+		secrets[0].GetAzure().TenantId = "moved-tenant"
+		_, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(&tokenRequests)).To(Equal(int32(2)))
+	})
+
+	It("looks for the function app in the resource group", func() {
+		handleArm("/subscriptions/test-subscription/resourceGroups/test-rg/providers/Microsoft.Web/sites", func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, map[string]interface{}{
+				"value": []map[string]string{
+					{"id": testAppId, "name": "my-app", "kind": "functionapp"},
+				},
+			})
+		})
+		upstream.GetAzure().ResourceGroup = "test-rg"
+
+		functions, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal(expectedFunctions))
+	})
+
+	It("updates the functions of the upstream", func() {
+		var updated *v1.Upstream
+		updatecb := func(mutator fds.UpstreamMutator) error {
+			updated = upstream.Clone().(*v1.Upstream)
+			return mutator(updated)
+		}
+		dependencies := func() fds.Dependencies {
+			return fds.Dependencies{Secrets: secrets}
+		}
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		err := newDiscovery().DetectFunctions(ctx, nil, dependencies, updatecb)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.GetAzure().GetFunctions()).To(Equal(expectedFunctions))
+	})
+
+	It("errors when the function app does not exist", func() {
+		upstream.GetAzure().FunctionAppName = "missing-app"
+
+		_, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).To(MatchError(ContainSubstring("function app missing-app not found in /subscriptions/test-subscription")))
+	})
+
+	It("returns the error of the ARM API", func() {
+		handleArm("/subscriptions/forbidden-subscription/providers/Microsoft.Web/sites", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			writeJson(w, map[string]interface{}{
+				"error": map[string]string{"code": "AuthorizationFailed", "message": "no read access"},
+			})
+		})
+		upstream.GetAzure().SubscriptionId = "forbidden-subscription"
+
+		_, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).To(MatchError(ContainSubstring("403 Forbidden: AuthorizationFailed: no read access")))
+	})
+
+	It("errors when the secret has no service principal credentials", func() {
+		secrets[0].GetAzure().ClientSecret = ""
+
+		_, err := newDiscovery().DetectFunctionsOnce(ctx, secrets)
+		Expect(err).To(MatchError(ContainSubstring("azure secret has no service principal credentials")))
+	})
+})
+
+func armFunctionJson(name string, disabled bool, bindings ...map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":   testAppId + "/functions/" + name,
+		"name": name,
+		"properties": map[string]interface{}{
+			"isDisabled": disabled,
+			"config": map[string]interface{}{
+				"bindings": bindings,
+			},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,13 +16,27 @@ import (
 	"github.com/jhump/protoreflect/grpcreflect"
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+)
+
+const (
+	// the key of the descriptor set in the ConfigMaps written by function discovery
+	DescriptorConfigMapKey = "protoDesc"
+	// the suffix of the name of the ConfigMaps written by function discovery, after the name of their upstream
+	descriptorConfigMapSuffix = "-descriptors"
 )
 
 func getGrpcspec(u *v1.Upstream) *grpc_plugins.ServiceSpec {
@@ -41,10 +56,24 @@ func getGrpcspec(u *v1.Upstream) *grpc_plugins.ServiceSpec {
 	return grpcWrapper.Grpc
 }
 
-func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
+func getGrpcJsonTranscoderSpec(u *v1.Upstream) *grpc_json.GrpcJsonTranscoder {
+	upstreamType, ok := u.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+
+	transcoderWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*plugins.ServiceSpec_GrpcJsonTranscoder)
+	if !ok {
+		return nil
+	}
+	return transcoderWrapper.GrpcJsonTranscoder
+}
+
+func NewFunctionDiscoveryFactory(opts bootstrap.Opts) fds.FunctionDiscoveryFactory {
 	return &FunctionDiscoveryFactory{
-		DetectionTimeout: time.Minute,
-		FunctionPollTime: time.Second * 15,
+		DetectionTimeout:       time.Minute,
+		FunctionPollTime:       time.Second * 15,
+		JsonTranscodingEnabled: opts.Settings.GetDiscovery().GetFdsOptions().GetGrpcJsonTranscodingEnabled().GetValue(),
 	}
 }
 
@@ -57,23 +86,29 @@ type FunctionDiscoveryFactory struct {
 	FunctionPollTime   time.Duration
 	// TODO: move over to ArtifactClient
 	Artifacts v1.ArtifactClient
+	// when enabled, newly discovered upstreams transcode JSON requests with a descriptor set stored in a ConfigMap
+	JsonTranscodingEnabled bool
 }
 
 // NewFunctionDiscovery returns a FunctionDiscovery that can be used to discover functions
-func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
+func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, clients fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
-		upstream: u,
+		upstream:               u,
+		artifactClient:         clients.ArtifactClient,
+		jsonTranscodingEnabled: f.JsonTranscodingEnabled && clients.ArtifactClient != nil,
 	}
 }
 
 // UpstreamFunctionDiscovery represents a function discovery for upstream
 type UpstreamFunctionDiscovery struct {
-	upstream *v1.Upstream
+	upstream               *v1.Upstream
+	artifactClient         v1.ArtifactClient
+	jsonTranscodingEnabled bool
 }
 
 // IsFunctional returns true if the upstream is functional
 func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
-	return getGrpcspec(f.upstream) != nil
+	return getGrpcspec(f.upstream) != nil || getGrpcJsonTranscoderSpec(f.upstream) != nil
 }
 
 func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
@@ -92,6 +127,24 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 		return nil, errors.Wrapf(err, "listing services. are you sure %v implements reflection?", url)
 	}
 
+	if f.jsonTranscodingEnabled {
+		// the descriptor set and the services are filled when the functions are discovered
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
+				GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+					DescriptorSet: &grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap{
+						ProtoDescriptorConfigMap: &grpc_json.GrpcJsonTranscoder_DescriptorConfigMap{
+							ConfigMapRef: DescriptorConfigMapRef(f.upstream),
+							Key:          DescriptorConfigMapKey,
+						},
+					},
+					// methods without a google.api.http annotation are available at /<package>.<service>/<method>
+					AutoMapping: true,
+				},
+			},
+		}, nil
+	}
+
 	svcInfo := &plugins.ServiceSpec{
 		PluginType: &plugins.ServiceSpec_Grpc{
 			Grpc: &grpc_plugins.ServiceSpec{},
@@ -109,12 +162,8 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 		if ctx.Err() != nil {
 			return multierror.Append(err, ctx.Err())
 		}
-		// only log other errors as we would like to continue forever.
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform grpc function discovery for upstream %s in namespace %s, error: ",
-			f.upstream.GetMetadata().GetName(),
-			f.upstream.GetMetadata().GetNamespace(),
-			err.Error(),
-		)
+		// the updater records the error on the upstream and retries with a backoff
+		return err
 	}
 
 	// sleep so we are not hogging
@@ -142,22 +191,28 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	}
 
 	descriptors := &descriptor.FileDescriptorSet{}
+	addedFiles := make(map[string]bool)
 
 	var grpcServices []*grpc_plugins.ServiceSpec_GrpcService
+	var fullServiceNames []string
 
 	for _, s := range services {
-		// ignore the reflection descriptor
-		if s == "grpc.reflection.v1alpha.ServerReflection" {
+		// ignore the reflection descriptors
+		if reflectionServiceNames[s] {
 			continue
 		}
-		// TODO(yuval-k): do not add the same file twice
 		root, err := refClient.FileContainingSymbol(s)
 		if err != nil {
 			return errors.Wrapf(err, "getting file for svc symbol %s", s)
 		}
-		files := getDepTree(root)
-
-		descriptors.File = append(descriptors.GetFile(), files...)
+		// services often share files and dependencies, which must be in the set only once
+		for _, file := range getDepTree(root) {
+			if addedFiles[file.GetName()] {
+				continue
+			}
+			addedFiles[file.GetName()] = true
+			descriptors.File = append(descriptors.GetFile(), file)
+		}
 
 		parts := strings.Split(s, ".")
 		serviceName := parts[len(parts)-1]
@@ -177,6 +232,7 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 			}
 		}
 		grpcServices = append(grpcServices, grpcService)
+		fullServiceNames = append(fullServiceNames, s)
 	}
 
 	rawDescriptors, err := proto.Marshal(descriptors)
@@ -184,6 +240,10 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 		return errors.Wrap(err, "marshalling proto descriptors")
 	}
 
+	if transcoderSpec := getGrpcJsonTranscoderSpec(f.upstream); transcoderSpec != nil {
+		return f.updateGrpcJsonTranscoder(ctx, transcoderSpec, fullServiceNames, rawDescriptors, updatecb)
+	}
+
 	encodedDescriptors := []byte(base64.StdEncoding.EncodeToString(rawDescriptors))
 
 	return updatecb(func(out *v1.Upstream) error {
@@ -199,6 +259,72 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	})
 }
 
+// Stores the descriptor set in the ConfigMap referenced by the transcoder of the upstream, and transcodes the services.
+// The descriptors are kept as they were reflected, so the transcoder maps requests with their google.api.http annotations.
+func (f *UpstreamFunctionDiscovery) updateGrpcJsonTranscoder(ctx context.Context, transcoderSpec *grpc_json.GrpcJsonTranscoder, services []string, rawDescriptors []byte, updatecb func(fds.UpstreamMutator) error) error {
+	sort.Strings(services)
+
+	configMapRef := transcoderSpec.GetProtoDescriptorConfigMap().GetConfigMapRef()
+	key := transcoderSpec.GetProtoDescriptorConfigMap().GetKey()
+	if configMapRef == nil {
+		configMapRef = DescriptorConfigMapRef(f.upstream)
+		key = DescriptorConfigMapKey
+	}
+	if err := f.writeDescriptorConfigMap(ctx, configMapRef, key, base64.StdEncoding.EncodeToString(rawDescriptors)); err != nil {
+		return err
+	}
+
+	return updatecb(func(out *v1.Upstream) error {
+		transcoder := getGrpcJsonTranscoderSpec(out)
+		if transcoder == nil {
+			return errors.New("not a gRPC JSON transcoding upstream")
+		}
+		transcoder.DescriptorSet = &grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap{
+			ProtoDescriptorConfigMap: &grpc_json.GrpcJsonTranscoder_DescriptorConfigMap{
+				ConfigMapRef: configMapRef,
+				Key:          key,
+			},
+		}
+		transcoder.Services = services
+		return nil
+	})
+}
+
+func (f *UpstreamFunctionDiscovery) writeDescriptorConfigMap(ctx context.Context, ref *core.ResourceRef, key, encodedDescriptors string) error {
+	if f.artifactClient == nil {
+		return errors.New("no client to write the descriptor ConfigMap")
+	}
+	artifact := &v1.Artifact{
+		Metadata: &core.Metadata{
+			Name:      ref.GetName(),
+			Namespace: ref.GetNamespace(),
+		},
+	}
+	existing, err := f.artifactClient.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil && !skerrors.IsNotExist(err) {
+		return errors.Wrapf(err, "reading descriptor ConfigMap %s", ref.Key())
+	}
+	if existing != nil {
+		if existing.GetData()[key] == encodedDescriptors {
+			return nil
+		}
+		artifact = existing
+	}
+	artifact.Data = map[string]string{key: encodedDescriptors}
+	if _, err := f.artifactClient.Write(artifact, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+		return errors.Wrapf(err, "writing descriptor ConfigMap %s", ref.Key())
+	}
+	return nil
+}
+
+// DescriptorConfigMapRef returns the ref of the ConfigMap which stores the descriptor set of a discovered upstream.
+func DescriptorConfigMapRef(u *v1.Upstream) *core.ResourceRef {
+	return &core.ResourceRef{
+		Name:      u.GetMetadata().GetName() + descriptorConfigMapSuffix,
+		Namespace: u.GetMetadata().GetNamespace(),
+	}
+}
+
 func getClient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error) {
 	var dialOpts []grpc.DialOption
 	if url.Scheme != "https" {
@@ -209,7 +335,12 @@ func getClient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() e
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "dialing grpc on %v", url.Host)
 	}
-	refClient := grpcreflect.NewClient(ctx, reflectpb.NewServerReflectionClient(cc))
+	// prefer the v1 reflection API, and fall back to the v1alpha API for servers which do not implement it
+	refClient := grpcreflect.NewClient(ctx, newServerReflectionV1Client(cc))
+	if _, err := refClient.ListServices(); status.Code(err) == codes.Unimplemented {
+		refClient.Reset()
+		refClient = grpcreflect.NewClient(ctx, reflectpb.NewServerReflectionClient(cc))
+	}
 	closeConn := func() error {
 		refClient.Reset()
 		return cc.Close()
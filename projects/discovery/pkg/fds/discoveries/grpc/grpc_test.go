@@ -0,0 +1,249 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	testgrpcservice "github.com/solo-io/gloo/test/v1helpers/test_grpc_service"
+)
+
+var _ = Describe("Grpc function discovery", func() {
+
+	var (
+		ctx            context.Context
+		cancel         context.CancelFunc
+		artifactClient v1.ArtifactClient
+		upstream       *v1.Upstream
+		serverUrl      *url.URL
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+		artifactClient, err = v1.NewArtifactClient(ctx, &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := testgrpcservice.RunServer(ctx)
+		serverUrl = &url.URL{Scheme: "http", Host: fmt.Sprintf("localhost:%d", srv.Port)}
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "grpc-upstream", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					Hosts: []*static.Host{{Addr: "localhost", Port: srv.Port}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	newDiscovery := func(jsonTranscodingEnabled bool) fds.UpstreamFunctionDiscovery {
+		factory := &FunctionDiscoveryFactory{JsonTranscodingEnabled: jsonTranscodingEnabled}
+		return factory.NewFunctionDiscovery(upstream, fds.AdditionalClients{ArtifactClient: artifactClient})
+	}
+
+	// detects the type of the upstream and then its functions, as the updater does
+	discover := func(discovery fds.UpstreamFunctionDiscovery) {
+		spec, err := discovery.DetectType(ctx, serverUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).NotTo(BeNil())
+		upstream.GetStatic().ServiceSpec = spec
+		Expect(discovery.IsFunctional()).To(BeTrue())
+
+		err = discovery.(*UpstreamFunctionDiscovery).DetectFunctionsOnce(ctx, serverUrl, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("discovers the grpc services", func() {
+		discover(newDiscovery(false))
+
+		grpcSpec := upstream.GetStatic().GetServiceSpec().GetGrpc()
+		Expect(grpcSpec).NotTo(BeNil())
+		var serviceNames []string
+		for _, svc := range grpcSpec.GetGrpcServices() {
+			serviceNames = append(serviceNames, svc.GetPackageName()+"."+svc.GetServiceName())
+		}
+		Expect(serviceNames).To(ConsistOf("glootest.TestService", "grpc.health.v1.Health"))
+
+		artifacts, err := artifactClient.List("gloo-system", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(artifacts).To(BeEmpty())
+	})
+
+	Context("json transcoding", func() {
+
+		getDescriptorSet := func(ref *core.ResourceRef, key string) *descriptor.FileDescriptorSet {
+			artifact, err := artifactClient.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			rawDescriptors, err := base64.StdEncoding.DecodeString(artifact.GetData()[key])
+			Expect(err).NotTo(HaveOccurred())
+			var descriptors descriptor.FileDescriptorSet
+			Expect(proto.Unmarshal(rawDescriptors, &descriptors)).NotTo(HaveOccurred())
+			return &descriptors
+		}
+
+		It("stores the descriptor set in a configmap referenced by the transcoder", func() {
+			discover(newDiscovery(true))
+
+			transcoder := upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder()
+			Expect(transcoder).NotTo(BeNil())
+			Expect(transcoder.GetServices()).To(Equal([]string{"glootest.TestService", "grpc.health.v1.Health"}))
+			Expect(transcoder.GetAutoMapping()).To(BeTrue())
+			configMap := transcoder.GetProtoDescriptorConfigMap()
+			Expect(configMap.GetConfigMapRef()).To(Equal(&core.ResourceRef{Name: "grpc-upstream-descriptors", Namespace: "gloo-system"}))
+			Expect(configMap.GetKey()).To(Equal(DescriptorConfigMapKey))
+
+			descriptors := getDescriptorSet(configMap.GetConfigMapRef(), configMap.GetKey())
+			fileNames := make(map[string]int)
+			for _, file := range descriptors.GetFile() {
+				fileNames[file.GetName()]++
+			}
+			for name, count := range fileNames {
+				Expect(count).To(Equal(1), "file %s is in the descriptor set more than once", name)
+			}
+			Expect(fileNames).To(HaveKey("google/api/annotations.proto"))
+			Expect(fileNames).To(HaveKey("google/api/http.proto"))
+			Expect(fileNames).To(HaveKey("google/protobuf/descriptor.proto"))
+		})
+
+		It("keeps the google.api.http annotations of the methods", func() {
+			discover(newDiscovery(true))
+
+			configMap := upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder().GetProtoDescriptorConfigMap()
+			descriptors := getDescriptorSet(configMap.GetConfigMapRef(), configMap.GetKey())
+			var method *descriptor.MethodDescriptorProto
+			for _, file := range descriptors.GetFile() {
+				for _, svc := range file.GetService() {
+					if file.GetPackage() == "glootest" && svc.GetName() == "TestService" {
+						method = svc.GetMethod()[0]
+					}
+				}
+			}
+			Expect(method).NotTo(BeNil())
+			ext, err := proto.GetExtension(method.GetOptions(), annotations.E_Http)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ext.(*annotations.HttpRule).GetPost()).To(Equal("/test"))
+			Expect(ext.(*annotations.HttpRule).GetBody()).To(Equal("str"))
+		})
+
+		It("updates the configmap referenced by the upstream", func() {
+			ref := &core.ResourceRef{Name: "my-descriptors", Namespace: "gloo-system"}
+			_, err := artifactClient.Write(&v1.Artifact{
+				Metadata: &core.Metadata{Name: ref.GetName(), Namespace: ref.GetNamespace()},
+				Data:     map[string]string{"desc": "b2xk"},
+			}, clients.WriteOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			upstream.GetStatic().ServiceSpec = &plugins.ServiceSpec{
+				PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
+					GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+						DescriptorSet: &grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap{
+							ProtoDescriptorConfigMap: &grpc_json.GrpcJsonTranscoder_DescriptorConfigMap{
+								ConfigMapRef: ref,
+								Key:          "desc",
+							},
+						},
+					},
+				},
+			}
+
+			discovery := newDiscovery(true)
+			Expect(discovery.IsFunctional()).To(BeTrue())
+			err = discovery.(*UpstreamFunctionDiscovery).DetectFunctionsOnce(ctx, serverUrl, func(mutator fds.UpstreamMutator) error {
+				return mutator(upstream)
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			transcoder := upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder()
+			Expect(transcoder.GetProtoDescriptorConfigMap().GetConfigMapRef()).To(Equal(ref))
+			Expect(transcoder.GetServices()).To(ContainElement("glootest.TestService"))
+			Expect(getDescriptorSet(ref, "desc").GetFile()).NotTo(BeEmpty())
+		})
+	})
+
+	Context("v1 reflection", func() {
+
+		BeforeEach(func() {
+			// serve only the v1 reflection API, by proxying it to the v1alpha API of the test server
+			alphaConn, err := grpc.Dial(serverUrl.Host, grpc.WithInsecure())
+			Expect(err).NotTo(HaveOccurred())
+			lis, err := net.Listen("tcp", "localhost:0")
+			Expect(err).NotTo(HaveOccurred())
+			grpcServer := grpc.NewServer()
+			serviceDesc := reflectpb.ServerReflection_ServiceDesc
+			serviceDesc.ServiceName = reflectionV1ServiceName
+			grpcServer.RegisterService(&serviceDesc, &reflectionProxy{client: reflectpb.NewServerReflectionClient(alphaConn)})
+			go func() {
+				defer GinkgoRecover()
+				_ = grpcServer.Serve(lis)
+			}()
+			go func() {
+				<-ctx.Done()
+				grpcServer.Stop()
+				alphaConn.Close()
+			}()
+			serverUrl = &url.URL{Scheme: "http", Host: lis.Addr().String()}
+		})
+
+		It("discovers the services of servers which only implement the v1 API", func() {
+			discover(newDiscovery(true))
+
+			transcoder := upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder()
+			Expect(transcoder.GetServices()).To(ContainElement("glootest.TestService"))
+		})
+	})
+})
+
+type reflectionProxy struct {
+	client reflectpb.ServerReflectionClient
+}
+
+func (p *reflectionProxy) ServerReflectionInfo(stream reflectpb.ServerReflection_ServerReflectionInfoServer) error {
+	upstreamStream, err := p.client.ServerReflectionInfo(stream.Context())
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		if err := upstreamStream.Send(req); err != nil {
+			return err
+		}
+		resp, err := upstreamStream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
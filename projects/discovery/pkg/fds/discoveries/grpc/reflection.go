@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+const (
+	reflectionV1ServiceName      = "grpc.reflection.v1.ServerReflection"
+	reflectionV1AlphaServiceName = "grpc.reflection.v1alpha.ServerReflection"
+)
+
+// the reflection services are not discovered as functions of the upstream
+var reflectionServiceNames = map[string]bool{
+	reflectionV1ServiceName:      true,
+	reflectionV1AlphaServiceName: true,
+}
+
+// The messages of the v1 reflection API are identical to the ones of the v1alpha API, only the service name differs.
+// This client sends the v1alpha messages to the v1 service, so that the reflection client can use either API.
+type serverReflectionV1Client struct {
+	cc *grpc.ClientConn
+}
+
+func newServerReflectionV1Client(cc *grpc.ClientConn) reflectpb.ServerReflectionClient {
+	return &serverReflectionV1Client{cc: cc}
+}
+
+var serverReflectionInfoStreamDesc = &grpc.StreamDesc{
+	StreamName:    "ServerReflectionInfo",
+	ServerStreams: true,
+	ClientStreams: true,
+}
+
+func (c *serverReflectionV1Client) ServerReflectionInfo(ctx context.Context, opts ...grpc.CallOption) (reflectpb.ServerReflection_ServerReflectionInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, serverReflectionInfoStreamDesc, "/"+reflectionV1ServiceName+"/ServerReflectionInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &serverReflectionInfoClient{ClientStream: stream}, nil
+}
+
+type serverReflectionInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverReflectionInfoClient) Send(m *reflectpb.ServerReflectionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverReflectionInfoClient) Recv() (*reflectpb.ServerReflectionResponse, error) {
+	m := new(reflectpb.ServerReflectionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,356 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	"sigs.k8s.io/yaml"
+
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+)
+
+const jsonContentType = "application/json"
+
+// The subset of an OpenAPI 3.0 or 3.1 document which is used to generate the REST functions of an upstream.
+// The document is decoded directly, as the Swagger library only supports Swagger 2.0, and OpenAPI 3.1 schemas can
+// have several types.
+type openAPIDoc struct {
+	OpenAPI    string                      `json:"openapi"`
+	Servers    []*openAPIServer            `json:"servers"`
+	Paths      map[string]*openAPIPathItem `json:"paths"`
+	Components *openAPIComponents          `json:"components"`
+}
+
+type openAPIServer struct {
+	URL       string                            `json:"url"`
+	Variables map[string]*openAPIServerVariable `json:"variables"`
+}
+
+type openAPIServerVariable struct {
+	Default string `json:"default"`
+}
+
+type openAPIPathItem struct {
+	Servers    []*openAPIServer    `json:"servers"`
+	Parameters []*openAPIParameter `json:"parameters"`
+	Get        *openAPIOperation   `json:"get"`
+	Put        *openAPIOperation   `json:"put"`
+	Post       *openAPIOperation   `json:"post"`
+	Delete     *openAPIOperation   `json:"delete"`
+	Options    *openAPIOperation   `json:"options"`
+	Head       *openAPIOperation   `json:"head"`
+	Patch      *openAPIOperation   `json:"patch"`
+}
+
+type openAPIOperation struct {
+	OperationID string              `json:"operationId"`
+	Servers     []*openAPIServer    `json:"servers"`
+	Parameters  []*openAPIParameter `json:"parameters"`
+	RequestBody *openAPIRequestBody `json:"requestBody"`
+}
+
+type openAPIParameter struct {
+	Ref  string `json:"$ref"`
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+type openAPIRequestBody struct {
+	Ref     string                       `json:"$ref"`
+	Content map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref        string                    `json:"$ref"`
+	Type       openAPISchemaType         `json:"type"`
+	Properties map[string]*openAPISchema `json:"properties"`
+	AllOf      []*openAPISchema          `json:"allOf"`
+	Default    interface{}               `json:"default"`
+}
+
+// OpenAPI 3.0 schemas have a single type, OpenAPI 3.1 schemas can have several, e.g. `[string, "null"]`
+type openAPISchemaType []string
+
+func (t *openAPISchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = openAPISchemaType{single}
+		return nil
+	}
+	var several []string
+	if err := json.Unmarshal(data, &several); err != nil {
+		return err
+	}
+	*t = several
+	return nil
+}
+
+func (t openAPISchemaType) contains(typ string) bool {
+	for _, s := range t {
+		if s == typ {
+			return true
+		}
+	}
+	return false
+}
+
+type openAPIComponents struct {
+	Schemas       map[string]*openAPISchema      `json:"schemas"`
+	Parameters    map[string]*openAPIParameter   `json:"parameters"`
+	RequestBodies map[string]*openAPIRequestBody `json:"requestBodies"`
+}
+
+// Returns true if the JSON or YAML document is an OpenAPI 3 document rather than a Swagger 2.0 document.
+func isOpenAPI3Doc(docBytes []byte) bool {
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := yaml.Unmarshal(docBytes, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+func parseOpenAPI3Doc(docBytes []byte) (*openAPIDoc, error) {
+	var doc openAPIDoc
+	if err := yaml.Unmarshal(docBytes, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, errors.Errorf("unsupported openapi version %q", doc.OpenAPI)
+	}
+	return &doc, nil
+}
+
+func (doc *openAPIDoc) functions() (map[string]*transformation_plugins.TransformationTemplate, error) {
+	if doc.Paths == nil {
+		return nil, errors.Errorf("openapi spec paths was nil: %v", doc.Paths)
+	}
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for functionPath, pathItem := range doc.Paths {
+		if pathItem == nil {
+			continue
+		}
+		operations := []struct {
+			method    string
+			operation *openAPIOperation
+		}{
+			{"GET", pathItem.Get},
+			{"PUT", pathItem.Put},
+			{"POST", pathItem.Post},
+			{"DELETE", pathItem.Delete},
+			{"OPTIONS", pathItem.Options},
+			{"HEAD", pathItem.Head},
+			{"PATCH", pathItem.Patch},
+		}
+		for _, op := range operations {
+			if op.operation == nil {
+				continue
+			}
+			name, trans := doc.createFunctionForOperation(op.method, functionPath, pathItem, op.operation)
+			funcs[name] = trans
+		}
+	}
+	return funcs, nil
+}
+
+func (doc *openAPIDoc) createFunctionForOperation(method, functionPath string, pathItem *openAPIPathItem, operation *openAPIOperation) (string, *transformation_plugins.TransformationTemplate) {
+	var queryParams, headerParams []string
+	for _, param := range doc.operationParameters(pathItem, operation) {
+		// sort parameters by the template they will go into
+		switch param.In {
+		case "query":
+			queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+		case "header":
+			headerParams = append(headerParams, param.Name)
+		case "path", "cookie":
+			// the path parameters are already in the path template, and the cookies are sent by the client
+		}
+	}
+
+	var body *string
+	if schema := doc.requestBodySchema(operation.RequestBody); schema != nil {
+		tmp := doc.getBodyTemplate("", schema, map[string]bool{})
+		body = &tmp
+	}
+
+	fnName := operation.OperationID
+	if fnName == "" {
+		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
+	}
+
+	basePath := serversBasePath(operation.Servers, pathItem.Servers, doc.Servers)
+	return fnName, createFunctionTemplate(method, basePath+functionPath, queryParams, headerParams, body)
+}
+
+// Returns the parameters of the operation, which override the parameters of its path with the same name and location.
+func (doc *openAPIDoc) operationParameters(pathItem *openAPIPathItem, operation *openAPIOperation) []*openAPIParameter {
+	var params []*openAPIParameter
+	overridden := make(map[string]bool)
+	for _, param := range operation.Parameters {
+		if param = doc.resolveParameter(param); param != nil {
+			params = append(params, param)
+			overridden[param.In+"/"+param.Name] = true
+		}
+	}
+	for _, param := range pathItem.Parameters {
+		if param = doc.resolveParameter(param); param != nil && !overridden[param.In+"/"+param.Name] {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+func (doc *openAPIDoc) resolveParameter(param *openAPIParameter) *openAPIParameter {
+	if param == nil || param.Ref == "" {
+		return param
+	}
+	return doc.Components.parameter(strings.TrimPrefix(param.Ref, "#/components/parameters/"))
+}
+
+// Returns the JSON schema of the request body, or nil if the operation does not accept a JSON body.
+func (doc *openAPIDoc) requestBodySchema(requestBody *openAPIRequestBody) *openAPISchema {
+	if requestBody != nil && requestBody.Ref != "" {
+		requestBody = doc.Components.requestBody(strings.TrimPrefix(requestBody.Ref, "#/components/requestBodies/"))
+	}
+	if requestBody == nil {
+		return nil
+	}
+	mediaType, ok := requestBody.Content[jsonContentType]
+	if !ok || mediaType == nil {
+		return nil
+	}
+	return mediaType.Schema
+}
+
+// Follows the reference of the schema, and merges the properties of its allOf schemas.
+// Returns nil if the schema is a reference which was already resolved by a parent, to stop recursive schemas.
+func (doc *openAPIDoc) resolveSchema(schema *openAPISchema, resolvedRefs map[string]bool) *openAPISchema {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		if resolvedRefs[schema.Ref] {
+			return nil
+		}
+		resolvedRefs[schema.Ref] = true
+		return doc.resolveSchema(doc.Components.schema(strings.TrimPrefix(schema.Ref, "#/components/schemas/")), resolvedRefs)
+	}
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
+	merged := &openAPISchema{
+		Type:       schema.Type,
+		Default:    schema.Default,
+		Properties: make(map[string]*openAPISchema),
+	}
+	for key, prop := range schema.Properties {
+		merged.Properties[key] = prop
+	}
+	for _, subSchema := range schema.AllOf {
+		subSchema = doc.resolveSchema(subSchema, resolvedRefs)
+		if subSchema == nil {
+			continue
+		}
+		for key, prop := range subSchema.Properties {
+			merged.Properties[key] = prop
+		}
+		if len(merged.Type) == 0 {
+			merged.Type = subSchema.Type
+		}
+	}
+	return merged
+}
+
+func (doc *openAPIDoc) getBodyTemplate(parent string, schema *openAPISchema, resolvedRefs map[string]bool) string {
+	var fields []string
+	for key, prop := range doc.resolveSchema(schema, resolvedRefs).getProperties() {
+		paramName := key
+		if parent != "" {
+			paramName = parent + "." + key
+		}
+		var defaultValue string
+		if prop.Default != nil {
+			defaultValue = fmt.Sprintf("%v", prop.Default)
+		}
+		defaultValue = fmt.Sprintf("\"%v\"", defaultValue)
+
+		// each branch of the schema resolves its own references
+		propRefs := make(map[string]bool, len(resolvedRefs))
+		for ref := range resolvedRefs {
+			propRefs[ref] = true
+		}
+		resolved := doc.resolveSchema(prop, propRefs)
+		switch {
+		case resolved != nil && len(resolved.Properties) > 0:
+			fields = append(fields, fmt.Sprintf(`"%v": %v`, key, doc.getBodyTemplate(paramName, resolved, propRefs)))
+		case resolved != nil && resolved.Type.contains("string"):
+			// string needs escaping
+			fields = append(fields, fmt.Sprintf(`"%v": "{{ default(%v, %v)}}"`, key, paramName, defaultValue))
+		default:
+			fields = append(fields, fmt.Sprintf(`"%v": {{ default(%v, %v) }}`, key, paramName, defaultValue))
+		}
+	}
+	// idempotency
+	sort.Strings(fields)
+	return "{" + strings.Join(fields, ",") + "}"
+}
+
+func (s *openAPISchema) getProperties() map[string]*openAPISchema {
+	if s == nil {
+		return nil
+	}
+	return s.Properties
+}
+
+func (c *openAPIComponents) schema(name string) *openAPISchema {
+	if c == nil {
+		return nil
+	}
+	return c.Schemas[name]
+}
+
+func (c *openAPIComponents) parameter(name string) *openAPIParameter {
+	if c == nil {
+		return nil
+	}
+	return c.Parameters[name]
+}
+
+func (c *openAPIComponents) requestBody(name string) *openAPIRequestBody {
+	if c == nil {
+		return nil
+	}
+	return c.RequestBodies[name]
+}
+
+// Returns the path of the first server of the most specific list of servers, without its trailing slash.
+// The servers of an operation override the servers of its path, which override the servers of the document.
+func serversBasePath(serverLists ...[]*openAPIServer) string {
+	for _, servers := range serverLists {
+		if len(servers) == 0 || servers[0] == nil {
+			continue
+		}
+		server := servers[0]
+		serverUrl := server.URL
+		for name, variable := range server.Variables {
+			if variable != nil {
+				serverUrl = strings.Replace(serverUrl, "{"+name+"}", variable.Default, -1)
+			}
+		}
+		parsed, err := url.Parse(serverUrl)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(parsed.Path, "/")
+	}
+	return ""
+}
@@ -0,0 +1,146 @@
+package swagger
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+)
+
+var _ = Describe("OpenAPI 3", func() {
+
+	const petstore = `
+openapi: 3.1.0
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+- url: https://petstore.example.com/{basePath}/
+  variables:
+    basePath:
+      default: api/v3
+paths:
+  /pets/{petId}:
+    parameters:
+    - $ref: '#/components/parameters/PetId'
+    - name: x-tenant
+      in: header
+    get:
+      operationId: getPet
+      parameters:
+      - name: fields
+        in: query
+    put:
+      operationId: updatePet
+      servers:
+      - url: /v4
+      requestBody:
+        $ref: '#/components/requestBodies/Pet'
+  /pets:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              allOf:
+              - $ref: '#/components/schemas/Pet'
+              - properties:
+                  count:
+                    type: integer
+                    default: 1
+components:
+  parameters:
+    PetId:
+      name: petId
+      in: path
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: [string, "null"]
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      properties:
+        id:
+          type: integer
+        pet:
+          $ref: '#/components/schemas/Pet'
+`
+
+	It("detects the version of the document", func() {
+		Expect(isOpenAPI3Doc([]byte(petstore))).To(BeTrue())
+		Expect(isOpenAPI3Doc([]byte(`{"openapi": "3.0.3", "paths": {}}`))).To(BeTrue())
+		Expect(isOpenAPI3Doc([]byte(`{"swagger": "2.0", "paths": {}}`))).To(BeFalse())
+	})
+
+	It("creates a function for each operation", func() {
+		funcs, err := functionsForDoc([]byte(petstore))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(funcs).To(HaveLen(3))
+		Expect(funcs).To(HaveKey("getPet"))
+		Expect(funcs).To(HaveKey("updatePet"))
+		Expect(funcs).To(HaveKey("post.pets"))
+	})
+
+	It("templates the path, query and header parameters under the base path of the servers", func() {
+		funcs, err := functionsForDoc([]byte(petstore))
+		Expect(err).NotTo(HaveOccurred())
+
+		headers := funcs["getPet"].GetHeaders()
+		Expect(headers[":method"].GetText()).To(Equal("GET"))
+		Expect(headers[":path"].GetText()).To(Equal(`/api/v3/pets/{{ default(petId, "") }}?fields={{default(fields, "")}}`))
+		Expect(headers["x-tenant"].GetText()).To(Equal(`{{default(x-tenant, "")}}`))
+		Expect(funcs["getPet"].GetBody().GetText()).To(BeEmpty())
+
+		Expect(funcs["updatePet"].GetHeaders()[":path"].GetText()).To(Equal(`/v4/pets/{{ default(petId, "") }}`))
+	})
+
+	It("templates the JSON request bodies from their schemas", func() {
+		funcs, err := functionsForDoc([]byte(petstore))
+		Expect(err).NotTo(HaveOccurred())
+
+		update := funcs["updatePet"]
+		Expect(update.GetHeaders()["content-type"].GetText()).To(Equal("application/json"))
+		Expect(update.GetBodyTransformation()).To(Equal(&transformation_plugins.TransformationTemplate_Body{
+			Body: &transformation_plugins.InjaTemplate{
+				Text: `{"name": "{{ default(name, "")}}","owner": {"id": {{ default(owner.id, "") }},"pet": {{ default(owner.pet, "") }}}}`,
+			},
+		}))
+
+		Expect(funcs["post.pets"].GetBody().GetText()).To(Equal(
+			`{"count": {{ default(count, "1") }},"name": "{{ default(name, "")}}","owner": {"id": {{ default(owner.id, "") }},"pet": {{ default(owner.pet, "") }}}}`))
+	})
+
+	It("passes through the request bodies which are not JSON", func() {
+		funcs, err := functionsForDoc([]byte(`{
+			"openapi": "3.0.3",
+			"paths": {
+				"/upload": {
+					"post": {
+						"operationId": "upload",
+						"requestBody": {"content": {"application/octet-stream": {"schema": {"type": "string"}}}}
+					}
+				}
+			}
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(funcs["upload"].GetPassthrough()).NotTo(BeNil())
+	})
+
+	It("still supports swagger 2.0 documents", func() {
+		funcs, err := functionsForDoc([]byte(`{
+			"swagger": "2.0",
+			"basePath": "/api",
+			"paths": {"/pets": {"get": {"operationId": "listPets"}}}
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(funcs["listPets"].GetHeaders()[":path"].GetText()).To(Equal("/api/pets"))
+	})
+})
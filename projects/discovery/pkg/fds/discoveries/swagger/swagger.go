@@ -24,12 +24,16 @@ import (
 	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 )
 
+// the paths at which services commonly serve their Swagger 2.0 or OpenAPI 3 document
 var commonSwaggerURIs = []string{
 	"/swagger.json",
 	"/swagger/docs/v1",
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.json",
+	"/openapi.yaml",
+	"/v3/api-docs",
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -128,7 +132,7 @@ func (f *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 		}
 		// might have found a swagger service
 		if res.StatusCode == http.StatusOK {
-			if _, err := RetrieveSwaggerDocFromUrl(ctx, url); err != nil {
+			if _, err := retrieveFunctionsFromUrl(ctx, url); err != nil {
 				// first check if this is a context error
 				if ctx.Err() != nil {
 					return nil, multierror.Append(err, ctx.Err())
@@ -181,11 +185,11 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, _ *url.U
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
 	err := contextutils.NewExponentialBackoff(contextutils.ExponentialBackoff{}).Backoff(ctx, func(ctx context.Context) error {
 
-		spec, err := RetrieveSwaggerDocFromUrl(ctx, url)
+		funcs, err := retrieveFunctionsFromUrl(ctx, url)
 		if err != nil {
 			return err
 		}
-		err = f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+		err = updateFunctions(funcs, updatecb)
 		if err != nil {
 			return err
 		}
@@ -195,12 +199,8 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform Swagger function discovery for upstream %s in namespace %s, error: ",
-			f.upstream.GetMetadata().GetName(),
-			f.upstream.GetMetadata().GetNamespace(),
-			err.Error(),
-		)
-		// ignore other errors as we would like to continue forever.
+		// the updater records the error on the upstream and retries with a backoff
+		return err
 	}
 	if err := contextutils.Sleep(ctx, f.functionPollTime); err != nil {
 		return err
@@ -209,26 +209,46 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context, document string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
-	spec, err := parseSwaggerDoc([]byte(document))
+	funcs, err := functionsForDoc([]byte(document))
 	if err != nil {
 		return err
 	}
-	return f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+	if err := updateFunctions(funcs, updatecb); err != nil {
+		return err
+	}
+	// the inline document does not change until the upstream does, so there is no need to poll it often
+	return contextutils.Sleep(ctx, f.functionPollTime)
+}
+
+// Returns the functions of a Swagger 2.0 or OpenAPI 3.0/3.1 document, in JSON or YAML.
+func functionsForDoc(docBytes []byte) (map[string]*transformation_plugins.TransformationTemplate, error) {
+	if isOpenAPI3Doc(docBytes) {
+		doc, err := parseOpenAPI3Doc(docBytes)
+		if err != nil {
+			return nil, err
+		}
+		return doc.functions()
+	}
+	swaggerSpec, err := parseSwaggerDoc(docBytes)
+	if err != nil {
+		return nil, err
+	}
+	return functionsForSwaggerSpec(swaggerSpec)
 }
 
-func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context, swaggerSpec *openapi.Swagger, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+func functionsForSwaggerSpec(swaggerSpec *openapi.Swagger) (map[string]*transformation_plugins.TransformationTemplate, error) {
 	var consumesJson bool
 	if len(swaggerSpec.Consumes) == 0 {
 		consumesJson = true
 	}
 	for _, contentType := range swaggerSpec.Consumes {
-		if contentType == "application/json" {
+		if contentType == jsonContentType {
 			consumesJson = true
 			break
 		}
 	}
 	if !consumesJson {
-		return errors.Errorf("swagger function discovery uses content type application/json; "+
+		return nil, errors.Errorf("swagger function discovery uses content type application/json; "+
 			"available: %v", swaggerSpec.Consumes)
 	}
 	// TODO: when response transformation is done, look at produces as well
@@ -236,13 +256,16 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
 
 	if swaggerSpec.Paths == nil {
-		return errors.Errorf("swagger spec paths was nil: %v", swaggerSpec.Paths)
+		return nil, errors.Errorf("swagger spec paths was nil: %v", swaggerSpec.Paths)
 	}
 
 	for functionPath, pathItem := range swaggerSpec.Paths.Paths {
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
+	return funcs, nil
+}
 
+func updateFunctions(funcs map[string]*transformation_plugins.TransformationTemplate, updatecb func(fds.UpstreamMutator) error) error {
 	return updatecb(func(u *v1.Upstream) error {
 		upstreamSpec, ok := u.GetUpstreamType().(v1.ServiceSpecMutator)
 		if !ok {
@@ -267,6 +290,14 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 	})
 }
 
+func retrieveFunctionsFromUrl(ctx context.Context, url string) (map[string]*transformation_plugins.TransformationTemplate, error) {
+	docBytes, err := LoadFromFileOrHTTP(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading swagger doc from url")
+	}
+	return functionsForDoc(docBytes)
+}
+
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
 	docBytes, err := LoadFromFileOrHTTP(ctx, url)
 	if err != nil {
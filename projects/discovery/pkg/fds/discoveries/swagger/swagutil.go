@@ -61,7 +61,18 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		}
 	}
 
-	path := swaggerPathToJinjaTemplate(basePath + functionPath)
+	fnName := operation.ID
+	if fnName == "" {
+		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
+	}
+
+	return fnName, createFunctionTemplate(method, basePath+functionPath, queryParams, headerParams, body)
+}
+
+// Returns the transformation which turns a request to the function into a request to the REST endpoint, shared by
+// Swagger 2.0 and OpenAPI 3 documents.
+func createFunctionTemplate(method, functionPath string, queryParams, headerParams []string, body *string) *transformation_plugins.TransformationTemplate {
+	path := swaggerPathToJinjaTemplate(functionPath)
 	if len(queryParams) > 0 {
 		path += "?" + strings.Join(queryParams, "&")
 	}
@@ -72,11 +83,6 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		headersTemplate[name] = fmt.Sprintf("{{default(%v, \"\")}}", name)
 	}
 
-	fnName := operation.ID
-	if fnName == "" {
-		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
-	}
-
 	// build transformation:
 
 	headerTemplatesForTransform := make(map[string]*transformation_plugins.InjaTemplate)
@@ -107,7 +113,7 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 
 	// this function doesn't request any kind of transformation
 	if !needsTransformation {
-		return fnName, nil
+		return nil
 	}
 	transTemplate := &transformation_plugins.TransformationTemplate{
 		Headers: headerTemplatesForTransform,
@@ -125,7 +131,7 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 			}}
 	}
 
-	return fnName, transTemplate
+	return transTemplate
 }
 
 func getBodyTemplate(parent string, schema spec.SchemaProps, definitions spec.Definitions) string {
@@ -14,7 +14,8 @@ import (
 type UpstreamMutator func(*v1.Upstream) error
 
 type AdditionalClients struct {
-	GraphqlClient v1beta1.GraphQLApiClient
+	GraphqlClient  v1beta1.GraphQLApiClient
+	ArtifactClient v1.ArtifactClient
 }
 
 /*
@@ -50,6 +50,9 @@ const (
 )
 
 func selectUpstreamsForDiscovery(fdsMode v1.Settings_DiscoveryOptions_FdsMode, upstreams v1.UpstreamList, namespaces kubernetes.KubeNamespaceList) v1.UpstreamList {
+	// the opt-out annotation of an upstream takes precedence over any label of the upstream or its namespace
+	upstreams = withoutOptedOutUpstreams(upstreams)
+
 	whitelistNamespaces := sets.NewString()
 	blacklistNamespaces := sets.NewString()
 	for _, namespace := range namespaces {
@@ -71,6 +74,18 @@ func selectUpstreamsForDiscovery(fdsMode v1.Settings_DiscoveryOptions_FdsMode, u
 	return selectUpstreamsBlacklist(upstreams, blacklistNamespaces)
 }
 
+// upstreams annotated with discovery.solo.io/function_discovery: disabled are never discovered,
+// e.g. to stop discovery for an upstream whose functions cannot be detected
+func withoutOptedOutUpstreams(upstreams v1.UpstreamList) v1.UpstreamList {
+	var selected v1.UpstreamList
+	for _, us := range upstreams {
+		if !isBlacklisted(us.GetMetadata().GetAnnotations()) {
+			selected = append(selected, us)
+		}
+	}
+	return selected
+}
+
 func isBlacklistedUpstream(us *v1.Upstream) bool {
 	// Fall back to Metadata labels to support legacy Upstreams if needed
 	return isBlacklisted(us.GetDiscoveryMetadata().GetLabels()) || isBlacklisted(us.GetMetadata().GetLabels())
@@ -128,14 +143,15 @@ func selectUpstreamsWhitelist(upstreams v1.UpstreamList, whitelistedNamespaces,
 		blacklisted := isBlacklistedUpstream(us)
 		whitelisted := isWhitelistedUpstream(us)
 
-		// if an upstream is AWS, then include it only if it would be included in blacklist mode (https://github.com/solo-io/solo-projects/issues/1339)
-		// otherwise, include the upstream only if it is *not* AWS, and either condition holds:
+		// if an upstream is AWS or Azure, then include it only if it would be included in blacklist mode (https://github.com/solo-io/solo-projects/issues/1339)
+		// otherwise, include the upstream only if it is *not* AWS or Azure, and either condition holds:
 		//   - the upstream is in a whitelisted namespace and not explicitly blacklisted
 		//   - the upstream itself is explicitly whitelisted
-		shouldIncludeAwsUpstream := us.GetAws() != nil && shouldIncludeUpstreamInBlacklistMode(us, blacklistedNamespaces)
-		shouldIncludeNonAwsUpstream := us.GetAws() == nil && ((inWhitelistedNamespace && !blacklisted) || whitelisted)
+		isCloudFunctionUpstream := us.GetAws() != nil || us.GetAzure() != nil
+		shouldIncludeCloudFunctionUpstream := isCloudFunctionUpstream && shouldIncludeUpstreamInBlacklistMode(us, blacklistedNamespaces)
+		shouldIncludeOtherUpstream := !isCloudFunctionUpstream && ((inWhitelistedNamespace && !blacklisted) || whitelisted)
 
-		if shouldIncludeAwsUpstream || shouldIncludeNonAwsUpstream {
+		if shouldIncludeCloudFunctionUpstream || shouldIncludeOtherUpstream {
 			selected = append(selected, us)
 		}
 	}
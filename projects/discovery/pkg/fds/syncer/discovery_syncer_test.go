@@ -12,6 +12,7 @@ import (
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 )
@@ -61,8 +62,14 @@ var _ = Describe("selectUpstreamsForDiscovery", func() {
 	enabledAwsUs3 := makeAwsUpstream("enabledAwsUs3", "other-namespace", enabledLabels)
 	explicitlyEnabledUs1 := makeKubeUpstream("explicitlyEnabledUs1", explicitlyEnabledNs.Name, nil)
 	explicitlyEnabledUs2 := makeKubeUpstream("explicitlyEnabledUs2", enabledNs.Name, enabledLabels)
+	enabledAzureUs := makeAzureUpstream("enabledAzureUs", enabledNs.Name, nil)
+	disabledAzureUs := makeAzureUpstream("disabledAzureUs", enabledNs.Name, disabledLabels)
+	optedOutUs := makeKubeUpstream("optedOutUs", explicitlyEnabledNs.Name, enabledLabels)
+	optedOutUs.GetMetadata().Annotations = disabledLabels
+	optedOutAwsUs := makeAwsUpstream("optedOutAwsUs", enabledNs.Name, nil)
+	optedOutAwsUs.GetMetadata().Annotations = disabledLabels
 
-	usList := gloov1.UpstreamList{disabledUs1, disabledUs2, disabledUs3, enabledUs1, enabledUs2, explicitlyEnabledUs1, explicitlyEnabledUs2, disabledAwsUs1, enabledAwsUs3, disabledAwsUs2, enabledAwsUs1, enabledAwsUs2}
+	usList := gloov1.UpstreamList{disabledUs1, disabledUs2, disabledUs3, enabledUs1, enabledUs2, explicitlyEnabledUs1, explicitlyEnabledUs2, disabledAwsUs1, enabledAwsUs3, disabledAwsUs2, enabledAwsUs1, enabledAwsUs2, optedOutUs, optedOutAwsUs, enabledAzureUs, disabledAzureUs}
 
 	var filtered gloov1.UpstreamList
 
@@ -80,6 +87,10 @@ var _ = Describe("selectUpstreamsForDiscovery", func() {
 		It("excludes upstreams whose namespace is kube-system", func() {
 			Expect(filtered).NotTo(ContainElement(disabledUs3))
 		})
+		It("excludes upstreams who have the disabled annotation", func() {
+			Expect(filtered).NotTo(ContainElement(optedOutUs))
+			Expect(filtered).NotTo(ContainElement(optedOutAwsUs))
+		})
 		It("includes upstreams in namespaces without disabled label", func() {
 			Expect(filtered).To(ContainElement(enabledUs1))
 			Expect(filtered).To(ContainElement(explicitlyEnabledUs2))
@@ -106,6 +117,10 @@ var _ = Describe("selectUpstreamsForDiscovery", func() {
 		It("excludes upstreams whose namespace is kube-system", func() {
 			Expect(filtered).NotTo(ContainElement(disabledUs3))
 		})
+		It("excludes upstreams who have the disabled annotation", func() {
+			Expect(filtered).NotTo(ContainElement(optedOutUs))
+			Expect(filtered).NotTo(ContainElement(optedOutAwsUs))
+		})
 		It("excludes upstreams in namespaces without disabled label", func() {
 			Expect(filtered).NotTo(ContainElement(enabledUs1))
 		})
@@ -126,6 +141,10 @@ var _ = Describe("selectUpstreamsForDiscovery", func() {
 			Expect(filtered).NotTo(ContainElement(disabledAwsUs1))
 			Expect(filtered).NotTo(ContainElement(disabledAwsUs2))
 		})
+		It("includes Azure upstreams as if they were in blacklist mode", func() {
+			Expect(filtered).To(ContainElement(enabledAzureUs))
+			Expect(filtered).NotTo(ContainElement(disabledAzureUs))
+		})
 	})
 
 	Context("RunFDS", func() {
@@ -187,3 +206,16 @@ func makeAwsUpstream(name, namespace string, labels map[string]string) *gloov1.U
 	}
 	return us
 }
+
+func makeAzureUpstream(name, namespace string, labels map[string]string) *gloov1.Upstream {
+	us := gloov1.NewUpstream(namespace, name)
+	us.UpstreamType = &gloov1.Upstream_Azure{
+		Azure: &azure.UpstreamSpec{
+			FunctionAppName: name,
+		},
+	}
+	us.DiscoveryMetadata = &gloov1.DiscoveryMetadata{
+		Labels: labels,
+	}
+	return us
+}
@@ -76,6 +76,13 @@ func RunFDSWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if err := graphqlClient.Register(); err != nil {
 		return err
 	}
+	artifactClient, err := v1.NewArtifactClient(watchOpts.Ctx, opts.Artifacts)
+	if err != nil {
+		return err
+	}
+	if err := artifactClient.Register(); err != nil {
+		return err
+	}
 
 	var nsClient skkube.KubeNamespaceClient
 	if opts.KubeClient != nil && opts.KubeCoreCache.NamespaceLister() != nil {
@@ -98,7 +105,7 @@ func RunFDSWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	functionalPlugins := GetFunctionDiscoveriesWithExtensions(opts, extensions)
 
 	// TODO(yuval-k): max Concurrency here
-	updater := fds.NewUpdater(watchOpts.Ctx, resolvers, graphqlClient, upstreamClient, 0, functionalPlugins)
+	updater := fds.NewUpdater(watchOpts.Ctx, resolvers, graphqlClient, artifactClient, upstreamClient, 0, functionalPlugins)
 	disc := fds.NewFunctionDiscovery(updater)
 
 	sync := NewDiscoverySyncer(disc, fdsMode)
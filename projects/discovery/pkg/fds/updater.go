@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
@@ -44,6 +46,7 @@ type Updater struct {
 
 	upstreamWriter UpstreamWriterClient
 	graphqlClient  v1beta1.GraphQLApiClient
+	artifactClient v1.ArtifactClient
 
 	maxInParallelSemaphore chan struct{}
 
@@ -64,7 +67,7 @@ func getConcurrencyChan(maxOnCurrency uint) chan struct{} {
 
 }
 
-func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.GraphQLApiClient, upstreamclient UpstreamWriterClient, maxconncurrency uint, functionalPlugins []FunctionDiscoveryFactory) *Updater {
+func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.GraphQLApiClient, artifactClient v1.ArtifactClient, upstreamclient UpstreamWriterClient, maxconncurrency uint, functionalPlugins []FunctionDiscoveryFactory) *Updater {
 	ctx = contextutils.WithLogger(ctx, "function-discovery-updater")
 	return &Updater{
 		logger:                 contextutils.LoggerFrom(ctx),
@@ -75,6 +78,7 @@ func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.Gr
 		maxInParallelSemaphore: getConcurrencyChan(maxconncurrency),
 		upstreamWriter:         upstreamclient,
 		graphqlClient:          graphqlClient,
+		artifactClient:         artifactClient,
 	}
 }
 
@@ -101,7 +105,8 @@ func (u *Updater) createDiscoveries(upstream *v1.Upstream) []UpstreamFunctionDis
 	var ret []UpstreamFunctionDiscovery
 	for _, e := range u.functionalPlugins {
 		ret = append(ret, e.NewFunctionDiscovery(upstream, AdditionalClients{
-			GraphqlClient: u.graphqlClient,
+			GraphqlClient:  u.graphqlClient,
+			ArtifactClient: u.artifactClient,
 		}))
 	}
 	return ret
@@ -300,27 +305,28 @@ func (u *updaterUpdater) Run() error {
 		return u.saveUpstream(m)
 	}
 
+	attemptTime := time.Now()
 	resolvedUrl, resolvedErr := u.parent.resolver.Resolve(u.upstream)
 	if len(discoveriesForUpstream) == 0 {
 		// TODO: this is probably not going to work unless the upstream type will also have the method required
 		_, ok := u.upstream.GetUpstreamType().(v1.ServiceSpecSetter)
 		if !ok {
 			// can't set a service spec - which is required from this point on, as heuristic detection requires spec
-			return errors.New("discovery not possible for upstream")
+			return u.recordDetectionFailure(attemptTime, errors.New("discovery not possible for upstream"), upstreamSave)
 		}
 
 		// if we are here it means that the service upstream doesn't have a spec
 		if resolvedErr != nil {
-			return resolvedErr
+			return u.recordDetectionFailure(attemptTime, resolvedErr, upstreamSave)
 		}
 		// try to detect the type
 		res, err := u.detectType(*resolvedUrl)
 		if err != nil {
-			if err == errorUndetectableUpstream {
-				// TODO(yuval-k): at this point all discoveries gave up.
-				// do we want to mark an upstream as undetected persistently so we do not detect it anymore?
+			if u.ctx.Err() != nil {
+				return err
 			}
-			return err
+			// at this point all discoveries gave up, until the upstream changes
+			return u.recordDetectionFailure(attemptTime, err, upstreamSave)
 		}
 		for _, r := range res {
 			discoveriesForUpstream = append(discoveriesForUpstream, r.fp)
@@ -334,27 +340,172 @@ func (u *updaterUpdater) Run() error {
 			})
 		}
 	}
-	logger := contextutils.LoggerFrom(u.ctx)
 	for _, discoveryForUpstream := range discoveriesForUpstream {
-		go func(d UpstreamFunctionDiscovery) {
-			for {
-				select {
-				case <-u.ctx.Done():
-					logger.Debugf("context done, stopping upstream discovery %T for upstream %s.%s",
-						d,
-						u.upstream.GetMetadata().GetName(),
-						u.upstream.GetMetadata().GetNamespace())
-					return
-				default:
-					// continue to detect functions, as you were
-				}
-				err := d.DetectFunctions(u.ctx, resolvedUrl, u.dependencies, upstreamSave)
-				if err != nil {
-					logger.Errorf("Error doing discovery %T: %s", d, err.Error())
-					return
-				}
-			}
-		}(discoveryForUpstream)
+		go u.runDiscovery(discoveryForUpstream, resolvedUrl, upstreamSave)
 	}
 	return nil
 }
+
+// runDiscovery detects the functions of the upstream until the context is done. Every attempt is recorded in the
+// function discovery status of the upstream, and failing attempts are retried with an exponential backoff.
+// The backoff is resumed from the status of the upstream, as the discovery restarts whenever the upstream changes.
+func (u *updaterUpdater) runDiscovery(d UpstreamFunctionDiscovery, resolvedUrl *url.URL, upstreamSave func(UpstreamMutator) error) {
+	logger := contextutils.LoggerFrom(u.ctx)
+	status := u.upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+	failures := status.GetConsecutiveFailures()
+	if status.GetError() != "" && status.GetNextAttemptTime() != nil {
+		if err := contextutils.Sleep(u.ctx, time.Until(status.GetNextAttemptTime().AsTime())); err != nil {
+			return
+		}
+	}
+	for {
+		select {
+		case <-u.ctx.Done():
+			logger.Debugf("context done, stopping upstream discovery %T for upstream %s.%s",
+				d,
+				u.upstream.GetMetadata().GetName(),
+				u.upstream.GetMetadata().GetNamespace())
+			return
+		default:
+			// continue to detect functions, as you were
+		}
+		attemptTime := time.Now()
+		err := d.DetectFunctions(u.ctx, resolvedUrl, u.dependencies, upstreamSave)
+		if u.ctx.Err() != nil {
+			continue
+		}
+		if err == nil {
+			failures = 0
+			if err := upstreamSave(recordDiscoverySuccess(attemptTime)); err != nil {
+				logger.Warnf("unable to record function discovery status for upstream %s in namespace %s, err: %s",
+					u.upstream.GetMetadata().GetName(),
+					u.upstream.GetMetadata().GetNamespace(),
+					err)
+			}
+			continue
+		}
+
+		failures++
+		backoff := discoveryBackoff(failures)
+		logger.Warnf("unable to perform function discovery %T for upstream %s in namespace %s, retrying in %s, err: %s",
+			d,
+			u.upstream.GetMetadata().GetName(),
+			u.upstream.GetMetadata().GetNamespace(),
+			backoff,
+			err)
+		if err := upstreamSave(recordDiscoveryFailure(attemptTime, err, failures, backoff)); err != nil {
+			logger.Warnf("unable to record function discovery status for upstream %s in namespace %s, err: %s",
+				u.upstream.GetMetadata().GetName(),
+				u.upstream.GetMetadata().GetNamespace(),
+				err)
+		}
+		if err := contextutils.Sleep(u.ctx, backoff); err != nil {
+			return
+		}
+	}
+}
+
+// recordDetectionFailure records in the status of the upstream that its functions cannot be discovered, and returns
+// the error. The type of the upstream is only detected again when the upstream changes, so the status is only
+// written when the error changes, or the upstream would be changed, and detected again, on every attempt.
+func (u *updaterUpdater) recordDetectionFailure(attemptTime time.Time, err error, upstreamSave func(UpstreamMutator) error) error {
+	saveErr := upstreamSave(func(upstream *v1.Upstream) error {
+		current := upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+		if current.GetError() == err.Error() && current.GetNextAttemptTime() == nil {
+			return nil
+		}
+		setFunctionDiscoveryStatus(upstream, &v1.FunctionDiscoveryStatus{
+			FunctionType:    functionTypeForUpstream(upstream),
+			LastAttemptTime: timestamppb.New(attemptTime),
+			LastSuccessTime: current.GetLastSuccessTime(),
+			Error:           err.Error(),
+		})
+		return nil
+	})
+	if saveErr != nil {
+		contextutils.LoggerFrom(u.ctx).Warnf("unable to record function discovery status for upstream %s in namespace %s, err: %s",
+			u.upstream.GetMetadata().GetName(),
+			u.upstream.GetMetadata().GetNamespace(),
+			saveErr)
+	}
+	return err
+}
+
+const (
+	initialDiscoveryBackoff = 10 * time.Second
+	maxDiscoveryBackoff     = 10 * time.Minute
+)
+
+// discoveryBackoff returns the time to wait after the given number of consecutive failures.
+func discoveryBackoff(failures uint32) time.Duration {
+	backoff := initialDiscoveryBackoff
+	for i := uint32(1); i < failures && backoff < maxDiscoveryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDiscoveryBackoff {
+		return maxDiscoveryBackoff
+	}
+	return backoff
+}
+
+func recordDiscoverySuccess(attemptTime time.Time) UpstreamMutator {
+	return func(upstream *v1.Upstream) error {
+		functionType := functionTypeForUpstream(upstream)
+		current := upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+		if current.GetError() == "" && current.GetFunctionType() == functionType && current.GetLastSuccessTime() != nil {
+			// nothing changed since the status was last written. the status is not rewritten on every
+			// successful attempt, so that a discovery polling for functions does not write the upstream on every poll
+			return nil
+		}
+		setFunctionDiscoveryStatus(upstream, &v1.FunctionDiscoveryStatus{
+			FunctionType:    functionType,
+			LastAttemptTime: timestamppb.New(attemptTime),
+			LastSuccessTime: timestamppb.New(attemptTime),
+		})
+		return nil
+	}
+}
+
+func recordDiscoveryFailure(attemptTime time.Time, err error, failures uint32, backoff time.Duration) UpstreamMutator {
+	return func(upstream *v1.Upstream) error {
+		setFunctionDiscoveryStatus(upstream, &v1.FunctionDiscoveryStatus{
+			FunctionType:        functionTypeForUpstream(upstream),
+			LastAttemptTime:     timestamppb.New(attemptTime),
+			LastSuccessTime:     upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus().GetLastSuccessTime(),
+			Error:               err.Error(),
+			ConsecutiveFailures: failures,
+			NextAttemptTime:     timestamppb.New(attemptTime.Add(backoff)),
+		})
+		return nil
+	}
+}
+
+func setFunctionDiscoveryStatus(upstream *v1.Upstream, status *v1.FunctionDiscoveryStatus) {
+	if upstream.GetDiscoveryMetadata() == nil {
+		upstream.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+	}
+	upstream.GetDiscoveryMetadata().FunctionDiscoveryStatus = status
+}
+
+// functionTypeForUpstream returns the type of functions discovered for the upstream, e.g. "aws" or "rest".
+func functionTypeForUpstream(upstream *v1.Upstream) string {
+	switch upstream.GetUpstreamType().(type) {
+	case *v1.Upstream_Aws:
+		return "aws"
+	case *v1.Upstream_Azure:
+		return "azure"
+	}
+	serviceSpecUpstream, ok := upstream.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return ""
+	}
+	switch serviceSpecUpstream.GetServiceSpec().GetPluginType().(type) {
+	case *plugins.ServiceSpec_Rest:
+		return "rest"
+	case *plugins.ServiceSpec_Grpc:
+		return "grpc"
+	case *plugins.ServiceSpec_GrpcJsonTranscoder:
+		return "grpcJsonTranscoder"
+	}
+	return ""
+}
@@ -7,6 +7,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -15,15 +17,28 @@ import (
 	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	aws_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	rest_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 )
 
-type testUpstreamWriterClient struct{}
+type testUpstreamWriterClient struct {
+	written atomic.Value
+}
 
 func (t *testUpstreamWriterClient) Write(resource *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	t.written.Store(proto.Clone(resource))
 	return resource, nil
 }
 
+func (t *testUpstreamWriterClient) lastWritten() *v1.Upstream {
+	us, _ := t.written.Load().(proto.Message)
+	if us == nil {
+		return nil
+	}
+	return us.(*v1.Upstream)
+}
+
 func (t *testUpstreamWriterClient) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
 	return nil, fmt.Errorf("test - no upstream")
 }
@@ -51,7 +66,8 @@ type testDiscovery struct {
 	detectFunctionsError       error
 	mutate                     UpstreamMutator
 
-	functionsCalled atomic.Value
+	functionsCalled      atomic.Value
+	detectFunctionsCalls int32
 }
 
 func NewTestDiscovery() *testDiscovery {
@@ -89,6 +105,7 @@ func (t *testDiscovery) DetectFunctions(ctx context.Context, url *url.URL, depen
 	fc := t.getFunctionsCalled()
 	fc.detectFunctions = true
 	t.setFunctionsCalled(fc)
+	atomic.AddInt32(&t.detectFunctionsCalls, 1)
 	if t.mutate != nil {
 		out(t.mutate)
 	}
@@ -127,7 +144,7 @@ var _ = Describe("Updater", func() {
 		}
 		testDiscovery1 = NewTestDiscovery()
 		testDiscovery2 = NewTestDiscovery()
-		updater = NewUpdater(ctx, resolver, nil, upstreamWriterClient, 0, []FunctionDiscoveryFactory{testDiscovery1, testDiscovery2})
+		updater = NewUpdater(ctx, resolver, nil, nil, upstreamWriterClient, 0, []FunctionDiscoveryFactory{testDiscovery1, testDiscovery2})
 		up = &v1.Upstream{
 			Metadata: &core_solo_io.Metadata{
 				Namespace: "ns",
@@ -177,4 +194,120 @@ var _ = Describe("Updater", func() {
 		Expect(fc.detectFunctions).To(BeTrue())
 	})
 
+	Context("function discovery status", func() {
+
+		BeforeEach(func() {
+			testDiscovery1.isUpstreamFunctionalResult = true
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{
+					ServiceSpec: &plugins.ServiceSpec{PluginType: &plugins.ServiceSpec_Rest{Rest: &rest_plugins_gloo_solo_io.ServiceSpec{}}},
+				},
+			}
+		})
+
+		It("should record a successful discovery", func() {
+			updater.UpstreamAdded(up)
+			Eventually(upstreamWriterClient.lastWritten, time.Second).ShouldNot(BeNil())
+			status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+			Expect(status.GetFunctionType()).To(Equal("rest"))
+			Expect(status.GetError()).To(BeEmpty())
+			Expect(status.GetConsecutiveFailures()).To(BeZero())
+			Expect(status.GetLastSuccessTime()).NotTo(BeNil())
+			Expect(status.GetLastSuccessTime()).To(Equal(status.GetLastAttemptTime()))
+		})
+
+		It("should record a failed discovery and back off", func() {
+			testDiscovery1.detectFunctionsError = fmt.Errorf("unreachable")
+			updater.UpstreamAdded(up)
+			Eventually(upstreamWriterClient.lastWritten, time.Second).ShouldNot(BeNil())
+			status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+			Expect(status.GetFunctionType()).To(Equal("rest"))
+			Expect(status.GetError()).To(Equal("unreachable"))
+			Expect(status.GetConsecutiveFailures()).To(Equal(uint32(1)))
+			Expect(status.GetLastSuccessTime()).To(BeNil())
+			Expect(status.GetNextAttemptTime().AsTime().Sub(status.GetLastAttemptTime().AsTime())).To(Equal(10 * time.Second))
+
+			Consistently(func() int32 {
+				return atomic.LoadInt32(&testDiscovery1.detectFunctionsCalls)
+			}, time.Second/10).Should(Equal(int32(1)))
+		})
+
+		It("should resume the backoff of the upstream", func() {
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{
+				FunctionDiscoveryStatus: &v1.FunctionDiscoveryStatus{
+					Error:               "unreachable",
+					ConsecutiveFailures: 3,
+					NextAttemptTime:     &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()},
+				},
+			}
+			updater.UpstreamAdded(up)
+			Consistently(func() int32 {
+				return atomic.LoadInt32(&testDiscovery1.detectFunctionsCalls)
+			}, time.Second/10).Should(BeZero())
+		})
+
+		It("should not rewrite the status of a successful discovery", func() {
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{
+				FunctionDiscoveryStatus: &v1.FunctionDiscoveryStatus{
+					FunctionType:    "rest",
+					LastAttemptTime: &timestamp.Timestamp{Seconds: time.Now().Add(-time.Hour).Unix()},
+					LastSuccessTime: &timestamp.Timestamp{Seconds: time.Now().Add(-time.Hour).Unix()},
+				},
+			}
+			updater.UpstreamAdded(up)
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&testDiscovery1.detectFunctionsCalls)
+			}, time.Second).Should(BeNumerically(">", 1))
+			Expect(upstreamWriterClient.lastWritten()).To(BeNil())
+		})
+
+		Context("when the type of the upstream cannot be detected", func() {
+
+			BeforeEach(func() {
+				testDiscovery1.isUpstreamFunctionalResult = false
+				up.UpstreamType = &v1.Upstream_Kube{
+					Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{},
+				}
+			})
+
+			It("should record the undetectable upstream", func() {
+				testDiscovery1.detectUpstreamTypeError = fmt.Errorf("not swagger")
+				testDiscovery2.detectUpstreamTypeError = fmt.Errorf("not grpc")
+				updater.UpstreamAdded(up)
+				Eventually(upstreamWriterClient.lastWritten, 5*time.Second).ShouldNot(BeNil())
+				status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+				Expect(status.GetError()).To(Equal("upstream type cannot be detected"))
+				Expect(status.GetLastAttemptTime()).NotTo(BeNil())
+				Expect(status.GetNextAttemptTime()).To(BeNil())
+			})
+
+			It("should record the resolution error", func() {
+				resolver.resolveError = fmt.Errorf("no such host")
+				updater.UpstreamAdded(up)
+				Eventually(upstreamWriterClient.lastWritten, time.Second).ShouldNot(BeNil())
+				status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+				Expect(status.GetError()).To(Equal("no such host"))
+				Expect(status.GetNextAttemptTime()).To(BeNil())
+			})
+
+			It("should record that discovery is not possible", func() {
+				up.UpstreamType = &v1.Upstream_Aws{
+					Aws: &aws_plugins_gloo_solo_io.UpstreamSpec{Region: "us-east-1"},
+				}
+				updater.UpstreamAdded(up)
+				Eventually(upstreamWriterClient.lastWritten, time.Second).ShouldNot(BeNil())
+				status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+				Expect(status.GetError()).To(Equal("discovery not possible for upstream"))
+			})
+
+			It("should not rewrite the same error", func() {
+				resolver.resolveError = fmt.Errorf("no such host")
+				up.DiscoveryMetadata = &v1.DiscoveryMetadata{
+					FunctionDiscoveryStatus: &v1.FunctionDiscoveryStatus{Error: "no such host"},
+				}
+				updater.UpstreamAdded(up)
+				Consistently(upstreamWriterClient.lastWritten, time.Second/10).Should(BeNil())
+			})
+		})
+	})
 })
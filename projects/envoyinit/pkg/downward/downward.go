@@ -2,10 +2,12 @@ package downward
 
 import (
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // read downward api:
@@ -18,7 +20,7 @@ func CreateLocationReader(basedir string) func(string) ([]byte, error) {
 }
 
 func RetrieveDownwardAPI() DownwardAPI {
-	return RetrieveDownwardAPIFrom(CreateLocationReader("/etc/podinfo/"), os.Getenv)
+	return RetrieveDownwardAPIWithNodeZoneFrom(CreateLocationReader("/etc/podinfo/"), os.Getenv, KubeNodeZone)
 }
 
 func TestNeededDownwardAPI() *TestWhichIsNeedDownwardAPI {
@@ -34,6 +36,7 @@ type TestWhichIsNeedDownwardAPI struct {
 
 	IsNodeName bool
 	IsNodeIp   bool
+	IsNodeZone bool
 
 	IsPodLabels      bool
 	IsPodAnnotations bool
@@ -74,6 +77,11 @@ func (td *TestWhichIsNeedDownwardAPI) NodeIp() string {
 	return ""
 }
 
+func (td *TestWhichIsNeedDownwardAPI) NodeZone() string {
+	td.IsNodeZone = true
+	return ""
+}
+
 func (td *TestWhichIsNeedDownwardAPI) PodLabels() map[string]string {
 	td.IsPodLabels = true
 	return map[string]string{}
@@ -85,6 +93,17 @@ func (td *TestWhichIsNeedDownwardAPI) PodAnnotations() map[string]string {
 }
 
 func RetrieveDownwardAPIFrom(read func(string) ([]byte, error), getenv func(string) string) DownwardAPI {
+	return RetrieveDownwardAPIWithNodeZoneFrom(read, getenv, nil)
+}
+
+// RetrieveDownwardAPIWithNodeZoneFrom is like RetrieveDownwardAPIFrom, and looks up the zone of the node of the pod
+// with lookupNodeZone when the NODE_ZONE environment variable is not set, as the downward API does not expose the
+// labels of nodes.
+func RetrieveDownwardAPIWithNodeZoneFrom(
+	read func(string) ([]byte, error),
+	getenv func(string) string,
+	lookupNodeZone func(nodeName string) (string, error),
+) DownwardAPI {
 	// read annotations
 	var ret downwardInjectable
 	if labels, err := read("labels"); err == nil {
@@ -100,6 +119,8 @@ func RetrieveDownwardAPIFrom(read func(string) ([]byte, error), getenv func(stri
 
 	ret.nodeName = getenv("NODE_NAME")
 	ret.nodeIp = getenv("NODE_IP")
+	ret.nodeZone = getenv("NODE_ZONE")
+	ret.lookupNodeZone = lookupNodeZone
 
 	ret.podUID = getenv("POD_UID")
 	ret.podSvcAccount = getenv("POD_SVCACCNT")
@@ -117,6 +138,10 @@ type downwardInjectable struct {
 	nodeIp         string
 	podLabels      map[string]string
 	podAnnotations map[string]string
+
+	nodeZone       string
+	lookupNodeZone func(nodeName string) (string, error)
+	nodeZoneOnce   sync.Once
 }
 
 func (di *downwardInjectable) PodName() string                   { return di.podName }
@@ -129,6 +154,23 @@ func (di *downwardInjectable) NodeIp() string                    { return di.nod
 func (di *downwardInjectable) PodLabels() map[string]string      { return di.podLabels }
 func (di *downwardInjectable) PodAnnotations() map[string]string { return di.podAnnotations }
 
+// NodeZone looks up the zone of the node the first time it is used, so that only the bootstrap configurations that
+// need it require the pod to read its node.
+func (di *downwardInjectable) NodeZone() string {
+	di.nodeZoneOnce.Do(func() {
+		if di.nodeZone != "" || di.nodeName == "" || di.lookupNodeZone == nil {
+			return
+		}
+		zone, err := di.lookupNodeZone(di.nodeName)
+		if err != nil {
+			log.Printf("failed to look up the zone of node %s: %v", di.nodeName, err)
+			return
+		}
+		di.nodeZone = zone
+	})
+	return di.nodeZone
+}
+
 func parse(data []byte) map[string]string {
 	m := map[string]string{}
 
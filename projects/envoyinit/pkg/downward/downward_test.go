@@ -1,6 +1,8 @@
 package downward_test
 
 import (
+	"github.com/rotisserie/eris"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -85,11 +87,58 @@ var _ = Describe("Downward", func() {
 		test(res)
 	})
 
+	Context("node zone", func() {
+
+		var lookups []string
+		lookupNodeZone := func(nodeName string) (string, error) {
+			lookups = append(lookups, nodeName)
+			return "us-east-1a", nil
+		}
+
+		BeforeEach(func() {
+			lookups = nil
+			env["NODE_NAME"] = "nodename"
+		})
+
+		It("should look up the zone of the node once, when needed", func() {
+			res := RetrieveDownwardAPIWithNodeZoneFrom(reader, envreader, lookupNodeZone)
+			Expect(lookups).To(BeEmpty())
+
+			Expect(res.NodeZone()).To(Equal("us-east-1a"))
+			Expect(res.NodeZone()).To(Equal("us-east-1a"))
+			Expect(lookups).To(Equal([]string{"nodename"}))
+		})
+
+		It("should prefer the NODE_ZONE env var", func() {
+			env["NODE_ZONE"] = "us-east-1b"
+
+			res := RetrieveDownwardAPIWithNodeZoneFrom(reader, envreader, lookupNodeZone)
+			Expect(res.NodeZone()).To(Equal("us-east-1b"))
+			Expect(lookups).To(BeEmpty())
+		})
+
+		It("should be empty when the lookup fails", func() {
+			res := RetrieveDownwardAPIWithNodeZoneFrom(reader, envreader, func(string) (string, error) {
+				return "", eris.New("forbidden")
+			})
+			Expect(res.NodeZone()).To(BeEmpty())
+		})
+
+		It("should be empty without the name of the node", func() {
+			delete(env, "NODE_NAME")
+
+			res := RetrieveDownwardAPIWithNodeZoneFrom(reader, envreader, lookupNodeZone)
+			Expect(res.NodeZone()).To(BeEmpty())
+			Expect(lookups).To(BeEmpty())
+		})
+	})
+
 	It("should detect when var is needed", func() {
 		var downward TestWhichIsNeedDownwardAPI
 
 		ExpectSet(&downward.IsNodeIp, downward.NodeIp)
 		ExpectSet(&downward.IsNodeName, downward.NodeName)
+		ExpectSet(&downward.IsNodeZone, downward.NodeZone)
 
 		ExpectSet(&downward.IsPodIp, downward.PodIp)
 		ExpectSet(&downward.IsPodName, downward.PodName)
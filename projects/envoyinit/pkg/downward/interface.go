@@ -9,6 +9,7 @@ type DownwardAPI interface {
 
 	NodeName() string
 	NodeIp() string
+	NodeZone() string
 
 	PodLabels() map[string]string
 	PodAnnotations() map[string]string
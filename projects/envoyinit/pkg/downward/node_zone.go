@@ -0,0 +1,41 @@
+package downward
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// the label set on nodes by Kubernetes before 1.17
+	deprecatedZoneLabel = "failure-domain.beta.kubernetes.io/zone"
+
+	// Envoy only starts once the zone is known, so the lookup must not wait for an unreachable API server
+	nodeZoneTimeout = 10 * time.Second
+)
+
+// KubeNodeZone returns the zone of a node from its topology labels, using the service account of the pod.
+func KubeNodeZone(nodeName string) (string, error) {
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return "", err
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), nodeZoneTimeout)
+	defer cancel()
+	node, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if zone := node.GetLabels()[corev1.LabelTopologyZone]; zone != "" {
+		return zone, nil
+	}
+	return node.GetLabels()[deprecatedZoneLabel], nil
+}
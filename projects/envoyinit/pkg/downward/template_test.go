@@ -15,6 +15,7 @@ type mockDownward struct {
 	podUID         string
 	nodeName       string
 	nodeIp         string
+	nodeZone       string
 	podLabels      map[string]string
 	podAnnotations map[string]string
 }
@@ -26,6 +27,7 @@ func (di *mockDownward) PodSvcAccount() string             { return di.podSvcAcc
 func (di *mockDownward) PodUID() string                    { return di.podUID }
 func (di *mockDownward) NodeName() string                  { return di.nodeName }
 func (di *mockDownward) NodeIp() string                    { return di.nodeIp }
+func (di *mockDownward) NodeZone() string                  { return di.nodeZone }
 func (di *mockDownward) PodLabels() map[string]string      { return di.podLabels }
 func (di *mockDownward) PodAnnotations() map[string]string { return di.podAnnotations }
 
@@ -31,6 +31,12 @@ func TransformConfigTemplatesWithApi(bootstrap *envoy_config_bootstrap.Bootstrap
 		return err
 	}
 
+	if locality := bootstrap.GetNode().GetLocality(); locality != nil {
+		if err = interpolate(&locality.Zone); err != nil {
+			return err
+		}
+	}
+
 	if err := transformStruct(interpolate, bootstrap.GetNode().GetMetadata()); err != nil {
 		return err
 	}
@@ -21,8 +21,9 @@ var _ = Describe("Transform", func() {
 		)
 		BeforeEach(func() {
 			api = &mockDownward{
-				podName: "Test",
-				nodeIp:  "5.5.5.5",
+				podName:  "Test",
+				nodeIp:   "5.5.5.5",
+				nodeZone: "us-east-1a",
 			}
 			bootstrapConfig = new(envoy_config_bootstrap.Bootstrap)
 			bootstrapConfig.Node = &envoy_core.Node{}
@@ -43,6 +44,13 @@ var _ = Describe("Transform", func() {
 			Expect(bootstrapConfig.Node.Cluster).To(Equal("Test"))
 		})
 
+		It("should transform locality zone", func() {
+			bootstrapConfig.Node.Locality = &envoy_core.Locality{Zone: "{{.NodeZone}}"}
+			err := TransformConfigTemplatesWithApi(bootstrapConfig, api)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bootstrapConfig.Node.Locality.Zone).To(Equal("us-east-1a"))
+		})
+
 		It("should transform metadata", func() {
 			bootstrapConfig.Node.Metadata = &structpb.Struct{
 				Fields: map[string]*structpb.Value{
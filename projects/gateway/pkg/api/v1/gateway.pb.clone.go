@@ -23,6 +23,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_hcm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -90,6 +92,12 @@ func (m *Gateway) Clone() proto.Message {
 		target.RouteOptions = proto.Clone(m.GetRouteOptions()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.RouteConfigurationOptions)
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(clone.Cloner); ok {
+		target.Http3 = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.Http3)
+	} else {
+		target.Http3 = proto.Clone(m.GetHttp3()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.Http3)
+	}
+
 	switch m.GatewayType.(type) {
 
 	case *Gateway_HttpGateway:
@@ -128,6 +136,18 @@ func (m *Gateway) Clone() proto.Message {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+
+		if h, ok := interface{}(m.GetUdpGateway()).(clone.Cloner); ok {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: h.Clone().(*UdpGateway),
+			}
+		} else {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: proto.Clone(m.GetUdpGateway()).(*UdpGateway),
+			}
+		}
+
 	}
 
 	return target
@@ -163,6 +183,29 @@ func (m *TcpGateway) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpGateway) Clone() proto.Message {
+	var target *UdpGateway
+	if m == nil {
+		return target
+	}
+	target = &UdpGateway{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(clone.Cloner); ok {
+		target.Options = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpListenerOptions)
+	} else {
+		target.Options = proto.Clone(m.GetOptions()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpListenerOptions)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HybridGateway) Clone() proto.Message {
 	var target *HybridGateway
@@ -119,6 +119,16 @@ func (m *Gateway) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp3()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp3(), target.GetHttp3()) {
+			return false
+		}
+	}
+
 	switch m.GatewayType.(type) {
 
 	case *Gateway_HttpGateway:
@@ -166,6 +176,21 @@ func (m *Gateway) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+		if _, ok := target.GatewayType.(*Gateway_UdpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpGateway(), target.GetUdpGateway()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.GatewayType != target.GatewayType {
@@ -227,6 +252,50 @@ func (m *TcpGateway) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpGateway)
+	if !ok {
+		that2, ok := that.(UdpGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOptions(), target.GetOptions()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HybridGateway) Equal(that interface{}) bool {
 	if that == nil {
@@ -726,6 +726,14 @@ var _ = Describe("Http Translator", func() {
 					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Regex{Regex: "/anything"}},
 					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/nomatch"}, QueryParameters: []*matchers.QueryParameterMatcher{{Name: "foo", Value: "bar"}}},
 					nil),
+				Entry("duplicate paths but earlier has header prefix matcher that doesn't short circuit the latter",
+					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Headers: []*matchers.HeaderMatcher{{Name: "foo", HeaderMatchSpecifier: &matchers.HeaderMatcher_PrefixMatch{PrefixMatch: "bar"}}}},
+					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Headers: []*matchers.HeaderMatcher{{Name: "foo", HeaderMatchSpecifier: &matchers.HeaderMatcher_PrefixMatch{PrefixMatch: "baz"}}}},
+					nil),
+				Entry("duplicate paths but earlier has case-insensitive query parameter matcher that doesn't short circuit the latter",
+					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, QueryParameters: []*matchers.QueryParameterMatcher{{Name: "foo", Value: "bar", IgnoreCase: true}}},
+					&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, QueryParameters: []*matchers.QueryParameterMatcher{{Name: "foo", QueryParameterMatchSpecifier: &matchers.QueryParameterMatcher_SuffixMatch{SuffixMatch: "baz"}}}},
+					nil),
 			)
 		})
 	})
@@ -12,7 +12,13 @@ import (
 
 var _ ListenerTranslator = new(InvalidGatewayTypeTranslator)
 
-var MissingGatewayTypeErr = errors.New("invalid gateway: gateway must contain gatewayType")
+var (
+	MissingGatewayTypeErr        = errors.New("invalid gateway: gateway must contain gatewayType")
+	Http3RequiresHttpGatewayErr  = errors.New("invalid gateway: http3 is only supported on gateways with an httpGateway")
+	Http3RequiresSslErr          = errors.New("invalid gateway: http3 requires the gateway to have ssl enabled")
+	UdpGatewayMissingUpstreamErr = errors.New("invalid gateway: udpGateway must specify an upstream")
+	UdpGatewaySslErr             = errors.New("invalid gateway: ssl is not supported on gateways with a udpGateway")
+)
 
 // ListenerTranslator converts a Gateway into a Listener
 type ListenerTranslator interface {
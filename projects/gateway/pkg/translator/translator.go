@@ -57,12 +57,14 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 	// Define the available translators which convert a Gateway into a Listener
 	//	- httpTranslator produces an HttpListener
 	//	- tcpTranslator produces a TcpListener
+	//	- udpTranslator produces a UdpListener
 	//	- hybridTranslator produces a HybridListener
 	//	- aggregateTranslator produces an AggregateListener
 	httpTranslator := &HttpTranslator{
 		VirtualServiceTranslator: virtualServiceTranslator,
 	}
 	tcpTranslator := &TcpTranslator{}
+	udpTranslator := &UdpTranslator{}
 	hybridTranslator := &HybridTranslator{
 		VirtualServiceTranslator: virtualServiceTranslator,
 		TcpTranslator:            tcpTranslator,
@@ -74,6 +76,7 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 	translatorsByName := map[string]ListenerTranslator{
 		HttpTranslatorName:      httpTranslator,
 		TcpTranslatorName:       tcpTranslator,
+		UdpTranslatorName:       udpTranslator,
 		HybridTranslatorName:    hybridTranslator,
 		AggregateTranslatorName: aggregateTranslator,
 	}
@@ -149,6 +152,9 @@ func (t *GwTranslator) getListenerTranslatorForGateway(gateway *v1.Gateway) List
 	case *v1.Gateway_TcpGateway:
 		listenerTranslatorImpl = t.listenerTranslators[TcpTranslatorName]
 
+	case *v1.Gateway_UdpGateway:
+		listenerTranslatorImpl = t.listenerTranslators[UdpTranslatorName]
+
 	case *v1.Gateway_HybridGateway:
 		if shouldIsolateVirtualHosts {
 			listenerTranslatorImpl = t.listenerTranslators[AggregateTranslatorName]
@@ -192,6 +198,7 @@ func makeListener(gateway *v1.Gateway) *gloov1.Listener {
 		Options:       gateway.GetOptions(),
 		UseProxyProto: gateway.GetUseProxyProto(),
 		RouteOptions:  gateway.GetRouteOptions(),
+		Http3:         gateway.GetHttp3(),
 	}
 }
 
@@ -204,6 +211,7 @@ func ListenerName(gateway *v1.Gateway) string {
 // Gateways must meet the following criteria:
 //	1. All bind addresses are unique
 //	2. All VirtualServices that are referenced by a Gateway are available in the API Snapshot
+//	3. HTTP/3 is only enabled on SSL HttpGateways
 func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
 	bindAddresses := map[string]v1.GatewayList{}
 	// if two gateway (=listener) that belong to the same proxy share the same bind address,
@@ -229,6 +237,23 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 				reports.AddError(gw, fmt.Errorf("invalid virtual service ref %v", vs))
 			}
 		}
+
+		if gw.GetHttp3() != nil {
+			if gw.GetHttpGateway() == nil {
+				reports.AddError(gw, Http3RequiresHttpGatewayErr)
+			} else if !gw.GetSsl() {
+				reports.AddError(gw, Http3RequiresSslErr)
+			}
+		}
+
+		if udpGateway := gw.GetUdpGateway(); udpGateway != nil {
+			if udpGateway.GetUpstream() == nil {
+				reports.AddError(gw, UdpGatewayMissingUpstreamErr)
+			}
+			if gw.GetSsl() {
+				reports.AddError(gw, UdpGatewaySslErr)
+			}
+		}
 	}
 
 	for addr, gateways := range bindAddresses {
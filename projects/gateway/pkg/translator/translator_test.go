@@ -16,6 +16,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
 	"github.com/solo-io/gloo/test/samples"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -218,6 +219,90 @@ var _ = Describe("Translator", func() {
 			Expect(err.Error()).To(ContainSubstring("bind-address :2 is not unique in a proxy. gateways: gloo-system.name,gloo-system.name2"))
 		})
 
+		Context("http3", func() {
+
+			var http3 *quic.Http3
+
+			BeforeEach(func() {
+				http3 = &quic.Http3{
+					MaxConcurrentStreams: &wrappers.UInt32Value{Value: 10},
+				}
+				snap.Gateways = v1.GatewayList{snap.Gateways[0]}
+				snap.Gateways[0].Http3 = http3
+			})
+
+			It("should translate http3 on ssl http gateways to the listener", func() {
+				snap.Gateways[0].Ssl = true
+
+				proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+				Expect(proxy.Listeners).To(HaveLen(1))
+				Expect(proxy.Listeners[0].GetHttp3()).To(Equal(http3))
+			})
+
+			It("should error on http3 on gateways without ssl", func() {
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(Http3RequiresSslErr.Error()))
+			})
+
+			It("should error on http3 on tcp gateways", func() {
+				snap.Gateways[0].Ssl = true
+				snap.Gateways[0].GatewayType = &v1.Gateway_TcpGateway{
+					TcpGateway: &v1.TcpGateway{},
+				}
+
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(Http3RequiresHttpGatewayErr.Error()))
+			})
+		})
+
+		Context("udp gateway", func() {
+
+			BeforeEach(func() {
+				snap.Gateways = v1.GatewayList{snap.Gateways[0]}
+				snap.Gateways[0].GatewayType = &v1.Gateway_UdpGateway{
+					UdpGateway: &v1.UdpGateway{
+						Upstream: &core.ResourceRef{Name: "dns", Namespace: ns},
+					},
+				}
+			})
+
+			It("should translate udp gateways to udp listeners", func() {
+				proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+				Expect(proxy.Listeners).To(HaveLen(1))
+				Expect(proxy.Listeners[0].GetUdpListener().GetUpstream()).To(Equal(&core.ResourceRef{Name: "dns", Namespace: ns}))
+			})
+
+			It("should error on udp gateways without an upstream", func() {
+				snap.Gateways[0].GetUdpGateway().Upstream = nil
+
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(UdpGatewayMissingUpstreamErr.Error()))
+			})
+
+			It("should error on udp gateways with ssl", func() {
+				snap.Gateways[0].Ssl = true
+
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(UdpGatewaySslErr.Error()))
+			})
+		})
+
 		It("should warn on vs with missing delegate action", func() {
 
 			badRoute := &v1.Route{
@@ -467,6 +467,12 @@ func earlyQueryParametersShortCircuitedLaterOnes(laterMatcher, earlyMatcher matc
 				// we found an overlapping condition
 				foundOverlappingCondition = true
 
+				if !isSimpleQueryParameterMatcher(earlyQpm) || !isSimpleQueryParameterMatcher(laterQpm) {
+					// we can't validate prefix, suffix, contains or case-insensitive constraints properly,
+					// so we mark the route as not short-circuited to avoid reporting flawed warnings.
+					return false
+				}
+
 				// let's check if the early condition overlaps the later one
 				if earlyQpm.GetRegex() && !laterQpm.GetRegex() {
 					re, err := regexp.Compile(earlyQpm.GetValue())
@@ -518,6 +524,12 @@ func earlyHeaderMatchersShortCircuitLaterOnes(laterMatcher, earlyMatcher matcher
 				// we found an overlapping condition
 				foundOverlappingCondition = true
 
+				if !isSimpleHeaderMatcher(earlyHeaderMatcher) || !isSimpleHeaderMatcher(laterHeaderMatcher) {
+					// we can't validate prefix, suffix, contains, range, presence or case-insensitive constraints
+					// properly, so we mark the route as not short-circuited to avoid reporting flawed warnings.
+					return false
+				}
+
 				// let's check if the early condition overlaps the later one
 				if earlyHeaderMatcher.GetRegex() && !laterHeaderMatcher.GetRegex() {
 					re, err := regexp.Compile(earlyHeaderMatcher.GetValue())
@@ -570,6 +582,16 @@ func earlyHeaderMatchersShortCircuitLaterOnes(laterMatcher, earlyMatcher matcher
 	return true
 }
 
+// returns true if the header matcher only matches on its exact or regex value
+func isSimpleHeaderMatcher(matcher *matchers.HeaderMatcher) bool {
+	return matcher.GetHeaderMatchSpecifier() == nil && !matcher.GetIgnoreCase()
+}
+
+// returns true if the query parameter matcher only matches on its exact or regex value
+func isSimpleQueryParameterMatcher(matcher *matchers.QueryParameterMatcher) bool {
+	return matcher.GetQueryParameterMatchSpecifier() == nil && !matcher.GetIgnoreCase()
+}
+
 // special case to catch the following:
 //	- matchers:
 //	  - prefix: /foo
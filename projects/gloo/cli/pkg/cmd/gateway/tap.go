@@ -0,0 +1,256 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	tapplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func tapCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tap",
+		Short: "capture the traffic of one of the proxy instances",
+		Long: "Starts a tap session on one of the proxy instances, and prints the requests and responses it captures " +
+			"until the command is interrupted.\n" +
+			"The traffic can only be captured on the listeners whose `tap` option has an `adminSink` with the given config id.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return tapEnvoy(opts, os.Stdout)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringVar(&opts.Proxy.Tap.ConfigId, "config-id", "", "the config id of the admin sink of the listeners to tap")
+	pflags.StringArrayVar(&opts.Proxy.Tap.RequestHeaders, "request-header", nil,
+		"only capture the requests with this header, in the form name=value, or name to only require the header to be present. "+
+			"Can be repeated")
+	pflags.StringArrayVar(&opts.Proxy.Tap.ResponseHeaders, "response-header", nil,
+		"only capture the requests whose response has this header, in the form name=value, or name to only require "+
+			"the header to be present. Can be repeated")
+	pflags.StringVar(&opts.Proxy.Tap.PathPrefix, "path-prefix", "", "only capture the requests whose path starts with this prefix")
+	pflags.Uint32Var(&opts.Proxy.Tap.MaxBufferedBytes, "max-bytes", 0,
+		"the maximum number of bytes of each body to capture. Defaults to the proxy default of 1KiB")
+	pflags.IntVar(&opts.Proxy.Tap.Count, "count", 0, "stop after capturing this number of traces. Runs until interrupted if 0")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func tapEnvoy(opts *options.Options, out io.Writer) error {
+	tapRequest, err := buildTapRequest(opts.Top.Ctx, opts.Proxy.Tap)
+	if err != nil {
+		return err
+	}
+	body, err := protojson.Marshal(tapRequest)
+	if err != nil {
+		return err
+	}
+
+	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
+	portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.GetNamespace(),
+		"deployment/"+opts.Proxy.Name, adminPort)
+	portFwd.Stdout = os.Stderr
+	portFwd.Stderr = os.Stderr
+	if err := portFwd.Start(); err != nil {
+		return errors.Wrapf(err, "failed to start port-forward")
+	}
+	defer func() {
+		if portFwd.Process != nil {
+			portFwd.Process.Kill()
+		}
+	}()
+
+	timeout := time.After(time.Second * 30)
+	for {
+		req, err := http.NewRequestWithContext(opts.Top.Ctx, http.MethodPost, "http://localhost:"+adminPort+"/tap", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err == nil {
+			defer res.Body.Close()
+			if res.StatusCode != http.StatusOK {
+				msg, _ := ioutil.ReadAll(res.Body)
+				return errors.Errorf("invalid status code: %v %v %s", res.StatusCode, res.Status, msg)
+			}
+			return printTraces(res.Body, out, opts.Proxy.Tap.Count)
+		}
+		log.Printf("connecting to envoy failed with err %v", err.Error())
+
+		select {
+		case <-opts.Top.Ctx.Done():
+			return errors.Errorf("cancelled")
+		case <-timeout:
+			return errors.Errorf("timed out trying to connect to Envoy admin port")
+		case <-time.After(time.Millisecond * 250):
+		}
+	}
+}
+
+// buildTapRequest builds the request starting a tap session on the admin endpoint of Envoy.
+// The traces are streamed back as JSON, which is how they are printed.
+func buildTapRequest(ctx context.Context, opts options.ProxyTap) (*envoy_admin_v3.TapRequest, error) {
+	if opts.ConfigId == "" {
+		return nil, errors.Errorf("the config id of the tap must be specified")
+	}
+
+	requestHeaders, err := parseHeaderMatchers(opts.RequestHeaders)
+	if err != nil {
+		return nil, err
+	}
+	responseHeaders, err := parseHeaderMatchers(opts.ResponseHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxBufferedBytes *wrappers.UInt32Value
+	if opts.MaxBufferedBytes > 0 {
+		maxBufferedBytes = &wrappers.UInt32Value{Value: opts.MaxBufferedBytes}
+	}
+
+	return &envoy_admin_v3.TapRequest{
+		ConfigId: opts.ConfigId,
+		TapConfig: &envoy_config_tap_v3.TapConfig{
+			Match: tapplugin.TranslateMatch(ctx, &tap.Tap_Match{
+				RequestHeaders:  requestHeaders,
+				ResponseHeaders: responseHeaders,
+				PathPrefix:      opts.PathPrefix,
+			}),
+			OutputConfig: &envoy_config_tap_v3.OutputConfig{
+				Sinks: []*envoy_config_tap_v3.OutputSink{{
+					Format: envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING,
+					OutputSinkType: &envoy_config_tap_v3.OutputSink_StreamingAdmin{
+						StreamingAdmin: &envoy_config_tap_v3.StreamingAdminSink{},
+					},
+				}},
+				MaxBufferedRxBytes: maxBufferedBytes,
+				MaxBufferedTxBytes: maxBufferedBytes,
+			},
+		},
+	}, nil
+}
+
+func parseHeaderMatchers(headers []string) ([]*matchers.HeaderMatcher, error) {
+	var headerMatchers []*matchers.HeaderMatcher
+	for _, header := range headers {
+		name, value, _ := strings.Cut(header, "=")
+		if name == "" {
+			return nil, errors.Errorf("invalid header %q, expected name=value", header)
+		}
+		headerMatchers = append(headerMatchers, &matchers.HeaderMatcher{
+			Name:  name,
+			Value: value,
+		})
+	}
+	return headerMatchers, nil
+}
+
+// printTraces prints the traces streamed by Envoy, until the stream ends or count traces have been printed.
+func printTraces(in io.Reader, out io.Writer, count int) error {
+	decoder := json.NewDecoder(in)
+	for printed := 0; count <= 0 || printed < count; printed++ {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrapf(err, "reading traces")
+		}
+		trace := &envoy_data_tap_v3.TraceWrapper{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, trace); err != nil {
+			return errors.Wrapf(err, "parsing trace")
+		}
+		printTrace(out, trace)
+	}
+	return nil
+}
+
+func printTrace(out io.Writer, trace *envoy_data_tap_v3.TraceWrapper) {
+	switch {
+	case trace.GetHttpBufferedTrace() != nil:
+		httpTrace := trace.GetHttpBufferedTrace()
+		fmt.Fprintln(out, "--- request ---")
+		printHttpMessage(out, "> ", httpTrace.GetRequest())
+		fmt.Fprintln(out, "--- response ---")
+		printHttpMessage(out, "< ", httpTrace.GetResponse())
+	case trace.GetSocketBufferedTrace() != nil:
+		socketTrace := trace.GetSocketBufferedTrace()
+		fmt.Fprintf(out, "--- connection %d from %s to %s ---\n", socketTrace.GetTraceId(),
+			formatAddress(socketTrace.GetConnection().GetRemoteAddress()),
+			formatAddress(socketTrace.GetConnection().GetLocalAddress()))
+		for _, event := range socketTrace.GetEvents() {
+			switch {
+			case event.GetRead() != nil:
+				printBody(out, "> ", event.GetRead().GetData())
+			case event.GetWrite() != nil:
+				printBody(out, "< ", event.GetWrite().GetData())
+			case event.GetClosed() != nil:
+				fmt.Fprintln(out, "(closed)")
+			}
+		}
+		if socketTrace.GetReadTruncated() || socketTrace.GetWriteTruncated() {
+			fmt.Fprintln(out, "(truncated)")
+		}
+	default:
+		fmt.Fprintln(out, protojson.Format(trace))
+	}
+	fmt.Fprintln(out)
+}
+
+func printHttpMessage(out io.Writer, prefix string, message *envoy_data_tap_v3.HttpBufferedTrace_Message) {
+	for _, header := range message.GetHeaders() {
+		fmt.Fprintf(out, "%s%s: %s\n", prefix, header.GetKey(), header.GetValue())
+	}
+	fmt.Fprintln(out, strings.TrimSpace(prefix))
+	printBody(out, prefix, message.GetBody())
+	for _, trailer := range message.GetTrailers() {
+		fmt.Fprintf(out, "%s%s: %s\n", prefix, trailer.GetKey(), trailer.GetValue())
+	}
+}
+
+func printBody(out io.Writer, prefix string, body *envoy_data_tap_v3.Body) {
+	data := body.GetAsString()
+	if data == "" {
+		data = string(body.GetAsBytes())
+	}
+	if data == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		fmt.Fprintf(out, "%s%s\n", prefix, line)
+	}
+	if body.GetTruncated() {
+		fmt.Fprintf(out, "%s(truncated)\n", prefix)
+	}
+}
+
+func formatAddress(address *envoy_config_core_v3.Address) string {
+	socketAddress := address.GetSocketAddress()
+	if socketAddress == nil {
+		return "unknown"
+	}
+	return socketAddress.GetAddress() + ":" + strconv.Itoa(int(socketAddress.GetPortValue()))
+}
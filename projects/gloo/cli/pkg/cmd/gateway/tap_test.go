@@ -0,0 +1,105 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+)
+
+var _ = Describe("Tap", func() {
+
+	Context("buildTapRequest", func() {
+
+		It("requires a config id", func() {
+			_, err := buildTapRequest(context.Background(), options.ProxyTap{})
+			Expect(err).To(MatchError(ContainSubstring("config id")))
+		})
+
+		It("builds a streaming admin tap request with the match conditions", func() {
+			tapRequest, err := buildTapRequest(context.Background(), options.ProxyTap{
+				ConfigId:         "gateway",
+				RequestHeaders:   []string{"x-debug=true", "x-request-id"},
+				ResponseHeaders:  []string{":status=500"},
+				PathPrefix:       "/api",
+				MaxBufferedBytes: 2048,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tapRequest.GetConfigId()).To(Equal("gateway"))
+
+			rules := tapRequest.GetTapConfig().GetMatch().GetAndMatch().GetRules()
+			Expect(rules).To(HaveLen(2))
+			requestHeaders := rules[0].GetHttpRequestHeadersMatch().GetHeaders()
+			Expect(requestHeaders).To(HaveLen(3))
+			Expect(requestHeaders[0].GetExactMatch()).To(Equal("true"))
+			Expect(requestHeaders[1].GetPresentMatch()).To(BeTrue())
+			Expect(requestHeaders[2].GetName()).To(Equal(":path"))
+			Expect(rules[1].GetHttpResponseHeadersMatch().GetHeaders()[0].GetExactMatch()).To(Equal("500"))
+
+			outputConfig := tapRequest.GetTapConfig().GetOutputConfig()
+			Expect(outputConfig.GetSinks()).To(HaveLen(1))
+			Expect(outputConfig.GetSinks()[0].GetFormat()).To(Equal(envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING))
+			Expect(outputConfig.GetSinks()[0].GetStreamingAdmin()).NotTo(BeNil())
+			Expect(outputConfig.GetMaxBufferedRxBytes().GetValue()).To(Equal(uint32(2048)))
+			Expect(outputConfig.GetMaxBufferedTxBytes().GetValue()).To(Equal(uint32(2048)))
+		})
+
+		It("captures all the traffic without match conditions", func() {
+			tapRequest, err := buildTapRequest(context.Background(), options.ProxyTap{ConfigId: "gateway"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tapRequest.GetTapConfig().GetMatch().GetAnyMatch()).To(BeTrue())
+			Expect(tapRequest.GetTapConfig().GetOutputConfig().GetMaxBufferedRxBytes()).To(BeNil())
+		})
+
+		It("errors on invalid headers", func() {
+			_, err := buildTapRequest(context.Background(), options.ProxyTap{
+				ConfigId:       "gateway",
+				RequestHeaders: []string{"=value"},
+			})
+			Expect(err).To(MatchError(ContainSubstring("invalid header")))
+		})
+	})
+
+	Context("printTraces", func() {
+
+		const traces = `{"http_buffered_trace":{"request":{"headers":[{"key":":method","value":"GET"},{"key":":path","value":"/api"}],"body":{"as_string":"ping"}},"response":{"headers":[{"key":":status","value":"200"}],"body":{"as_string":"pong","truncated":true}}}}
+{"socket_buffered_trace":{"trace_id":"7","connection":{"local_address":{"socket_address":{"address":"10.0.0.1","port_value":8000}},"remote_address":{"socket_address":{"address":"10.0.0.2","port_value":51000}}},"events":[{"read":{"data":{"as_bytes":"aGVsbG8="}}},{"write":{"data":{"as_string":"world"}}},{"closed":{}}]}}`
+
+		It("prints the http requests and responses and the tcp connections", func() {
+			out := &bytes.Buffer{}
+			Expect(printTraces(strings.NewReader(traces), out, 0)).NotTo(HaveOccurred())
+			Expect(out.String()).To(Equal(`--- request ---
+> :method: GET
+> :path: /api
+>
+> ping
+--- response ---
+< :status: 200
+<
+< pong
+< (truncated)
+
+--- connection 7 from 10.0.0.2:51000 to 10.0.0.1:8000 ---
+> hello
+< world
+(closed)
+
+`))
+		})
+
+		It("stops after count traces", func() {
+			out := &bytes.Buffer{}
+			Expect(printTraces(strings.NewReader(traces), out, 1)).NotTo(HaveOccurred())
+			Expect(out.String()).NotTo(ContainSubstring("connection"))
+		})
+
+		It("errors on invalid traces", func() {
+			err := printTraces(strings.NewReader(`{"http_buffered_trace":`), &bytes.Buffer{}, 0)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
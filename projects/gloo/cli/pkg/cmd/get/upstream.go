@@ -20,6 +20,9 @@ func Upstream(opts *options.Options) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if opts.Get.Discovery {
+				return printers.PrintUpstreamsDiscovery(upstreams, opts.Top.Output)
+			}
 			var xdsDump *xdsinspection.XdsDump
 			if opts.Top.Output == printers.WIDE {
 				xdsDump, err = xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.GetNamespace(), false)
@@ -30,5 +33,6 @@ func Upstream(opts *options.Options) *cobra.Command {
 			return printers.PrintUpstreams(upstreams, opts.Top.Output, xdsDump)
 		},
 	}
+	cmd.Flags().BoolVar(&opts.Get.Discovery, "discovery", false, "show the function discovery status of the upstreams")
 	return cmd
 }
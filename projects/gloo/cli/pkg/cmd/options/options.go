@@ -91,6 +91,16 @@ type Proxy struct {
 	Port             string
 	FollowLogs       bool
 	DebugLogs        bool
+	Tap              ProxyTap
+}
+
+type ProxyTap struct {
+	ConfigId         string
+	RequestHeaders   []string
+	ResponseHeaders  []string
+	PathPrefix       string
+	MaxBufferedBytes uint32
+	Count            int
 }
 
 type Upgrade struct {
@@ -99,7 +109,8 @@ type Upgrade struct {
 }
 
 type Get struct {
-	Selector InputMapStringString
+	Selector  InputMapStringString
+	Discovery bool
 }
 
 type Delete struct {
@@ -58,6 +58,7 @@ func sortRoutes(opts *options.Options) error {
 	fmt.Printf("sorting %v routes by:\n"+
 		"- exact < regex < prefix \n"+
 		"- longest path first \n"+
+		"- most specific header and query parameter matchers first \n"+
 		"...\n", len(vs.GetVirtualHost().GetRoutes()))
 	utils.SortGatewayRoutesByPath(vs.GetVirtualHost().GetRoutes())
 
@@ -5,6 +5,9 @@ import (
 	"io"
 	"os"
 	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
@@ -57,6 +60,53 @@ func UpstreamTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w i
 	table.Render()
 }
 
+// PrintUpstreamsDiscovery prints the function discovery status of upstreams
+func PrintUpstreamsDiscovery(upstreams v1.UpstreamList, outputType OutputType) error {
+	if !outputType.IsTable() {
+		// the status is part of the printed upstreams
+		return PrintUpstreams(upstreams, outputType, nil)
+	}
+	return cliutils.PrintList(outputType.String(), "", upstreams,
+		func(data interface{}, w io.Writer) error {
+			UpstreamDiscoveryTable(data.(v1.UpstreamList), w)
+			return nil
+		}, os.Stdout)
+}
+
+// UpstreamDiscoveryTable prints the function discovery status of upstreams using tables to io.Writer
+func UpstreamDiscoveryTable(upstreams []*v1.Upstream, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Upstream", "function type", "last attempt", "last success", "failures", "next attempt", "error"})
+
+	for _, us := range upstreams {
+		status := us.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+		var failures, nextAttempt string
+		if status.GetConsecutiveFailures() > 0 {
+			failures = fmt.Sprintf("%d", status.GetConsecutiveFailures())
+			nextAttempt = formatTimestamp(status.GetNextAttemptTime())
+		}
+		table.Append([]string{
+			us.GetMetadata().GetName(),
+			status.GetFunctionType(),
+			formatTimestamp(status.GetLastAttemptTime()),
+			formatTimestamp(status.GetLastSuccessTime()),
+			failures,
+			nextAttempt,
+			status.GetError(),
+		})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func formatTimestamp(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
+
 func upstreamStatus(us *v1.Upstream) string {
 	return AggregateNamespacedStatuses(us.GetNamespacedStatuses(), func(status *core.Status) string {
 		return status.GetState().String()
@@ -216,6 +266,11 @@ func linesForServiceSpec(serviceSpec *plugins.ServiceSpec) []string {
 				add(fmt.Sprintf("  - %v", fn))
 			}
 		}
+	case *plugins.ServiceSpec_GrpcJsonTranscoder:
+		add("gRPC JSON transcoding:")
+		for _, grpcService := range plug.GrpcJsonTranscoder.GetServices() {
+			add(fmt.Sprintf("  %v", grpcService))
+		}
 	}
 
 	return spec
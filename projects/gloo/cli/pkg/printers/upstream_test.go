@@ -1,9 +1,14 @@
 package printers
 
 import (
+	"bytes"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("UpstreamTable", func() {
@@ -14,3 +19,27 @@ var _ = Describe("UpstreamTable", func() {
 		}).NotTo(Panic())
 	})
 })
+
+var _ = Describe("UpstreamDiscoveryTable", func() {
+	It("prints the function discovery status", func() {
+		lastAttempt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		failing := &v1.Upstream{
+			Metadata: &core.Metadata{Name: "failing"},
+			DiscoveryMetadata: &v1.DiscoveryMetadata{
+				FunctionDiscoveryStatus: &v1.FunctionDiscoveryStatus{
+					FunctionType:        "rest",
+					LastAttemptTime:     &timestamp.Timestamp{Seconds: lastAttempt.Unix()},
+					Error:               "swagger unreachable",
+					ConsecutiveFailures: 2,
+					NextAttemptTime:     &timestamp.Timestamp{Seconds: lastAttempt.Add(20 * time.Second).Unix()},
+				},
+			},
+		}
+		undiscovered := &v1.Upstream{Metadata: &core.Metadata{Name: "undiscovered"}}
+
+		var out bytes.Buffer
+		UpstreamDiscoveryTable([]*v1.Upstream{failing, undiscovered}, &out)
+		Expect(out.String()).To(ContainSubstring("| failing      | rest          | 2023-01-02T03:04:05Z |              | 2        | 2023-01-02T03:04:25Z | swagger unreachable |"))
+		Expect(out.String()).To(ContainSubstring("| undiscovered |"))
+	})
+})
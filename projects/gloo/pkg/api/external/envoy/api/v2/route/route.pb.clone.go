@@ -23,6 +23,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+
 	github_com_solo_io_solo_kit_pkg_api_external_envoy_api_v2_core "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
 
 	github_com_solo_io_solo_kit_pkg_api_external_envoy_type "github.com/solo-io/solo-kit/pkg/api/external/envoy/type"
@@ -1002,6 +1004,24 @@ func (m *HeaderMatcher) Clone() proto.Message {
 			SuffixMatch: m.GetSuffixMatch(),
 		}
 
+	case *HeaderMatcher_ContainsMatch:
+
+		target.HeaderMatchSpecifier = &HeaderMatcher_ContainsMatch{
+			ContainsMatch: m.GetContainsMatch(),
+		}
+
+	case *HeaderMatcher_StringMatch:
+
+		if h, ok := interface{}(m.GetStringMatch()).(clone.Cloner); ok {
+			target.HeaderMatchSpecifier = &HeaderMatcher_StringMatch{
+				StringMatch: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher),
+			}
+		} else {
+			target.HeaderMatchSpecifier = &HeaderMatcher_StringMatch{
+				StringMatch: proto.Clone(m.GetStringMatch()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher),
+			}
+		}
+
 	}
 
 	return target
@@ -1025,6 +1045,12 @@ func (m *QueryParameterMatcher) Clone() proto.Message {
 		target.Regex = proto.Clone(m.GetRegex()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetStringMatch()).(clone.Cloner); ok {
+		target.StringMatch = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher)
+	} else {
+		target.StringMatch = proto.Clone(m.GetStringMatch()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.StringMatcher)
+	}
+
 	return target
 }
 
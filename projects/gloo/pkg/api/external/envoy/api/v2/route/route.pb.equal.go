@@ -1580,6 +1580,30 @@ func (m *HeaderMatcher) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *HeaderMatcher_ContainsMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_ContainsMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetContainsMatch(), target.GetContainsMatch()) != 0 {
+			return false
+		}
+
+	case *HeaderMatcher_StringMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_StringMatch); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetStringMatch()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetStringMatch()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetStringMatch(), target.GetStringMatch()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.HeaderMatchSpecifier != target.HeaderMatchSpecifier {
@@ -1629,6 +1653,16 @@ func (m *QueryParameterMatcher) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetStringMatch()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStringMatch()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStringMatch(), target.GetStringMatch()) {
+			return false
+		}
+	}
+
 	return true
 }
 
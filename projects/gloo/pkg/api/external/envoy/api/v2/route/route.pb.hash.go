@@ -1954,6 +1954,34 @@ func (m *HeaderMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *HeaderMatcher_ContainsMatch:
+
+		if _, err = hasher.Write([]byte(m.GetContainsMatch())); err != nil {
+			return 0, err
+		}
+
+	case *HeaderMatcher_StringMatch:
+
+		if h, ok := interface{}(m.GetStringMatch()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("StringMatch")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetStringMatch(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("StringMatch")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -2000,6 +2028,26 @@ func (m *QueryParameterMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetStringMatch()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("StringMatch")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetStringMatch(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("StringMatch")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
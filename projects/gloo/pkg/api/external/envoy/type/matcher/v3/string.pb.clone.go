@@ -67,6 +67,12 @@ func (m *StringMatcher) Clone() proto.Message {
 			}
 		}
 
+	case *StringMatcher_Contains:
+
+		target.MatchPattern = &StringMatcher_Contains{
+			Contains: m.GetContains(),
+		}
+
 	}
 
 	return target
@@ -94,6 +94,15 @@ func (m *StringMatcher) Equal(that interface{}) bool {
 			}
 		}
 
+	case *StringMatcher_Contains:
+		if _, ok := target.MatchPattern.(*StringMatcher_Contains); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetContains(), target.GetContains()) != 0 {
+			return false
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.MatchPattern != target.MatchPattern {
@@ -85,6 +85,12 @@ func (m *StringMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *StringMatcher_Contains:
+
+		if _, err = hasher.Write([]byte(m.GetContains())); err != nil {
+			return 0, err
+		}
+
 	}
 
 	return hasher.Sum64(), nil
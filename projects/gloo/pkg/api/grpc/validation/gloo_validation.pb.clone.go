@@ -347,6 +347,18 @@ func (m *ListenerReport) Clone() proto.Message {
 			}
 		}
 
+	case *ListenerReport_UdpListenerReport:
+
+		if h, ok := interface{}(m.GetUdpListenerReport()).(clone.Cloner); ok {
+			target.ListenerTypeReport = &ListenerReport_UdpListenerReport{
+				UdpListenerReport: h.Clone().(*UdpListenerReport),
+			}
+		} else {
+			target.ListenerTypeReport = &ListenerReport_UdpListenerReport{
+				UdpListenerReport: proto.Clone(m.GetUdpListenerReport()).(*UdpListenerReport),
+			}
+		}
+
 	}
 
 	return target
@@ -524,6 +536,30 @@ func (m *TcpHostReport) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListenerReport) Clone() proto.Message {
+	var target *UdpListenerReport
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerReport{}
+
+	if m.GetErrors() != nil {
+		target.Errors = make([]*UdpListenerReport_Error, len(m.GetErrors()))
+		for idx, v := range m.GetErrors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Errors[idx] = h.Clone().(*UdpListenerReport_Error)
+			} else {
+				target.Errors[idx] = proto.Clone(v).(*UdpListenerReport_Error)
+			}
+
+		}
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HybridListenerReport) Clone() proto.Message {
 	var target *HybridListenerReport
@@ -715,3 +751,18 @@ func (m *TcpHostReport_Error) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *UdpListenerReport_Error) Clone() proto.Message {
+	var target *UdpListenerReport_Error
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerReport_Error{}
+
+	target.Type = m.GetType()
+
+	target.Reason = m.GetReason()
+
+	return target
+}
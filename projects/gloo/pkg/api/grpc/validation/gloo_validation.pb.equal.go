@@ -544,6 +544,21 @@ func (m *ListenerReport) Equal(that interface{}) bool {
 			}
 		}
 
+	case *ListenerReport_UdpListenerReport:
+		if _, ok := target.ListenerTypeReport.(*ListenerReport_UdpListenerReport); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpListenerReport()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpListenerReport()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpListenerReport(), target.GetUdpListenerReport()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.ListenerTypeReport != target.ListenerTypeReport {
@@ -827,6 +842,47 @@ func (m *TcpHostReport) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListenerReport) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListenerReport)
+	if !ok {
+		that2, ok := that.(UdpListenerReport)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetErrors()) != len(target.GetErrors()) {
+		return false
+	}
+	for idx, v := range m.GetErrors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetErrors()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetErrors()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HybridListenerReport) Equal(that interface{}) bool {
 	if that == nil {
@@ -1195,3 +1251,35 @@ func (m *TcpHostReport_Error) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *UdpListenerReport_Error) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListenerReport_Error)
+	if !ok {
+		that2, ok := that.(UdpListenerReport_Error)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetType() != target.GetType() {
+		return false
+	}
+
+	if strings.Compare(m.GetReason(), target.GetReason()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -118,5 +118,81 @@ func (m *CircuitBreakerConfig) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRetryBudget()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RetryBudget")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRetryBudget(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RetryBudget")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *RetryBudget) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.RetryBudget")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetBudgetPercent()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("BudgetPercent")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetBudgetPercent(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("BudgetPercent")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinRetryConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MinRetryConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMinRetryConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MinRetryConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type"
 )
 
 // ensure the imports are used
@@ -117,6 +119,48 @@ func (m *HeaderMatcher) Clone() proto.Message {
 
 	target.InvertMatch = m.GetInvertMatch()
 
+	target.IgnoreCase = m.GetIgnoreCase()
+
+	switch m.HeaderMatchSpecifier.(type) {
+
+	case *HeaderMatcher_PrefixMatch:
+
+		target.HeaderMatchSpecifier = &HeaderMatcher_PrefixMatch{
+			PrefixMatch: m.GetPrefixMatch(),
+		}
+
+	case *HeaderMatcher_SuffixMatch:
+
+		target.HeaderMatchSpecifier = &HeaderMatcher_SuffixMatch{
+			SuffixMatch: m.GetSuffixMatch(),
+		}
+
+	case *HeaderMatcher_ContainsMatch:
+
+		target.HeaderMatchSpecifier = &HeaderMatcher_ContainsMatch{
+			ContainsMatch: m.GetContainsMatch(),
+		}
+
+	case *HeaderMatcher_RangeMatch:
+
+		if h, ok := interface{}(m.GetRangeMatch()).(clone.Cloner); ok {
+			target.HeaderMatchSpecifier = &HeaderMatcher_RangeMatch{
+				RangeMatch: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type.Int64Range),
+			}
+		} else {
+			target.HeaderMatchSpecifier = &HeaderMatcher_RangeMatch{
+				RangeMatch: proto.Clone(m.GetRangeMatch()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type.Int64Range),
+			}
+		}
+
+	case *HeaderMatcher_PresentMatch:
+
+		target.HeaderMatchSpecifier = &HeaderMatcher_PresentMatch{
+			PresentMatch: m.GetPresentMatch(),
+		}
+
+	}
+
 	return target
 }
 
@@ -134,5 +178,29 @@ func (m *QueryParameterMatcher) Clone() proto.Message {
 
 	target.Regex = m.GetRegex()
 
+	target.IgnoreCase = m.GetIgnoreCase()
+
+	switch m.QueryParameterMatchSpecifier.(type) {
+
+	case *QueryParameterMatcher_PrefixMatch:
+
+		target.QueryParameterMatchSpecifier = &QueryParameterMatcher_PrefixMatch{
+			PrefixMatch: m.GetPrefixMatch(),
+		}
+
+	case *QueryParameterMatcher_SuffixMatch:
+
+		target.QueryParameterMatchSpecifier = &QueryParameterMatcher_SuffixMatch{
+			SuffixMatch: m.GetSuffixMatch(),
+		}
+
+	case *QueryParameterMatcher_ContainsMatch:
+
+		target.QueryParameterMatchSpecifier = &QueryParameterMatcher_ContainsMatch{
+			ContainsMatch: m.GetContainsMatch(),
+		}
+
+	}
+
 	return target
 }
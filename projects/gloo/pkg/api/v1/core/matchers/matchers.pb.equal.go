@@ -177,6 +177,70 @@ func (m *HeaderMatcher) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetIgnoreCase() != target.GetIgnoreCase() {
+		return false
+	}
+
+	switch m.HeaderMatchSpecifier.(type) {
+
+	case *HeaderMatcher_PrefixMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_PrefixMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetPrefixMatch(), target.GetPrefixMatch()) != 0 {
+			return false
+		}
+
+	case *HeaderMatcher_SuffixMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_SuffixMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetSuffixMatch(), target.GetSuffixMatch()) != 0 {
+			return false
+		}
+
+	case *HeaderMatcher_ContainsMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_ContainsMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetContainsMatch(), target.GetContainsMatch()) != 0 {
+			return false
+		}
+
+	case *HeaderMatcher_RangeMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_RangeMatch); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetRangeMatch()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRangeMatch()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetRangeMatch(), target.GetRangeMatch()) {
+				return false
+			}
+		}
+
+	case *HeaderMatcher_PresentMatch:
+		if _, ok := target.HeaderMatchSpecifier.(*HeaderMatcher_PresentMatch); !ok {
+			return false
+		}
+
+		if m.GetPresentMatch() != target.GetPresentMatch() {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.HeaderMatchSpecifier != target.HeaderMatchSpecifier {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -213,5 +277,45 @@ func (m *QueryParameterMatcher) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetIgnoreCase() != target.GetIgnoreCase() {
+		return false
+	}
+
+	switch m.QueryParameterMatchSpecifier.(type) {
+
+	case *QueryParameterMatcher_PrefixMatch:
+		if _, ok := target.QueryParameterMatchSpecifier.(*QueryParameterMatcher_PrefixMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetPrefixMatch(), target.GetPrefixMatch()) != 0 {
+			return false
+		}
+
+	case *QueryParameterMatcher_SuffixMatch:
+		if _, ok := target.QueryParameterMatchSpecifier.(*QueryParameterMatcher_SuffixMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetSuffixMatch(), target.GetSuffixMatch()) != 0 {
+			return false
+		}
+
+	case *QueryParameterMatcher_ContainsMatch:
+		if _, ok := target.QueryParameterMatchSpecifier.(*QueryParameterMatcher_ContainsMatch); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetContainsMatch(), target.GetContainsMatch()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.QueryParameterMatchSpecifier != target.QueryParameterMatchSpecifier {
+			return false
+		}
+	}
+
 	return true
 }
@@ -170,6 +170,62 @@ func (m *HeaderMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetIgnoreCase())
+	if err != nil {
+		return 0, err
+	}
+
+	switch m.HeaderMatchSpecifier.(type) {
+
+	case *HeaderMatcher_PrefixMatch:
+
+		if _, err = hasher.Write([]byte(m.GetPrefixMatch())); err != nil {
+			return 0, err
+		}
+
+	case *HeaderMatcher_SuffixMatch:
+
+		if _, err = hasher.Write([]byte(m.GetSuffixMatch())); err != nil {
+			return 0, err
+		}
+
+	case *HeaderMatcher_ContainsMatch:
+
+		if _, err = hasher.Write([]byte(m.GetContainsMatch())); err != nil {
+			return 0, err
+		}
+
+	case *HeaderMatcher_RangeMatch:
+
+		if h, ok := interface{}(m.GetRangeMatch()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("RangeMatch")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetRangeMatch(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("RangeMatch")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *HeaderMatcher_PresentMatch:
+
+		err = binary.Write(hasher, binary.LittleEndian, m.GetPresentMatch())
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -199,5 +255,32 @@ func (m *QueryParameterMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetIgnoreCase())
+	if err != nil {
+		return 0, err
+	}
+
+	switch m.QueryParameterMatchSpecifier.(type) {
+
+	case *QueryParameterMatcher_PrefixMatch:
+
+		if _, err = hasher.Write([]byte(m.GetPrefixMatch())); err != nil {
+			return 0, err
+		}
+
+	case *QueryParameterMatcher_SuffixMatch:
+
+		if _, err = hasher.Write([]byte(m.GetSuffixMatch())); err != nil {
+			return 0, err
+		}
+
+	case *QueryParameterMatcher_ContainsMatch:
+
+		if _, err = hasher.Write([]byte(m.GetContainsMatch())); err != nil {
+			return 0, err
+		}
+
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -12,6 +12,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 )
 
 // ensure the imports are used
@@ -23,6 +25,8 @@ var (
 	_ = strings.Compare
 	_ = equality.Equalizer(nil)
 	_ = proto.Message(nil)
+
+	_ = v3.HealthStatus(0)
 )
 
 // Equal function
@@ -95,6 +99,20 @@ func (m *Endpoint) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocality()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocality(), target.GetLocality()) {
+			return false
+		}
+	}
+
+	if m.GetHealthStatus() != target.GetHealthStatus() {
+		return false
+	}
+
 	return true
 }
 
@@ -129,6 +129,64 @@ func (m *LoadBalancerConfig) Clone() proto.Message {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(clone.Cloner); ok {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: h.Clone().(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		} else {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: proto.Clone(m.GetZoneAwareLbConfig()).(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *PreferSameZone) Clone() proto.Message {
+	var target *PreferSameZone
+	if m == nil {
+		return target
+	}
+	target = &PreferSameZone{}
+
+	if h, ok := interface{}(m.GetMinEndpoints()).(clone.Cloner); ok {
+		target.MinEndpoints = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	} else {
+		target.MinEndpoints = proto.Clone(m.GetMinEndpoints()).(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LoadBalancerConfig_SlowStartConfig) Clone() proto.Message {
+	var target *LoadBalancerConfig_SlowStartConfig
+	if m == nil {
+		return target
+	}
+	target = &LoadBalancerConfig_SlowStartConfig{}
+
+	if h, ok := interface{}(m.GetSlowStartWindow()).(clone.Cloner); ok {
+		target.SlowStartWindow = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.SlowStartWindow = proto.Clone(m.GetSlowStartWindow()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetAggression()).(clone.Cloner); ok {
+		target.Aggression = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Aggression = proto.Clone(m.GetAggression()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinWeightPercent()).(clone.Cloner); ok {
+		target.MinWeightPercent = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.MinWeightPercent = proto.Clone(m.GetMinWeightPercent()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
 	}
 
 	return target
@@ -142,6 +200,12 @@ func (m *LoadBalancerConfig_RoundRobin) Clone() proto.Message {
 	}
 	target = &LoadBalancerConfig_RoundRobin{}
 
+	if h, ok := interface{}(m.GetSlowStartConfig()).(clone.Cloner); ok {
+		target.SlowStartConfig = h.Clone().(*LoadBalancerConfig_SlowStartConfig)
+	} else {
+		target.SlowStartConfig = proto.Clone(m.GetSlowStartConfig()).(*LoadBalancerConfig_SlowStartConfig)
+	}
+
 	return target
 }
 
@@ -155,6 +219,18 @@ func (m *LoadBalancerConfig_LeastRequest) Clone() proto.Message {
 
 	target.ChoiceCount = m.GetChoiceCount()
 
+	if h, ok := interface{}(m.GetSlowStartConfig()).(clone.Cloner); ok {
+		target.SlowStartConfig = h.Clone().(*LoadBalancerConfig_SlowStartConfig)
+	} else {
+		target.SlowStartConfig = proto.Clone(m.GetSlowStartConfig()).(*LoadBalancerConfig_SlowStartConfig)
+	}
+
+	if h, ok := interface{}(m.GetActiveRequestBias()).(clone.Cloner); ok {
+		target.ActiveRequestBias = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.ActiveRequestBias = proto.Clone(m.GetActiveRequestBias()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
 	return target
 }
 
@@ -211,3 +287,28 @@ func (m *LoadBalancerConfig_Maglev) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Clone() proto.Message {
+	var target *LoadBalancerConfig_ZoneAwareLbConfig
+	if m == nil {
+		return target
+	}
+	target = &LoadBalancerConfig_ZoneAwareLbConfig{}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(clone.Cloner); ok {
+		target.RoutingEnabled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.RoutingEnabled = proto.Clone(m.GetRoutingEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(clone.Cloner); ok {
+		target.MinClusterSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	} else {
+		target.MinClusterSize = proto.Clone(m.GetMinClusterSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	}
+
+	target.FailTrafficOnPanic = m.GetFailTrafficOnPanic()
+
+	return target
+}
@@ -167,6 +167,21 @@ func (m *LoadBalancerConfig) Equal(that interface{}) bool {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+		if _, ok := target.LocalityConfig.(*LoadBalancerConfig_ZoneAwareLbConfig_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetZoneAwareLbConfig()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetZoneAwareLbConfig(), target.GetZoneAwareLbConfig()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.LocalityConfig != target.LocalityConfig {
@@ -177,6 +192,94 @@ func (m *LoadBalancerConfig) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *PreferSameZone) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*PreferSameZone)
+	if !ok {
+		that2, ok := that.(PreferSameZone)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMinEndpoints()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinEndpoints()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinEndpoints(), target.GetMinEndpoints()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LoadBalancerConfig_SlowStartConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LoadBalancerConfig_SlowStartConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_SlowStartConfig)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSlowStartWindow()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSlowStartWindow()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSlowStartWindow(), target.GetSlowStartWindow()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAggression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAggression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAggression(), target.GetAggression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinWeightPercent()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinWeightPercent()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinWeightPercent(), target.GetMinWeightPercent()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 	if that == nil {
@@ -198,6 +301,16 @@ func (m *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetSlowStartConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSlowStartConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSlowStartConfig(), target.GetSlowStartConfig()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -226,6 +339,26 @@ func (m *LoadBalancerConfig_LeastRequest) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetSlowStartConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSlowStartConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSlowStartConfig(), target.GetSlowStartConfig()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetActiveRequestBias()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetActiveRequestBias()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetActiveRequestBias(), target.GetActiveRequestBias()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -342,3 +475,51 @@ func (m *LoadBalancerConfig_Maglev) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRoutingEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRoutingEnabled(), target.GetRoutingEnabled()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinClusterSize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinClusterSize(), target.GetMinClusterSize()) {
+			return false
+		}
+	}
+
+	if m.GetFailTrafficOnPanic() != target.GetFailTrafficOnPanic() {
+		return false
+	}
+
+	return true
+}
@@ -49,10 +49,16 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_ext_proc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/ext_proc"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
@@ -63,12 +69,24 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_hcm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_to_metadata "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_internal_redirect "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/internal_redirect"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_reply"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lua "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/network_rbac"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_proxy_protocol "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
@@ -83,12 +101,16 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_stats "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/stats"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tracing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_udp_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp_proxy"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
 
 	github_com_solo_io_solo_kit_pkg_api_external_envoy_api_v2_core "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
@@ -150,6 +172,18 @@ func (m *ListenerOptions) Clone() proto.Message {
 		target.ProxyProtocol = proto.Clone(m.GetProxyProtocol()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_proxy_protocol.ProxyProtocol)
 	}
 
+	if h, ok := interface{}(m.GetConnectionLimit()).(clone.Cloner); ok {
+		target.ConnectionLimit = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit.ConnectionLimit)
+	} else {
+		target.ConnectionLimit = proto.Clone(m.GetConnectionLimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit.ConnectionLimit)
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(clone.Cloner); ok {
+		target.NetworkRbac = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	} else {
+		target.NetworkRbac = proto.Clone(m.GetNetworkRbac()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	}
+
 	return target
 }
 
@@ -244,6 +278,12 @@ func (m *HttpListenerOptions) Clone() proto.Message {
 		target.Gzip = proto.Clone(m.GetGzip()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_filter_http_gzip_v2.Gzip)
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.Compression)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.Compression)
+	}
+
 	if h, ok := interface{}(m.GetProxyLatency()).(clone.Cloner); ok {
 		target.ProxyLatency = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_extensions_proxylatency.ProxyLatency)
 	} else {
@@ -292,6 +332,36 @@ func (m *HttpListenerOptions) Clone() proto.Message {
 		target.Router = proto.Clone(m.GetRouter()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_router.Router)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(clone.Cloner); ok {
+		target.LocalRatelimit = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	} else {
+		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	}
+
+	if h, ok := interface{}(m.GetExtProc()).(clone.Cloner); ok {
+		target.ExtProc = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_ext_proc.ExtProc)
+	} else {
+		target.ExtProc = proto.Clone(m.GetExtProc()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_ext_proc.ExtProc)
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(clone.Cloner); ok {
+		target.NetworkRbac = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	} else {
+		target.NetworkRbac = proto.Clone(m.GetNetworkRbac()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	}
+
+	if h, ok := interface{}(m.GetTap()).(clone.Cloner); ok {
+		target.Tap = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap.Tap)
+	} else {
+		target.Tap = proto.Clone(m.GetTap()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap.Tap)
+	}
+
+	if h, ok := interface{}(m.GetLua()).(clone.Cloner); ok {
+		target.Lua = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lua.Lua)
+	} else {
+		target.Lua = proto.Clone(m.GetLua()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lua.Lua)
+	}
+
 	return target
 }
 
@@ -309,6 +379,41 @@ func (m *TcpListenerOptions) Clone() proto.Message {
 		target.TcpProxySettings = proto.Clone(m.GetTcpProxySettings()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tcp.TcpProxySettings)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(clone.Cloner); ok {
+		target.LocalRatelimit = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.NetworkLocalRateLimit)
+	} else {
+		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.NetworkLocalRateLimit)
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(clone.Cloner); ok {
+		target.NetworkRbac = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	} else {
+		target.NetworkRbac = proto.Clone(m.GetNetworkRbac()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_network_rbac.NetworkRbac)
+	}
+
+	if h, ok := interface{}(m.GetTap()).(clone.Cloner); ok {
+		target.Tap = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap.Tap)
+	} else {
+		target.Tap = proto.Clone(m.GetTap()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap.Tap)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *UdpListenerOptions) Clone() proto.Message {
+	var target *UdpListenerOptions
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerOptions{}
+
+	if h, ok := interface{}(m.GetUdpProxySettings()).(clone.Cloner); ok {
+		target.UdpProxySettings = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_udp_proxy.UdpProxySettings)
+	} else {
+		target.UdpProxySettings = proto.Clone(m.GetUdpProxySettings()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_udp_proxy.UdpProxySettings)
+	}
+
 	return target
 }
 
@@ -416,6 +521,24 @@ func (m *VirtualHostOptions) Clone() proto.Message {
 		target.StagedTransformations = proto.Clone(m.GetStagedTransformations()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_transformation.TransformationStages)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(clone.Cloner); ok {
+		target.LocalRatelimit = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	} else {
+		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	}
+
+	if h, ok := interface{}(m.GetLocalReplyConfig()).(clone.Cloner); ok {
+		target.LocalReplyConfig = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply.LocalReplyConfig)
+	} else {
+		target.LocalReplyConfig = proto.Clone(m.GetLocalReplyConfig()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply.LocalReplyConfig)
+	}
+
+	if h, ok := interface{}(m.GetHeaderToMetadata()).(clone.Cloner); ok {
+		target.HeaderToMetadata = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_to_metadata.HeaderToMetadata)
+	} else {
+		target.HeaderToMetadata = proto.Clone(m.GetHeaderToMetadata()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_to_metadata.HeaderToMetadata)
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -697,6 +820,54 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(clone.Cloner); ok {
+		target.LocalRatelimit = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	} else {
+		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.LocalRateLimit)
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetExtProc()).(clone.Cloner); ok {
+		target.ExtProc = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_ext_proc.RouteExtProc)
+	} else {
+		target.ExtProc = proto.Clone(m.GetExtProc()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_ext_proc.RouteExtProc)
+	}
+
+	if h, ok := interface{}(m.GetLua()).(clone.Cloner); ok {
+		target.Lua = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lua.LuaPerRoute)
+	} else {
+		target.Lua = proto.Clone(m.GetLua()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lua.LuaPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetHeaderToMetadata()).(clone.Cloner); ok {
+		target.HeaderToMetadata = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_to_metadata.HeaderToMetadata)
+	} else {
+		target.HeaderToMetadata = proto.Clone(m.GetHeaderToMetadata()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_to_metadata.HeaderToMetadata)
+	}
+
+	if h, ok := interface{}(m.GetHedgePolicy()).(clone.Cloner); ok {
+		target.HedgePolicy = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_retries.HedgePolicy)
+	} else {
+		target.HedgePolicy = proto.Clone(m.GetHedgePolicy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_retries.HedgePolicy)
+	}
+
+	if h, ok := interface{}(m.GetInternalRedirectPolicy()).(clone.Cloner); ok {
+		target.InternalRedirectPolicy = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_internal_redirect.InternalRedirectPolicy)
+	} else {
+		target.InternalRedirectPolicy = proto.Clone(m.GetInternalRedirectPolicy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_internal_redirect.InternalRedirectPolicy)
+	}
+
+	if h, ok := interface{}(m.GetTap()).(clone.Cloner); ok {
+		target.Tap = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.Tap = proto.Clone(m.GetTap()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -103,6 +103,26 @@ func (m *ListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetConnectionLimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnectionLimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnectionLimit(), target.GetConnectionLimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNetworkRbac()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNetworkRbac(), target.GetNetworkRbac()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -271,6 +291,16 @@ func (m *HttpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetProxyLatency()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetProxyLatency()) {
 			return false
@@ -351,6 +381,56 @@ func (m *HttpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimit(), target.GetLocalRatelimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetExtProc()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExtProc()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExtProc(), target.GetExtProc()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNetworkRbac()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNetworkRbac(), target.GetNetworkRbac()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTap()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTap()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTap(), target.GetTap()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetLua()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLua()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLua(), target.GetLua()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -385,6 +465,70 @@ func (m *TcpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimit(), target.GetLocalRatelimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetNetworkRbac()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNetworkRbac()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNetworkRbac(), target.GetNetworkRbac()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTap()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTap()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTap(), target.GetTap()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UdpListenerOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListenerOptions)
+	if !ok {
+		that2, ok := that.(UdpListenerOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUdpProxySettings()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUdpProxySettings()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUdpProxySettings(), target.GetUdpProxySettings()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -569,6 +713,36 @@ func (m *VirtualHostOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimit(), target.GetLocalRatelimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetLocalReplyConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalReplyConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalReplyConfig(), target.GetLocalReplyConfig()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHeaderToMetadata()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHeaderToMetadata()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHeaderToMetadata(), target.GetHeaderToMetadata()) {
+			return false
+		}
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -1003,6 +1177,86 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimit(), target.GetLocalRatelimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetExtProc()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExtProc()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExtProc(), target.GetExtProc()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetLua()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLua()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLua(), target.GetLua()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHeaderToMetadata()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHeaderToMetadata()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHeaderToMetadata(), target.GetHeaderToMetadata()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHedgePolicy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHedgePolicy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHedgePolicy(), target.GetHedgePolicy()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetInternalRedirectPolicy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInternalRedirectPolicy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInternalRedirectPolicy(), target.GetInternalRedirectPolicy()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTap()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTap()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTap(), target.GetTap()) {
+			return false
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
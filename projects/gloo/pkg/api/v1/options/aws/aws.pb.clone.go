@@ -68,6 +68,34 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		target.DestinationOverrides = proto.Clone(m.GetDestinationOverrides()).(*DestinationSpec)
 	}
 
+	if h, ok := interface{}(m.GetFunctionDiscoveryOptions()).(clone.Cloner); ok {
+		target.FunctionDiscoveryOptions = h.Clone().(*LambdaFunctionDiscoveryOptions)
+	} else {
+		target.FunctionDiscoveryOptions = proto.Clone(m.GetFunctionDiscoveryOptions()).(*LambdaFunctionDiscoveryOptions)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LambdaFunctionDiscoveryOptions) Clone() proto.Message {
+	var target *LambdaFunctionDiscoveryOptions
+	if m == nil {
+		return target
+	}
+	target = &LambdaFunctionDiscoveryOptions{}
+
+	target.FunctionNamePrefix = m.GetFunctionNamePrefix()
+
+	if m.GetTagSelector() != nil {
+		target.TagSelector = make(map[string]string, len(m.GetTagSelector()))
+		for k, v := range m.GetTagSelector() {
+
+			target.TagSelector[k] = v
+
+		}
+	}
+
 	return target
 }
 
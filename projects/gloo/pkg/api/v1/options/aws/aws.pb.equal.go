@@ -99,6 +99,55 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFunctionDiscoveryOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFunctionDiscoveryOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFunctionDiscoveryOptions(), target.GetFunctionDiscoveryOptions()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LambdaFunctionDiscoveryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LambdaFunctionDiscoveryOptions)
+	if !ok {
+		that2, ok := that.(LambdaFunctionDiscoveryOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetFunctionNamePrefix(), target.GetFunctionNamePrefix()) != 0 {
+		return false
+	}
+
+	if len(m.GetTagSelector()) != len(target.GetTagSelector()) {
+		return false
+	}
+	for k, v := range m.GetTagSelector() {
+
+		if strings.Compare(v, target.GetTagSelector()[k]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
 
@@ -56,6 +56,10 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		}
 	}
 
+	target.SubscriptionId = m.GetSubscriptionId()
+
+	target.ResourceGroup = m.GetResourceGroup()
+
 	return target
 }
 
@@ -77,6 +77,14 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 
 	}
 
+	if strings.Compare(m.GetSubscriptionId(), target.GetSubscriptionId()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetResourceGroup(), target.GetResourceGroup()) != 0 {
+		return false
+	}
+
 	return true
 }
 
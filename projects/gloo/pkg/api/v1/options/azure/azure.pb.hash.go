@@ -86,6 +86,14 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if _, err = hasher.Write([]byte(m.GetSubscriptionId())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetResourceGroup())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
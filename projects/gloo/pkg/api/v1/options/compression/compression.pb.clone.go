@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *Compression) Clone() proto.Message {
+	var target *Compression
+	if m == nil {
+		return target
+	}
+	target = &Compression{}
+
+	if m.GetCompressors() != nil {
+		target.Compressors = make([]*Compressor, len(m.GetCompressors()))
+		for idx, v := range m.GetCompressors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Compressors[idx] = h.Clone().(*Compressor)
+			} else {
+				target.Compressors[idx] = proto.Clone(v).(*Compressor)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Compressor) Clone() proto.Message {
+	var target *Compressor
+	if m == nil {
+		return target
+	}
+	target = &Compressor{}
+
+	if h, ok := interface{}(m.GetMinContentLength()).(clone.Cloner); ok {
+		target.MinContentLength = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MinContentLength = proto.Clone(m.GetMinContentLength()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if m.GetContentType() != nil {
+		target.ContentType = make([]string, len(m.GetContentType()))
+		for idx, v := range m.GetContentType() {
+
+			target.ContentType[idx] = v
+
+		}
+	}
+
+	target.DisableOnEtagHeader = m.GetDisableOnEtagHeader()
+
+	target.RemoveAcceptEncodingHeader = m.GetRemoveAcceptEncodingHeader()
+
+	switch m.Library.(type) {
+
+	case *Compressor_Gzip:
+
+		if h, ok := interface{}(m.GetGzip()).(clone.Cloner); ok {
+			target.Library = &Compressor_Gzip{
+				Gzip: h.Clone().(*Gzip),
+			}
+		} else {
+			target.Library = &Compressor_Gzip{
+				Gzip: proto.Clone(m.GetGzip()).(*Gzip),
+			}
+		}
+
+	case *Compressor_Brotli:
+
+		if h, ok := interface{}(m.GetBrotli()).(clone.Cloner); ok {
+			target.Library = &Compressor_Brotli{
+				Brotli: h.Clone().(*Brotli),
+			}
+		} else {
+			target.Library = &Compressor_Brotli{
+				Brotli: proto.Clone(m.GetBrotli()).(*Brotli),
+			}
+		}
+
+	case *Compressor_Zstd:
+
+		if h, ok := interface{}(m.GetZstd()).(clone.Cloner); ok {
+			target.Library = &Compressor_Zstd{
+				Zstd: h.Clone().(*Zstd),
+			}
+		} else {
+			target.Library = &Compressor_Zstd{
+				Zstd: proto.Clone(m.GetZstd()).(*Zstd),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Gzip) Clone() proto.Message {
+	var target *Gzip
+	if m == nil {
+		return target
+	}
+	target = &Gzip{}
+
+	if h, ok := interface{}(m.GetMemoryLevel()).(clone.Cloner); ok {
+		target.MemoryLevel = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MemoryLevel = proto.Clone(m.GetMemoryLevel()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(clone.Cloner); ok {
+		target.CompressionLevel = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.CompressionLevel = proto.Clone(m.GetCompressionLevel()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.CompressionStrategy = m.GetCompressionStrategy()
+
+	if h, ok := interface{}(m.GetWindowBits()).(clone.Cloner); ok {
+		target.WindowBits = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.WindowBits = proto.Clone(m.GetWindowBits()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetChunkSize()).(clone.Cloner); ok {
+		target.ChunkSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.ChunkSize = proto.Clone(m.GetChunkSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Brotli) Clone() proto.Message {
+	var target *Brotli
+	if m == nil {
+		return target
+	}
+	target = &Brotli{}
+
+	if h, ok := interface{}(m.GetQuality()).(clone.Cloner); ok {
+		target.Quality = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.Quality = proto.Clone(m.GetQuality()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.EncoderMode = m.GetEncoderMode()
+
+	if h, ok := interface{}(m.GetWindowBits()).(clone.Cloner); ok {
+		target.WindowBits = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.WindowBits = proto.Clone(m.GetWindowBits()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetInputBlockBits()).(clone.Cloner); ok {
+		target.InputBlockBits = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.InputBlockBits = proto.Clone(m.GetInputBlockBits()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetChunkSize()).(clone.Cloner); ok {
+		target.ChunkSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.ChunkSize = proto.Clone(m.GetChunkSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.DisableLiteralContextModeling = m.GetDisableLiteralContextModeling()
+
+	return target
+}
+
+// Clone function
+func (m *Zstd) Clone() proto.Message {
+	var target *Zstd
+	if m == nil {
+		return target
+	}
+	target = &Zstd{}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(clone.Cloner); ok {
+		target.CompressionLevel = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.CompressionLevel = proto.Clone(m.GetCompressionLevel()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.EnableChecksum = m.GetEnableChecksum()
+
+	target.Strategy = m.GetStrategy()
+
+	if h, ok := interface{}(m.GetChunkSize()).(clone.Cloner); ok {
+		target.ChunkSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.ChunkSize = proto.Clone(m.GetChunkSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CompressionPerRoute) Clone() proto.Message {
+	var target *CompressionPerRoute
+	if m == nil {
+		return target
+	}
+	target = &CompressionPerRoute{}
+
+	target.Disabled = m.GetDisabled()
+
+	return target
+}
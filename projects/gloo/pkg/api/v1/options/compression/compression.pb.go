@@ -0,0 +1,1043 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Gzip_CompressionStrategy int32
+
+const (
+	Gzip_DEFAULT  Gzip_CompressionStrategy = 0
+	Gzip_FILTERED Gzip_CompressionStrategy = 1
+	Gzip_HUFFMAN  Gzip_CompressionStrategy = 2
+	Gzip_RLE      Gzip_CompressionStrategy = 3
+	Gzip_FIXED    Gzip_CompressionStrategy = 4
+)
+
+// Enum value maps for Gzip_CompressionStrategy.
+var (
+	Gzip_CompressionStrategy_name = map[int32]string{
+		0: "DEFAULT",
+		1: "FILTERED",
+		2: "HUFFMAN",
+		3: "RLE",
+		4: "FIXED",
+	}
+	Gzip_CompressionStrategy_value = map[string]int32{
+		"DEFAULT":  0,
+		"FILTERED": 1,
+		"HUFFMAN":  2,
+		"RLE":      3,
+		"FIXED":    4,
+	}
+)
+
+func (x Gzip_CompressionStrategy) Enum() *Gzip_CompressionStrategy {
+	p := new(Gzip_CompressionStrategy)
+	*p = x
+	return p
+}
+
+func (x Gzip_CompressionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Gzip_CompressionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0].Descriptor()
+}
+
+func (Gzip_CompressionStrategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0]
+}
+
+func (x Gzip_CompressionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Gzip_CompressionStrategy.Descriptor instead.
+func (Gzip_CompressionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Brotli_EncoderMode int32
+
+const (
+	Brotli_DEFAULT Brotli_EncoderMode = 0
+	Brotli_GENERIC Brotli_EncoderMode = 1
+	Brotli_TEXT    Brotli_EncoderMode = 2
+	Brotli_FONT    Brotli_EncoderMode = 3
+)
+
+// Enum value maps for Brotli_EncoderMode.
+var (
+	Brotli_EncoderMode_name = map[int32]string{
+		0: "DEFAULT",
+		1: "GENERIC",
+		2: "TEXT",
+		3: "FONT",
+	}
+	Brotli_EncoderMode_value = map[string]int32{
+		"DEFAULT": 0,
+		"GENERIC": 1,
+		"TEXT":    2,
+		"FONT":    3,
+	}
+)
+
+func (x Brotli_EncoderMode) Enum() *Brotli_EncoderMode {
+	p := new(Brotli_EncoderMode)
+	*p = x
+	return p
+}
+
+func (x Brotli_EncoderMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Brotli_EncoderMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1].Descriptor()
+}
+
+func (Brotli_EncoderMode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1]
+}
+
+func (x Brotli_EncoderMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Brotli_EncoderMode.Descriptor instead.
+func (Brotli_EncoderMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Zstd_Strategy int32
+
+const (
+	Zstd_DEFAULT  Zstd_Strategy = 0
+	Zstd_FAST     Zstd_Strategy = 1
+	Zstd_DFAST    Zstd_Strategy = 2
+	Zstd_GREEDY   Zstd_Strategy = 3
+	Zstd_LAZY     Zstd_Strategy = 4
+	Zstd_LAZY2    Zstd_Strategy = 5
+	Zstd_BTLAZY2  Zstd_Strategy = 6
+	Zstd_BTOPT    Zstd_Strategy = 7
+	Zstd_BTULTRA  Zstd_Strategy = 8
+	Zstd_BTULTRA2 Zstd_Strategy = 9
+)
+
+// Enum value maps for Zstd_Strategy.
+var (
+	Zstd_Strategy_name = map[int32]string{
+		0: "DEFAULT",
+		1: "FAST",
+		2: "DFAST",
+		3: "GREEDY",
+		4: "LAZY",
+		5: "LAZY2",
+		6: "BTLAZY2",
+		7: "BTOPT",
+		8: "BTULTRA",
+		9: "BTULTRA2",
+	}
+	Zstd_Strategy_value = map[string]int32{
+		"DEFAULT":  0,
+		"FAST":     1,
+		"DFAST":    2,
+		"GREEDY":   3,
+		"LAZY":     4,
+		"LAZY2":    5,
+		"BTLAZY2":  6,
+		"BTOPT":    7,
+		"BTULTRA":  8,
+		"BTULTRA2": 9,
+	}
+)
+
+func (x Zstd_Strategy) Enum() *Zstd_Strategy {
+	p := new(Zstd_Strategy)
+	*p = x
+	return p
+}
+
+func (x Zstd_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Zstd_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2].Descriptor()
+}
+
+func (Zstd_Strategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2]
+}
+
+func (x Zstd_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Zstd_Strategy.Descriptor instead.
+func (Zstd_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// Compression of HTTP responses, using Envoy's generic compressor filter.
+// A separate filter is configured for each compressor, and Envoy picks the compressor that best matches the
+// `accept-encoding` header of the request.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/compressor/v3/compressor.proto
+// Example:
+// ```
+// compression:
+//   compressors:
+//   - brotli:
+//       quality: 5
+//   - gzip:
+//       compressionLevel: 6
+//     contentType:
+//     - "application/json"
+// ```
+type Compression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compressors to use. At most one compressor may be configured for each library.
+	Compressors []*Compressor `protobuf:"bytes,1,rep,name=compressors,proto3" json:"compressors,omitempty"`
+}
+
+func (x *Compression) Reset() {
+	*x = Compression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compression) ProtoMessage() {}
+
+func (x *Compression) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compression.ProtoReflect.Descriptor instead.
+func (*Compression) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Compression) GetCompressors() []*Compressor {
+	if x != nil {
+		return x.Compressors
+	}
+	return nil
+}
+
+// A single compressor, with its library and the rules deciding which responses it compresses.
+type Compressor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compression library.
+	//
+	// Types that are assignable to Library:
+	//	*Compressor_Gzip
+	//	*Compressor_Brotli
+	//	*Compressor_Zstd
+	Library isCompressor_Library `protobuf_oneof:"library"`
+	// Minimum response length, in bytes, which will trigger compression. The default value is 30.
+	MinContentLength *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=min_content_length,json=minContentLength,proto3" json:"min_content_length,omitempty"`
+	// Set of strings that allows specifying which mime-types yield compression; e.g.,
+	// application/json, text/html, etc. When this field is not defined, compression will be applied
+	// to the following mime-types: "application/javascript", "application/json",
+	// "application/xhtml+xml", "image/svg+xml", "text/css", "text/html", "text/plain", "text/xml"
+	// and their synonyms.
+	ContentType []string `protobuf:"bytes,5,rep,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// If true, disables compression when the response contains an etag header. When it is false, the
+	// filter will preserve weak etags and remove the ones that require strong validation.
+	DisableOnEtagHeader bool `protobuf:"varint,6,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+	// If true, removes accept-encoding from the request headers before dispatching it to the upstream
+	// so that responses do not get compressed before reaching the filter.
+	RemoveAcceptEncodingHeader bool `protobuf:"varint,7,opt,name=remove_accept_encoding_header,json=removeAcceptEncodingHeader,proto3" json:"remove_accept_encoding_header,omitempty"`
+}
+
+func (x *Compressor) Reset() {
+	*x = Compressor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compressor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compressor) ProtoMessage() {}
+
+func (x *Compressor) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compressor.ProtoReflect.Descriptor instead.
+func (*Compressor) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Compressor) GetLibrary() isCompressor_Library {
+	if m != nil {
+		return m.Library
+	}
+	return nil
+}
+
+func (x *Compressor) GetGzip() *Gzip {
+	if x, ok := x.GetLibrary().(*Compressor_Gzip); ok {
+		return x.Gzip
+	}
+	return nil
+}
+
+func (x *Compressor) GetBrotli() *Brotli {
+	if x, ok := x.GetLibrary().(*Compressor_Brotli); ok {
+		return x.Brotli
+	}
+	return nil
+}
+
+func (x *Compressor) GetZstd() *Zstd {
+	if x, ok := x.GetLibrary().(*Compressor_Zstd); ok {
+		return x.Zstd
+	}
+	return nil
+}
+
+func (x *Compressor) GetMinContentLength() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MinContentLength
+	}
+	return nil
+}
+
+func (x *Compressor) GetContentType() []string {
+	if x != nil {
+		return x.ContentType
+	}
+	return nil
+}
+
+func (x *Compressor) GetDisableOnEtagHeader() bool {
+	if x != nil {
+		return x.DisableOnEtagHeader
+	}
+	return false
+}
+
+func (x *Compressor) GetRemoveAcceptEncodingHeader() bool {
+	if x != nil {
+		return x.RemoveAcceptEncodingHeader
+	}
+	return false
+}
+
+type isCompressor_Library interface {
+	isCompressor_Library()
+}
+
+type Compressor_Gzip struct {
+	// Compress responses using gzip.
+	Gzip *Gzip `protobuf:"bytes,1,opt,name=gzip,proto3,oneof"`
+}
+
+type Compressor_Brotli struct {
+	// Compress responses using brotli.
+	Brotli *Brotli `protobuf:"bytes,2,opt,name=brotli,proto3,oneof"`
+}
+
+type Compressor_Zstd struct {
+	// Compress responses using zstd.
+	Zstd *Zstd `protobuf:"bytes,3,opt,name=zstd,proto3,oneof"`
+}
+
+func (*Compressor_Gzip) isCompressor_Library() {}
+
+func (*Compressor_Brotli) isCompressor_Library() {}
+
+func (*Compressor_Zstd) isCompressor_Library() {}
+
+// Settings for the gzip compression library.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/gzip/compressor/v3/gzip.proto
+type Gzip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value from 1 to 9 that controls the amount of internal memory used by zlib. Higher values
+	// use more memory, but are faster and produce better compression results. The default value is 5.
+	MemoryLevel *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=memory_level,json=memoryLevel,proto3" json:"memory_level,omitempty"`
+	// Value from 1 to 9 that controls the compression level. 1 provides the fastest compression,
+	// 9 the best compression. If not specified, zlib's default compression level is used.
+	CompressionLevel *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// A value used for selecting the zlib compression strategy which is directly related to the
+	// characteristics of the content. Most of the time "DEFAULT" will be the best choice.
+	CompressionStrategy Gzip_CompressionStrategy `protobuf:"varint,3,opt,name=compression_strategy,json=compressionStrategy,proto3,enum=compression.options.gloo.solo.io.Gzip_CompressionStrategy" json:"compression_strategy,omitempty"`
+	// Value from 9 to 15 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 12
+	// which will produce a 4096 bytes window.
+	WindowBits *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+	// Value for Zlib's next output buffer. If not set, defaults to 4096.
+	ChunkSize *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *Gzip) Reset() {
+	*x = Gzip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gzip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gzip) ProtoMessage() {}
+
+func (x *Gzip) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gzip.ProtoReflect.Descriptor instead.
+func (*Gzip) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Gzip) GetMemoryLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MemoryLevel
+	}
+	return nil
+}
+
+func (x *Gzip) GetCompressionLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return nil
+}
+
+func (x *Gzip) GetCompressionStrategy() Gzip_CompressionStrategy {
+	if x != nil {
+		return x.CompressionStrategy
+	}
+	return Gzip_DEFAULT
+}
+
+func (x *Gzip) GetWindowBits() *wrappers.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+func (x *Gzip) GetChunkSize() *wrappers.UInt32Value {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return nil
+}
+
+// Settings for the brotli compression library.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/brotli/compressor/v3/brotli.proto
+type Brotli struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value from 0 to 11 that controls the main compression speed-density lever.
+	// The higher quality, the slower compression. The default value is 3.
+	Quality *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=quality,proto3" json:"quality,omitempty"`
+	// A value used to tune encoder for specific input. For more information about modes,
+	// please refer to brotli manual.
+	EncoderMode Brotli_EncoderMode `protobuf:"varint,2,opt,name=encoder_mode,json=encoderMode,proto3,enum=compression.options.gloo.solo.io.Brotli_EncoderMode" json:"encoder_mode,omitempty"`
+	// Value from 10 to 24 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 18.
+	WindowBits *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+	// Value from 16 to 24 that represents the base two logarithmic of the compressor's input block
+	// size. Larger input block results in better compression at the expense of memory usage. The
+	// default is 24.
+	InputBlockBits *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=input_block_bits,json=inputBlockBits,proto3" json:"input_block_bits,omitempty"`
+	// Value for compressor's next output buffer. If not set, defaults to 4096.
+	ChunkSize *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// If true, disables "literal context modeling" format feature.
+	// This flag is a "decoding-speed vs compression ratio" trade-off.
+	DisableLiteralContextModeling bool `protobuf:"varint,6,opt,name=disable_literal_context_modeling,json=disableLiteralContextModeling,proto3" json:"disable_literal_context_modeling,omitempty"`
+}
+
+func (x *Brotli) Reset() {
+	*x = Brotli{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brotli) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brotli) ProtoMessage() {}
+
+func (x *Brotli) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brotli.ProtoReflect.Descriptor instead.
+func (*Brotli) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Brotli) GetQuality() *wrappers.UInt32Value {
+	if x != nil {
+		return x.Quality
+	}
+	return nil
+}
+
+func (x *Brotli) GetEncoderMode() Brotli_EncoderMode {
+	if x != nil {
+		return x.EncoderMode
+	}
+	return Brotli_DEFAULT
+}
+
+func (x *Brotli) GetWindowBits() *wrappers.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+func (x *Brotli) GetInputBlockBits() *wrappers.UInt32Value {
+	if x != nil {
+		return x.InputBlockBits
+	}
+	return nil
+}
+
+func (x *Brotli) GetChunkSize() *wrappers.UInt32Value {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return nil
+}
+
+func (x *Brotli) GetDisableLiteralContextModeling() bool {
+	if x != nil {
+		return x.DisableLiteralContextModeling
+	}
+	return false
+}
+
+// Settings for the zstd compression library.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/zstd/compressor/v3/zstd.proto
+type Zstd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set compression parameters according to pre-defined compression level table.
+	// Note that exact compression parameters are dynamically determined,
+	// depending on both compression level and source content size (when known).
+	// Value 0 means default, and default level is 3.
+	CompressionLevel *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// A 32-bits checksum of content is written at end of frame. If not set, defaults to false.
+	EnableChecksum bool `protobuf:"varint,2,opt,name=enable_checksum,json=enableChecksum,proto3" json:"enable_checksum,omitempty"`
+	// The higher the value of selected strategy, the more complex it is,
+	// resulting in stronger and slower compression.
+	Strategy Zstd_Strategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=compression.options.gloo.solo.io.Zstd_Strategy" json:"strategy,omitempty"`
+	// Value for compressor's next output buffer. If not set, defaults to 4096.
+	ChunkSize *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *Zstd) Reset() {
+	*x = Zstd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Zstd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zstd) ProtoMessage() {}
+
+func (x *Zstd) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zstd.ProtoReflect.Descriptor instead.
+func (*Zstd) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Zstd) GetCompressionLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return nil
+}
+
+func (x *Zstd) GetEnableChecksum() bool {
+	if x != nil {
+		return x.EnableChecksum
+	}
+	return false
+}
+
+func (x *Zstd) GetStrategy() Zstd_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Zstd_DEFAULT
+}
+
+func (x *Zstd) GetChunkSize() *wrappers.UInt32Value {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return nil
+}
+
+// Per-route compression settings.
+type CompressionPerRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set to true, responses for requests matched on this route are not compressed.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *CompressionPerRoute) Reset() {
+	*x = CompressionPerRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompressionPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressionPerRoute) ProtoMessage() {}
+
+func (x *CompressionPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressionPerRoute.ProtoReflect.Descriptor instead.
+func (*CompressionPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompressionPerRoute) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc = []byte{
+	0x0a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0xc8, 0x03, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x67,
+	0x7a, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x7a, 0x69,
+	0x70, 0x48, 0x00, 0x52, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x12, 0x42, 0x0a, 0x06, 0x62, 0x72, 0x6f,
+	0x74, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x42, 0x72, 0x6f,
+	0x74, 0x6c, 0x69, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x12, 0x3c, 0x0a,
+	0x04, 0x7a, 0x73, 0x74, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x5a,
+	0x73, 0x74, 0x64, 0x48, 0x00, 0x52, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x12, 0x4a, 0x0a, 0x12, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x32, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x65, 0x74, 0x61, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x45,
+	0x74, 0x61, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x22, 0x89, 0x04, 0x0a, 0x04, 0x47, 0x7a, 0x69, 0x70,
+	0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x09, 0x28, 0x01, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x54, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x09, 0x28, 0x01,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x77, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x7a, 0x69, 0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x48, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x0f, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x42, 0x69, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x2a, 0x07, 0x18, 0x80,
+	0x80, 0x04, 0x28, 0x80, 0x20, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x51, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x55, 0x46, 0x46, 0x4d, 0x41, 0x4e, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x52, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x58, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0x9a, 0x04, 0x0a, 0x06, 0x42, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x12, 0x3f,
+	0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x2a, 0x02, 0x18, 0x0b, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x61, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x42, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x18, 0x28, 0x0a,
+	0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x69, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x10,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x69, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x18, 0x28, 0x10, 0x52,
+	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x69, 0x74, 0x73, 0x12,
+	0x49, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x2a, 0x07, 0x18, 0x80, 0x80, 0x04, 0x28, 0x80, 0x20, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x69, 0x6e, 0x67, 0x22, 0x3b, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4f, 0x4e, 0x54, 0x10, 0x03,
+	0x22, 0xa8, 0x03, 0x0a, 0x04, 0x5a, 0x73, 0x74, 0x64, 0x12, 0x52, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x16, 0x52, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x55, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x5a, 0x73, 0x74, 0x64,
+	0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x49, 0x0a,
+	0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x0c, 0xfa, 0x42, 0x09, 0x2a, 0x07, 0x18, 0x80, 0x80, 0x04, 0x28, 0x80, 0x20, 0x52, 0x09, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x44, 0x46, 0x41, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x52, 0x45, 0x45, 0x44,
+	0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x5a, 0x59, 0x10, 0x04, 0x12, 0x09, 0x0a,
+	0x05, 0x4c, 0x41, 0x5a, 0x59, 0x32, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x54, 0x4c, 0x41,
+	0x5a, 0x59, 0x32, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x54, 0x4f, 0x50, 0x54, 0x10, 0x07,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x54, 0x55, 0x4c, 0x54, 0x52, 0x41, 0x10, 0x08, 0x12, 0x0c, 0x0a,
+	0x08, 0x42, 0x54, 0x55, 0x4c, 0x54, 0x52, 0x41, 0x32, 0x10, 0x09, 0x22, 0x31, 0x0a, 0x13, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x52,
+	0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5,
+	0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = []interface{}{
+	(Gzip_CompressionStrategy)(0), // 0: compression.options.gloo.solo.io.Gzip.CompressionStrategy
+	(Brotli_EncoderMode)(0),       // 1: compression.options.gloo.solo.io.Brotli.EncoderMode
+	(Zstd_Strategy)(0),            // 2: compression.options.gloo.solo.io.Zstd.Strategy
+	(*Compression)(nil),           // 3: compression.options.gloo.solo.io.Compression
+	(*Compressor)(nil),            // 4: compression.options.gloo.solo.io.Compressor
+	(*Gzip)(nil),                  // 5: compression.options.gloo.solo.io.Gzip
+	(*Brotli)(nil),                // 6: compression.options.gloo.solo.io.Brotli
+	(*Zstd)(nil),                  // 7: compression.options.gloo.solo.io.Zstd
+	(*CompressionPerRoute)(nil),   // 8: compression.options.gloo.solo.io.CompressionPerRoute
+	(*wrappers.UInt32Value)(nil),  // 9: google.protobuf.UInt32Value
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = []int32{
+	4,  // 0: compression.options.gloo.solo.io.Compression.compressors:type_name -> compression.options.gloo.solo.io.Compressor
+	5,  // 1: compression.options.gloo.solo.io.Compressor.gzip:type_name -> compression.options.gloo.solo.io.Gzip
+	6,  // 2: compression.options.gloo.solo.io.Compressor.brotli:type_name -> compression.options.gloo.solo.io.Brotli
+	7,  // 3: compression.options.gloo.solo.io.Compressor.zstd:type_name -> compression.options.gloo.solo.io.Zstd
+	9,  // 4: compression.options.gloo.solo.io.Compressor.min_content_length:type_name -> google.protobuf.UInt32Value
+	9,  // 5: compression.options.gloo.solo.io.Gzip.memory_level:type_name -> google.protobuf.UInt32Value
+	9,  // 6: compression.options.gloo.solo.io.Gzip.compression_level:type_name -> google.protobuf.UInt32Value
+	0,  // 7: compression.options.gloo.solo.io.Gzip.compression_strategy:type_name -> compression.options.gloo.solo.io.Gzip.CompressionStrategy
+	9,  // 8: compression.options.gloo.solo.io.Gzip.window_bits:type_name -> google.protobuf.UInt32Value
+	9,  // 9: compression.options.gloo.solo.io.Gzip.chunk_size:type_name -> google.protobuf.UInt32Value
+	9,  // 10: compression.options.gloo.solo.io.Brotli.quality:type_name -> google.protobuf.UInt32Value
+	1,  // 11: compression.options.gloo.solo.io.Brotli.encoder_mode:type_name -> compression.options.gloo.solo.io.Brotli.EncoderMode
+	9,  // 12: compression.options.gloo.solo.io.Brotli.window_bits:type_name -> google.protobuf.UInt32Value
+	9,  // 13: compression.options.gloo.solo.io.Brotli.input_block_bits:type_name -> google.protobuf.UInt32Value
+	9,  // 14: compression.options.gloo.solo.io.Brotli.chunk_size:type_name -> google.protobuf.UInt32Value
+	9,  // 15: compression.options.gloo.solo.io.Zstd.compression_level:type_name -> google.protobuf.UInt32Value
+	2,  // 16: compression.options.gloo.solo.io.Zstd.strategy:type_name -> compression.options.gloo.solo.io.Zstd.Strategy
+	9,  // 17: compression.options.gloo.solo.io.Zstd.chunk_size:type_name -> google.protobuf.UInt32Value
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compressor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gzip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Brotli); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Zstd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompressionPerRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Compressor_Gzip)(nil),
+		(*Compressor_Brotli)(nil),
+		(*Compressor_Zstd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = nil
+}
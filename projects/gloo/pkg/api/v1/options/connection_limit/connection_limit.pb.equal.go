@@ -0,0 +1,70 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto
+
+package connection_limit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ConnectionLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ConnectionLimit)
+	if !ok {
+		that2, ok := that.(ConnectionLimit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxActiveConnectionsPerFilterChain()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxActiveConnectionsPerFilterChain()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxActiveConnectionsPerFilterChain(), target.GetMaxActiveConnectionsPerFilterChain()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetDelayBeforeClose()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDelayBeforeClose()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDelayBeforeClose(), target.GetDelayBeforeClose()) {
+			return false
+		}
+	}
+
+	return true
+}
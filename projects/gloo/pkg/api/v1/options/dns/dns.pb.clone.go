@@ -0,0 +1,66 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns/dns.proto
+
+package dns
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *DnsOptions) Clone() proto.Message {
+	var target *DnsOptions
+	if m == nil {
+		return target
+	}
+	target = &DnsOptions{}
+
+	target.DiscoveryType = m.GetDiscoveryType()
+
+	target.LookupFamily = m.GetLookupFamily()
+
+	if h, ok := interface{}(m.GetDnsRefreshRate()).(clone.Cloner); ok {
+		target.DnsRefreshRate = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.DnsRefreshRate = proto.Clone(m.GetDnsRefreshRate()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetRespectDnsTtl()).(clone.Cloner); ok {
+		target.RespectDnsTtl = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.RespectDnsTtl = proto.Clone(m.GetRespectDnsTtl()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	if m.GetResolvers() != nil {
+		target.Resolvers = make([]string, len(m.GetResolvers()))
+		for idx, v := range m.GetResolvers() {
+
+			target.Resolvers[idx] = v
+
+		}
+	}
+
+	return target
+}
@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns/dns.proto
+
+package dns
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *DnsOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DnsOptions)
+	if !ok {
+		that2, ok := that.(DnsOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetDiscoveryType() != target.GetDiscoveryType() {
+		return false
+	}
+
+	if m.GetLookupFamily() != target.GetLookupFamily() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDnsRefreshRate()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDnsRefreshRate()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDnsRefreshRate(), target.GetDnsRefreshRate()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRespectDnsTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRespectDnsTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRespectDnsTtl(), target.GetRespectDnsTtl()) {
+			return false
+		}
+	}
+
+	if len(m.GetResolvers()) != len(target.GetResolvers()) {
+		return false
+	}
+	for idx, v := range m.GetResolvers() {
+
+		if strings.Compare(v, target.GetResolvers()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
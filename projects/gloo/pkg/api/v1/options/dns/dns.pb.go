@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns/dns.proto
+
+package dns
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How Envoy turns the resolved addresses into endpoints.
+// See https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/service_discovery#arch-overview-service-discovery-types
+type DnsOptions_DiscoveryType int32
+
+const (
+	// Use the discovery type of the Settings, or STRICT_DNS.
+	DnsOptions_UNSPECIFIED_DISCOVERY_TYPE DnsOptions_DiscoveryType = 0
+	// Every address returned by the DNS server is an endpoint of the upstream, and the endpoints are updated on
+	// each refresh. Connections to addresses which are no longer returned are drained.
+	DnsOptions_STRICT_DNS DnsOptions_DiscoveryType = 1
+	// Only the first address returned by the DNS server is used, and existing connections are kept when it changes.
+	// Suited to large web services whose addresses rotate, such as cloud load balancers.
+	// Can only be used by upstreams with a single host.
+	DnsOptions_LOGICAL_DNS DnsOptions_DiscoveryType = 2
+)
+
+// Enum value maps for DnsOptions_DiscoveryType.
+var (
+	DnsOptions_DiscoveryType_name = map[int32]string{
+		0: "UNSPECIFIED_DISCOVERY_TYPE",
+		1: "STRICT_DNS",
+		2: "LOGICAL_DNS",
+	}
+	DnsOptions_DiscoveryType_value = map[string]int32{
+		"UNSPECIFIED_DISCOVERY_TYPE": 0,
+		"STRICT_DNS":                 1,
+		"LOGICAL_DNS":                2,
+	}
+)
+
+func (x DnsOptions_DiscoveryType) Enum() *DnsOptions_DiscoveryType {
+	p := new(DnsOptions_DiscoveryType)
+	*p = x
+	return p
+}
+
+func (x DnsOptions_DiscoveryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DnsOptions_DiscoveryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes[0].Descriptor()
+}
+
+func (DnsOptions_DiscoveryType) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes[0]
+}
+
+func (x DnsOptions_DiscoveryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DnsOptions_DiscoveryType.Descriptor instead.
+func (DnsOptions_DiscoveryType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// The IP families of the addresses Envoy looks up.
+type DnsOptions_LookupFamily int32
+
+const (
+	// Use the lookup family of the Settings, or V4_ONLY.
+	DnsOptions_UNSPECIFIED_LOOKUP_FAMILY DnsOptions_LookupFamily = 0
+	// Only look up IPv4 addresses.
+	DnsOptions_V4_ONLY DnsOptions_LookupFamily = 1
+	// Only look up IPv6 addresses.
+	DnsOptions_V6_ONLY DnsOptions_LookupFamily = 2
+	// Look up IPv6 addresses, and fall back to IPv4 addresses if there are none.
+	DnsOptions_AUTO DnsOptions_LookupFamily = 3
+	// Look up IPv4 addresses, and fall back to IPv6 addresses if there are none.
+	DnsOptions_V4_PREFERRED DnsOptions_LookupFamily = 4
+	// Look up both IPv4 and IPv6 addresses.
+	DnsOptions_ALL DnsOptions_LookupFamily = 5
+)
+
+// Enum value maps for DnsOptions_LookupFamily.
+var (
+	DnsOptions_LookupFamily_name = map[int32]string{
+		0: "UNSPECIFIED_LOOKUP_FAMILY",
+		1: "V4_ONLY",
+		2: "V6_ONLY",
+		3: "AUTO",
+		4: "V4_PREFERRED",
+		5: "ALL",
+	}
+	DnsOptions_LookupFamily_value = map[string]int32{
+		"UNSPECIFIED_LOOKUP_FAMILY": 0,
+		"V4_ONLY":                   1,
+		"V6_ONLY":                   2,
+		"AUTO":                      3,
+		"V4_PREFERRED":              4,
+		"ALL":                       5,
+	}
+)
+
+func (x DnsOptions_LookupFamily) Enum() *DnsOptions_LookupFamily {
+	p := new(DnsOptions_LookupFamily)
+	*p = x
+	return p
+}
+
+func (x DnsOptions_LookupFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DnsOptions_LookupFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes[1].Descriptor()
+}
+
+func (DnsOptions_LookupFamily) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes[1]
+}
+
+func (x DnsOptions_LookupFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DnsOptions_LookupFamily.Descriptor instead.
+func (DnsOptions_LookupFamily) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Controls how Envoy resolves the hostnames of an upstream.
+// Fields which are not set on the upstream are read from the `staticUpstreamDnsOptions` of the Settings, and then
+// fall back to the Envoy defaults, except for the lookup family which defaults to V4_ONLY.
+// Example:
+// ```
+// dnsOptions:
+//   discoveryType: LOGICAL_DNS
+//   lookupFamily: V4_PREFERRED
+//   dnsRefreshRate: 30s
+//   respectDnsTtl: true
+//   resolvers:
+//   - 10.0.0.10
+//   - 10.0.0.11:5353
+// ```
+type DnsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The DNS discovery type of the upstream.
+	DiscoveryType DnsOptions_DiscoveryType `protobuf:"varint,1,opt,name=discovery_type,json=discoveryType,proto3,enum=dns.options.gloo.solo.io.DnsOptions_DiscoveryType" json:"discovery_type,omitempty"`
+	// The lookup family of the upstream.
+	LookupFamily DnsOptions_LookupFamily `protobuf:"varint,2,opt,name=lookup_family,json=lookupFamily,proto3,enum=dns.options.gloo.solo.io.DnsOptions_LookupFamily" json:"lookup_family,omitempty"`
+	// The interval between two resolutions of the hostnames. Must be greater than 1ms. Envoy defaults to 5s.
+	DnsRefreshRate *duration.Duration `protobuf:"bytes,3,opt,name=dns_refresh_rate,json=dnsRefreshRate,proto3" json:"dns_refresh_rate,omitempty"`
+	// If true, the TTL of the DNS records is used as the refresh rate, instead of `dnsRefreshRate`.
+	RespectDnsTtl *wrappers.BoolValue `protobuf:"bytes,4,opt,name=respect_dns_ttl,json=respectDnsTtl,proto3" json:"respect_dns_ttl,omitempty"`
+	// The addresses of the DNS servers used to resolve the hostnames, instead of the servers of the system.
+	// Each resolver is an IP address, optionally followed by a port, which defaults to 53.
+	// For example `10.0.0.10`, `10.0.0.10:5353` or `[fd00::10]:53`.
+	Resolvers []string `protobuf:"bytes,5,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
+}
+
+func (x *DnsOptions) Reset() {
+	*x = DnsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsOptions) ProtoMessage() {}
+
+func (x *DnsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsOptions.ProtoReflect.Descriptor instead.
+func (*DnsOptions) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DnsOptions) GetDiscoveryType() DnsOptions_DiscoveryType {
+	if x != nil {
+		return x.DiscoveryType
+	}
+	return DnsOptions_UNSPECIFIED_DISCOVERY_TYPE
+}
+
+func (x *DnsOptions) GetLookupFamily() DnsOptions_LookupFamily {
+	if x != nil {
+		return x.LookupFamily
+	}
+	return DnsOptions_UNSPECIFIED_LOOKUP_FAMILY
+}
+
+func (x *DnsOptions) GetDnsRefreshRate() *duration.Duration {
+	if x != nil {
+		return x.DnsRefreshRate
+	}
+	return nil
+}
+
+func (x *DnsOptions) GetRespectDnsTtl() *wrappers.BoolValue {
+	if x != nil {
+		return x.RespectDnsTtl
+	}
+	return nil
+}
+
+func (x *DnsOptions) GetResolvers() []string {
+	if x != nil {
+		return x.Resolvers
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDesc = []byte{
+	0x0a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x64, 0x6e, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12,
+	0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x04, 0x0a, 0x0a,
+	0x44, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x6e,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x60, 0x0a, 0x0d, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x44, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x51, 0x0a, 0x10, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x2a, 0x04,
+	0x10, 0xc0, 0x84, 0x3d, 0x52, 0x0e, 0x64, 0x6e, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f,
+	0x64, 0x6e, 0x73, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x44, 0x6e, 0x73, 0x54, 0x74, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x52, 0x49, 0x43,
+	0x54, 0x5f, 0x44, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x4f, 0x47, 0x49, 0x43,
+	0x41, 0x4c, 0x5f, 0x44, 0x4e, 0x53, 0x10, 0x02, 0x22, 0x6c, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x46,
+	0x41, 0x4d, 0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x34, 0x5f, 0x4f, 0x4e,
+	0x4c, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x56,
+	0x34, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x42, 0x4a, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5,
+	0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_goTypes = []interface{}{
+	(DnsOptions_DiscoveryType)(0), // 0: dns.options.gloo.solo.io.DnsOptions.DiscoveryType
+	(DnsOptions_LookupFamily)(0),  // 1: dns.options.gloo.solo.io.DnsOptions.LookupFamily
+	(*DnsOptions)(nil),            // 2: dns.options.gloo.solo.io.DnsOptions
+	(*duration.Duration)(nil),     // 3: google.protobuf.Duration
+	(*wrappers.BoolValue)(nil),    // 4: google.protobuf.BoolValue
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_depIdxs = []int32{
+	0, // 0: dns.options.gloo.solo.io.DnsOptions.discovery_type:type_name -> dns.options.gloo.solo.io.DnsOptions.DiscoveryType
+	1, // 1: dns.options.gloo.solo.io.DnsOptions.lookup_family:type_name -> dns.options.gloo.solo.io.DnsOptions.LookupFamily
+	3, // 2: dns.options.gloo.solo.io.DnsOptions.dns_refresh_rate:type_name -> google.protobuf.Duration
+	4, // 3: dns.options.gloo.solo.io.DnsOptions.respect_dns_ttl:type_name -> google.protobuf.BoolValue
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_init() }
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_dns_dns_proto_depIdxs = nil
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/ext_proc/ext_proc.proto
+
+package ext_proc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *ExtProc) Clone() proto.Message {
+	var target *ExtProc
+	if m == nil {
+		return target
+	}
+	target = &ExtProc{}
+
+	if h, ok := interface{}(m.GetGrpcService()).(clone.Cloner); ok {
+		target.GrpcService = h.Clone().(*GrpcService)
+	} else {
+		target.GrpcService = proto.Clone(m.GetGrpcService()).(*GrpcService)
+	}
+
+	if h, ok := interface{}(m.GetFilterStage()).(clone.Cloner); ok {
+		target.FilterStage = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm.FilterStage)
+	} else {
+		target.FilterStage = proto.Clone(m.GetFilterStage()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm.FilterStage)
+	}
+
+	target.FailureModeAllow = m.GetFailureModeAllow()
+
+	if h, ok := interface{}(m.GetProcessingMode()).(clone.Cloner); ok {
+		target.ProcessingMode = h.Clone().(*ProcessingMode)
+	} else {
+		target.ProcessingMode = proto.Clone(m.GetProcessingMode()).(*ProcessingMode)
+	}
+
+	if h, ok := interface{}(m.GetMessageTimeout()).(clone.Cloner); ok {
+		target.MessageTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MessageTimeout = proto.Clone(m.GetMessageTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	return target
+}
+
+// Clone function
+func (m *GrpcService) Clone() proto.Message {
+	var target *GrpcService
+	if m == nil {
+		return target
+	}
+	target = &GrpcService{}
+
+	if h, ok := interface{}(m.GetExtProcServerRef()).(clone.Cloner); ok {
+		target.ExtProcServerRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.ExtProcServerRef = proto.Clone(m.GetExtProcServerRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.Authority = m.GetAuthority()
+
+	if h, ok := interface{}(m.GetTimeout()).(clone.Cloner); ok {
+		target.Timeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Timeout = proto.Clone(m.GetTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if m.GetInitialMetadata() != nil {
+		target.InitialMetadata = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValue, len(m.GetInitialMetadata()))
+		for idx, v := range m.GetInitialMetadata() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.InitialMetadata[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValue)
+			} else {
+				target.InitialMetadata[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValue)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ProcessingMode) Clone() proto.Message {
+	var target *ProcessingMode
+	if m == nil {
+		return target
+	}
+	target = &ProcessingMode{}
+
+	target.RequestHeaderMode = m.GetRequestHeaderMode()
+
+	target.ResponseHeaderMode = m.GetResponseHeaderMode()
+
+	target.RequestBodyMode = m.GetRequestBodyMode()
+
+	target.ResponseBodyMode = m.GetResponseBodyMode()
+
+	target.RequestTrailerMode = m.GetRequestTrailerMode()
+
+	target.ResponseTrailerMode = m.GetResponseTrailerMode()
+
+	return target
+}
+
+// Clone function
+func (m *RouteExtProc) Clone() proto.Message {
+	var target *RouteExtProc
+	if m == nil {
+		return target
+	}
+	target = &RouteExtProc{}
+
+	switch m.Override.(type) {
+
+	case *RouteExtProc_Disabled:
+
+		target.Override = &RouteExtProc_Disabled{
+			Disabled: m.GetDisabled(),
+		}
+
+	case *RouteExtProc_Overrides:
+
+		if h, ok := interface{}(m.GetOverrides()).(clone.Cloner); ok {
+			target.Override = &RouteExtProc_Overrides{
+				Overrides: h.Clone().(*Overrides),
+			}
+		} else {
+			target.Override = &RouteExtProc_Overrides{
+				Overrides: proto.Clone(m.GetOverrides()).(*Overrides),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Overrides) Clone() proto.Message {
+	var target *Overrides
+	if m == nil {
+		return target
+	}
+	target = &Overrides{}
+
+	if h, ok := interface{}(m.GetProcessingMode()).(clone.Cloner); ok {
+		target.ProcessingMode = h.Clone().(*ProcessingMode)
+	} else {
+		target.ProcessingMode = proto.Clone(m.GetProcessingMode()).(*ProcessingMode)
+	}
+
+	if h, ok := interface{}(m.GetGrpcService()).(clone.Cloner); ok {
+		target.GrpcService = h.Clone().(*GrpcService)
+	} else {
+		target.GrpcService = proto.Clone(m.GetGrpcService()).(*GrpcService)
+	}
+
+	return target
+}
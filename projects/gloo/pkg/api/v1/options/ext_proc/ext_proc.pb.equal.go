@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/ext_proc/ext_proc.proto
+
+package ext_proc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ExtProc) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ExtProc)
+	if !ok {
+		that2, ok := that.(ExtProc)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetGrpcService()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGrpcService()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGrpcService(), target.GetGrpcService()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetFilterStage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFilterStage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFilterStage(), target.GetFilterStage()) {
+			return false
+		}
+	}
+
+	if m.GetFailureModeAllow() != target.GetFailureModeAllow() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetProcessingMode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetProcessingMode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetProcessingMode(), target.GetProcessingMode()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMessageTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMessageTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMessageTimeout(), target.GetMessageTimeout()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GrpcService) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GrpcService)
+	if !ok {
+		that2, ok := that.(GrpcService)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetExtProcServerRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExtProcServerRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExtProcServerRef(), target.GetExtProcServerRef()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetAuthority(), target.GetAuthority()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTimeout(), target.GetTimeout()) {
+			return false
+		}
+	}
+
+	if len(m.GetInitialMetadata()) != len(target.GetInitialMetadata()) {
+		return false
+	}
+	for idx, v := range m.GetInitialMetadata() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetInitialMetadata()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetInitialMetadata()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ProcessingMode) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ProcessingMode)
+	if !ok {
+		that2, ok := that.(ProcessingMode)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetRequestHeaderMode() != target.GetRequestHeaderMode() {
+		return false
+	}
+
+	if m.GetResponseHeaderMode() != target.GetResponseHeaderMode() {
+		return false
+	}
+
+	if m.GetRequestBodyMode() != target.GetRequestBodyMode() {
+		return false
+	}
+
+	if m.GetResponseBodyMode() != target.GetResponseBodyMode() {
+		return false
+	}
+
+	if m.GetRequestTrailerMode() != target.GetRequestTrailerMode() {
+		return false
+	}
+
+	if m.GetResponseTrailerMode() != target.GetResponseTrailerMode() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RouteExtProc) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RouteExtProc)
+	if !ok {
+		that2, ok := that.(RouteExtProc)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.Override.(type) {
+
+	case *RouteExtProc_Disabled:
+		if _, ok := target.Override.(*RouteExtProc_Disabled); !ok {
+			return false
+		}
+
+		if m.GetDisabled() != target.GetDisabled() {
+			return false
+		}
+
+	case *RouteExtProc_Overrides:
+		if _, ok := target.Override.(*RouteExtProc_Overrides); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetOverrides()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetOverrides()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetOverrides(), target.GetOverrides()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Override != target.Override {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Overrides) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Overrides)
+	if !ok {
+		that2, ok := that.(Overrides)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetProcessingMode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetProcessingMode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetProcessingMode(), target.GetProcessingMode()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetGrpcService()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGrpcService()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGrpcService(), target.GetGrpcService()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -17,6 +17,8 @@ import (
 
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_reply"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_protocol "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
@@ -252,6 +254,12 @@ func (m *HttpConnectionManagerSettings) Clone() proto.Message {
 		target.InternalAddressConfig = proto.Clone(m.GetInternalAddressConfig()).(*HttpConnectionManagerSettings_InternalAddressConfig)
 	}
 
+	if h, ok := interface{}(m.GetLocalReplyConfig()).(clone.Cloner); ok {
+		target.LocalReplyConfig = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply.LocalReplyConfig)
+	} else {
+		target.LocalReplyConfig = proto.Clone(m.GetLocalReplyConfig()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_reply.LocalReplyConfig)
+	}
+
 	switch m.HeaderFormat.(type) {
 
 	case *HttpConnectionManagerSettings_ProperCaseHeaderKeyFormat:
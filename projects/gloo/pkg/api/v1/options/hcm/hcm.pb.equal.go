@@ -393,6 +393,16 @@ func (m *HttpConnectionManagerSettings) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalReplyConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalReplyConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalReplyConfig(), target.GetLocalReplyConfig()) {
+			return false
+		}
+	}
+
 	switch m.HeaderFormat.(type) {
 
 	case *HttpConnectionManagerSettings_ProperCaseHeaderKeyFormat:
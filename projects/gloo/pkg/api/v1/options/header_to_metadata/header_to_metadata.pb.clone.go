@@ -0,0 +1,104 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/header_to_metadata/header_to_metadata.proto
+
+package header_to_metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *HeaderToMetadata) Clone() proto.Message {
+	var target *HeaderToMetadata
+	if m == nil {
+		return target
+	}
+	target = &HeaderToMetadata{}
+
+	if m.GetRequestRules() != nil {
+		target.RequestRules = make([]*HeaderToMetadata_Rule, len(m.GetRequestRules()))
+		for idx, v := range m.GetRequestRules() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RequestRules[idx] = h.Clone().(*HeaderToMetadata_Rule)
+			} else {
+				target.RequestRules[idx] = proto.Clone(v).(*HeaderToMetadata_Rule)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *HeaderToMetadata_Rule) Clone() proto.Message {
+	var target *HeaderToMetadata_Rule
+	if m == nil {
+		return target
+	}
+	target = &HeaderToMetadata_Rule{}
+
+	target.Header = m.GetHeader()
+
+	if h, ok := interface{}(m.GetOnHeaderPresent()).(clone.Cloner); ok {
+		target.OnHeaderPresent = h.Clone().(*HeaderToMetadata_KeyValuePair)
+	} else {
+		target.OnHeaderPresent = proto.Clone(m.GetOnHeaderPresent()).(*HeaderToMetadata_KeyValuePair)
+	}
+
+	if h, ok := interface{}(m.GetOnHeaderMissing()).(clone.Cloner); ok {
+		target.OnHeaderMissing = h.Clone().(*HeaderToMetadata_KeyValuePair)
+	} else {
+		target.OnHeaderMissing = proto.Clone(m.GetOnHeaderMissing()).(*HeaderToMetadata_KeyValuePair)
+	}
+
+	target.Remove = m.GetRemove()
+
+	return target
+}
+
+// Clone function
+func (m *HeaderToMetadata_KeyValuePair) Clone() proto.Message {
+	var target *HeaderToMetadata_KeyValuePair
+	if m == nil {
+		return target
+	}
+	target = &HeaderToMetadata_KeyValuePair{}
+
+	target.MetadataNamespace = m.GetMetadataNamespace()
+
+	target.Key = m.GetKey()
+
+	target.Value = m.GetValue()
+
+	if h, ok := interface{}(m.GetRegexValueRewrite()).(clone.Cloner); ok {
+		target.RegexValueRewrite = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.RegexMatchAndSubstitute)
+	} else {
+		target.RegexValueRewrite = proto.Clone(m.GetRegexValueRewrite()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.RegexMatchAndSubstitute)
+	}
+
+	target.Type = m.GetType()
+
+	return target
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/header_to_metadata/header_to_metadata.proto
+
+package header_to_metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *HeaderToMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderToMetadata)
+	if !ok {
+		that2, ok := that.(HeaderToMetadata)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetRequestRules()) != len(target.GetRequestRules()) {
+		return false
+	}
+	for idx, v := range m.GetRequestRules() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRequestRules()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRequestRules()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HeaderToMetadata_Rule) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderToMetadata_Rule)
+	if !ok {
+		that2, ok := that.(HeaderToMetadata_Rule)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetHeader(), target.GetHeader()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetOnHeaderPresent()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOnHeaderPresent()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOnHeaderPresent(), target.GetOnHeaderPresent()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetOnHeaderMissing()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOnHeaderMissing()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOnHeaderMissing(), target.GetOnHeaderMissing()) {
+			return false
+		}
+	}
+
+	if m.GetRemove() != target.GetRemove() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HeaderToMetadata_KeyValuePair) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderToMetadata_KeyValuePair)
+	if !ok {
+		that2, ok := that.(HeaderToMetadata_KeyValuePair)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetMetadataNamespace(), target.GetMetadataNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetValue(), target.GetValue()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRegexValueRewrite()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRegexValueRewrite()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRegexValueRewrite(), target.GetRegexValueRewrite()) {
+			return false
+		}
+	}
+
+	if m.GetType() != target.GetType() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/header_to_metadata/header_to_metadata.proto
+
+package header_to_metadata
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *HeaderToMetadata) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("header_to_metadata.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata.HeaderToMetadata")); err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetRequestRules() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *HeaderToMetadata_Rule) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("header_to_metadata.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata.HeaderToMetadata_Rule")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetHeader())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetOnHeaderPresent()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("OnHeaderPresent")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetOnHeaderPresent(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("OnHeaderPresent")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetOnHeaderMissing()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("OnHeaderMissing")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetOnHeaderMissing(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("OnHeaderMissing")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetRemove())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *HeaderToMetadata_KeyValuePair) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("header_to_metadata.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata.HeaderToMetadata_KeyValuePair")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetMetadataNamespace())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetKey())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetValue())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetRegexValueRewrite()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RegexValueRewrite")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRegexValueRewrite(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RegexValueRewrite")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetType())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
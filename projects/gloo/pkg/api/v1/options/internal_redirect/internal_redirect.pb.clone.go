@@ -0,0 +1,56 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/internal_redirect/internal_redirect.proto
+
+package internal_redirect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *InternalRedirectPolicy) Clone() proto.Message {
+	var target *InternalRedirectPolicy
+	if m == nil {
+		return target
+	}
+	target = &InternalRedirectPolicy{}
+
+	if h, ok := interface{}(m.GetMaxInternalRedirects()).(clone.Cloner); ok {
+		target.MaxInternalRedirects = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxInternalRedirects = proto.Clone(m.GetMaxInternalRedirects()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if m.GetRedirectResponseCodes() != nil {
+		target.RedirectResponseCodes = make([]uint32, len(m.GetRedirectResponseCodes()))
+		for idx, v := range m.GetRedirectResponseCodes() {
+
+			target.RedirectResponseCodes[idx] = v
+
+		}
+	}
+
+	target.AllowCrossSchemeRedirect = m.GetAllowCrossSchemeRedirect()
+
+	return target
+}
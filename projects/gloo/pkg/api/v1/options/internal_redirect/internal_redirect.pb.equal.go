@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/internal_redirect/internal_redirect.proto
+
+package internal_redirect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *InternalRedirectPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*InternalRedirectPolicy)
+	if !ok {
+		that2, ok := that.(InternalRedirectPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxInternalRedirects()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxInternalRedirects()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxInternalRedirects(), target.GetMaxInternalRedirects()) {
+			return false
+		}
+	}
+
+	if len(m.GetRedirectResponseCodes()) != len(target.GetRedirectResponseCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRedirectResponseCodes() {
+
+		if v != target.GetRedirectResponseCodes()[idx] {
+			return false
+		}
+
+	}
+
+	if m.GetAllowCrossSchemeRedirect() != target.GetAllowCrossSchemeRedirect() {
+		return false
+	}
+
+	return true
+}
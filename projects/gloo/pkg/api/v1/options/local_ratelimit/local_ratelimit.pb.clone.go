@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto
+
+package local_ratelimit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *TokenBucket) Clone() proto.Message {
+	var target *TokenBucket
+	if m == nil {
+		return target
+	}
+	target = &TokenBucket{}
+
+	target.MaxTokens = m.GetMaxTokens()
+
+	if h, ok := interface{}(m.GetTokensPerFill()).(clone.Cloner); ok {
+		target.TokensPerFill = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.TokensPerFill = proto.Clone(m.GetTokensPerFill()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetFillInterval()).(clone.Cloner); ok {
+		target.FillInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.FillInterval = proto.Clone(m.GetFillInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LocalRateLimitDescriptor) Clone() proto.Message {
+	var target *LocalRateLimitDescriptor
+	if m == nil {
+		return target
+	}
+	target = &LocalRateLimitDescriptor{}
+
+	if m.GetEntries() != nil {
+		target.Entries = make([]*LocalRateLimitDescriptor_Entry, len(m.GetEntries()))
+		for idx, v := range m.GetEntries() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Entries[idx] = h.Clone().(*LocalRateLimitDescriptor_Entry)
+			} else {
+				target.Entries[idx] = proto.Clone(v).(*LocalRateLimitDescriptor_Entry)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(clone.Cloner); ok {
+		target.TokenBucket = h.Clone().(*TokenBucket)
+	} else {
+		target.TokenBucket = proto.Clone(m.GetTokenBucket()).(*TokenBucket)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LocalRateLimit) Clone() proto.Message {
+	var target *LocalRateLimit
+	if m == nil {
+		return target
+	}
+	target = &LocalRateLimit{}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(clone.Cloner); ok {
+		target.TokenBucket = h.Clone().(*TokenBucket)
+	} else {
+		target.TokenBucket = proto.Clone(m.GetTokenBucket()).(*TokenBucket)
+	}
+
+	if m.GetDescriptors() != nil {
+		target.Descriptors = make([]*LocalRateLimitDescriptor, len(m.GetDescriptors()))
+		for idx, v := range m.GetDescriptors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Descriptors[idx] = h.Clone().(*LocalRateLimitDescriptor)
+			} else {
+				target.Descriptors[idx] = proto.Clone(v).(*LocalRateLimitDescriptor)
+			}
+
+		}
+	}
+
+	if m.GetResponseHeadersToAdd() != nil {
+		target.ResponseHeadersToAdd = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption, len(m.GetResponseHeadersToAdd()))
+		for idx, v := range m.GetResponseHeadersToAdd() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ResponseHeadersToAdd[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption)
+			} else {
+				target.ResponseHeadersToAdd[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetStatusCode()).(clone.Cloner); ok {
+		target.StatusCode = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.StatusCode = proto.Clone(m.GetStatusCode()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.LocalRateLimitPerDownstreamConnection = m.GetLocalRateLimitPerDownstreamConnection()
+
+	target.EnableXRatelimitHeaders = m.GetEnableXRatelimitHeaders()
+
+	return target
+}
+
+// Clone function
+func (m *NetworkLocalRateLimit) Clone() proto.Message {
+	var target *NetworkLocalRateLimit
+	if m == nil {
+		return target
+	}
+	target = &NetworkLocalRateLimit{}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(clone.Cloner); ok {
+		target.TokenBucket = h.Clone().(*TokenBucket)
+	} else {
+		target.TokenBucket = proto.Clone(m.GetTokenBucket()).(*TokenBucket)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LocalRateLimitDescriptor_Entry) Clone() proto.Message {
+	var target *LocalRateLimitDescriptor_Entry
+	if m == nil {
+		return target
+	}
+	target = &LocalRateLimitDescriptor_Entry{}
+
+	target.Key = m.GetKey()
+
+	target.Value = m.GetValue()
+
+	return target
+}
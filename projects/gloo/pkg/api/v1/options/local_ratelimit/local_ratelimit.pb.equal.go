@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto
+
+package local_ratelimit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *TokenBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TokenBucket)
+	if !ok {
+		that2, ok := that.(TokenBucket)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMaxTokens() != target.GetMaxTokens() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTokensPerFill()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokensPerFill()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokensPerFill(), target.GetTokensPerFill()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetFillInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFillInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFillInterval(), target.GetFillInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LocalRateLimitDescriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalRateLimitDescriptor)
+	if !ok {
+		that2, ok := that.(LocalRateLimitDescriptor)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetEntries()) != len(target.GetEntries()) {
+		return false
+	}
+	for idx, v := range m.GetEntries() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEntries()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetEntries()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenBucket()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenBucket(), target.GetTokenBucket()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LocalRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalRateLimit)
+	if !ok {
+		that2, ok := that.(LocalRateLimit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenBucket()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenBucket(), target.GetTokenBucket()) {
+			return false
+		}
+	}
+
+	if len(m.GetDescriptors()) != len(target.GetDescriptors()) {
+		return false
+	}
+	for idx, v := range m.GetDescriptors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDescriptors()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDescriptors()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetResponseHeadersToAdd()) != len(target.GetResponseHeadersToAdd()) {
+		return false
+	}
+	for idx, v := range m.GetResponseHeadersToAdd() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetResponseHeadersToAdd()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetResponseHeadersToAdd()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetStatusCode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStatusCode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStatusCode(), target.GetStatusCode()) {
+			return false
+		}
+	}
+
+	if m.GetLocalRateLimitPerDownstreamConnection() != target.GetLocalRateLimitPerDownstreamConnection() {
+		return false
+	}
+
+	if m.GetEnableXRatelimitHeaders() != target.GetEnableXRatelimitHeaders() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *NetworkLocalRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*NetworkLocalRateLimit)
+	if !ok {
+		that2, ok := that.(NetworkLocalRateLimit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenBucket()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenBucket(), target.GetTokenBucket()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LocalRateLimitDescriptor_Entry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalRateLimitDescriptor_Entry)
+	if !ok {
+		that2, ok := that.(LocalRateLimitDescriptor_Entry)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetValue(), target.GetValue()) != 0 {
+		return false
+	}
+
+	return true
+}
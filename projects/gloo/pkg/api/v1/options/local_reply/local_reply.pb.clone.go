@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_reply/local_reply.proto
+
+package local_reply
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_struct "github.com/golang/protobuf/ptypes/struct"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *LocalReplyConfig) Clone() proto.Message {
+	var target *LocalReplyConfig
+	if m == nil {
+		return target
+	}
+	target = &LocalReplyConfig{}
+
+	if m.GetMappers() != nil {
+		target.Mappers = make([]*ResponseMapper, len(m.GetMappers()))
+		for idx, v := range m.GetMappers() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Mappers[idx] = h.Clone().(*ResponseMapper)
+			} else {
+				target.Mappers[idx] = proto.Clone(v).(*ResponseMapper)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetBodyFormat()).(clone.Cloner); ok {
+		target.BodyFormat = h.Clone().(*SubstitutionFormatString)
+	} else {
+		target.BodyFormat = proto.Clone(m.GetBodyFormat()).(*SubstitutionFormatString)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ResponseMapper) Clone() proto.Message {
+	var target *ResponseMapper
+	if m == nil {
+		return target
+	}
+	target = &ResponseMapper{}
+
+	if h, ok := interface{}(m.GetFilter()).(clone.Cloner); ok {
+		target.Filter = h.Clone().(*ResponseMapperFilter)
+	} else {
+		target.Filter = proto.Clone(m.GetFilter()).(*ResponseMapperFilter)
+	}
+
+	if h, ok := interface{}(m.GetStatusCode()).(clone.Cloner); ok {
+		target.StatusCode = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.StatusCode = proto.Clone(m.GetStatusCode()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetBody()).(clone.Cloner); ok {
+		target.Body = h.Clone().(*ResponseBody)
+	} else {
+		target.Body = proto.Clone(m.GetBody()).(*ResponseBody)
+	}
+
+	if h, ok := interface{}(m.GetBodyFormatOverride()).(clone.Cloner); ok {
+		target.BodyFormatOverride = h.Clone().(*SubstitutionFormatString)
+	} else {
+		target.BodyFormatOverride = proto.Clone(m.GetBodyFormatOverride()).(*SubstitutionFormatString)
+	}
+
+	if m.GetHeadersToAdd() != nil {
+		target.HeadersToAdd = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption, len(m.GetHeadersToAdd()))
+		for idx, v := range m.GetHeadersToAdd() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.HeadersToAdd[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption)
+			} else {
+				target.HeadersToAdd[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_headers.HeaderValueOption)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ResponseMapperFilter) Clone() proto.Message {
+	var target *ResponseMapperFilter
+	if m == nil {
+		return target
+	}
+	target = &ResponseMapperFilter{}
+
+	if h, ok := interface{}(m.GetStatusCode()).(clone.Cloner); ok {
+		target.StatusCode = h.Clone().(*StatusCodeFilter)
+	} else {
+		target.StatusCode = proto.Clone(m.GetStatusCode()).(*StatusCodeFilter)
+	}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetResponseFlags()).(clone.Cloner); ok {
+		target.ResponseFlags = h.Clone().(*ResponseFlagFilter)
+	} else {
+		target.ResponseFlags = proto.Clone(m.GetResponseFlags()).(*ResponseFlagFilter)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *StatusCodeFilter) Clone() proto.Message {
+	var target *StatusCodeFilter
+	if m == nil {
+		return target
+	}
+	target = &StatusCodeFilter{}
+
+	target.Op = m.GetOp()
+
+	target.Value = m.GetValue()
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	return target
+}
+
+// Clone function
+func (m *ResponseFlagFilter) Clone() proto.Message {
+	var target *ResponseFlagFilter
+	if m == nil {
+		return target
+	}
+	target = &ResponseFlagFilter{}
+
+	if m.GetFlags() != nil {
+		target.Flags = make([]string, len(m.GetFlags()))
+		for idx, v := range m.GetFlags() {
+
+			target.Flags[idx] = v
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ResponseBody) Clone() proto.Message {
+	var target *ResponseBody
+	if m == nil {
+		return target
+	}
+	target = &ResponseBody{}
+
+	switch m.Source.(type) {
+
+	case *ResponseBody_InlineString:
+
+		target.Source = &ResponseBody_InlineString{
+			InlineString: m.GetInlineString(),
+		}
+
+	case *ResponseBody_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(clone.Cloner); ok {
+			target.Source = &ResponseBody_Artifact{
+				Artifact: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts.ArtifactKeyRef),
+			}
+		} else {
+			target.Source = &ResponseBody_Artifact{
+				Artifact: proto.Clone(m.GetArtifact()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts.ArtifactKeyRef),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *SubstitutionFormatString) Clone() proto.Message {
+	var target *SubstitutionFormatString
+	if m == nil {
+		return target
+	}
+	target = &SubstitutionFormatString{}
+
+	target.OmitEmptyValues = m.GetOmitEmptyValues()
+
+	target.ContentType = m.GetContentType()
+
+	switch m.Format.(type) {
+
+	case *SubstitutionFormatString_TextFormat:
+
+		target.Format = &SubstitutionFormatString_TextFormat{
+			TextFormat: m.GetTextFormat(),
+		}
+
+	case *SubstitutionFormatString_JsonFormat:
+
+		if h, ok := interface{}(m.GetJsonFormat()).(clone.Cloner); ok {
+			target.Format = &SubstitutionFormatString_JsonFormat{
+				JsonFormat: h.Clone().(*github_com_golang_protobuf_ptypes_struct.Struct),
+			}
+		} else {
+			target.Format = &SubstitutionFormatString_JsonFormat{
+				JsonFormat: proto.Clone(m.GetJsonFormat()).(*github_com_golang_protobuf_ptypes_struct.Struct),
+			}
+		}
+
+	}
+
+	return target
+}
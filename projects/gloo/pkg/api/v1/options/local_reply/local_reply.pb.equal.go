@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_reply/local_reply.proto
+
+package local_reply
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *LocalReplyConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalReplyConfig)
+	if !ok {
+		that2, ok := that.(LocalReplyConfig)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetMappers()) != len(target.GetMappers()) {
+		return false
+	}
+	for idx, v := range m.GetMappers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMappers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMappers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetBodyFormat()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBodyFormat()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBodyFormat(), target.GetBodyFormat()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseMapper) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseMapper)
+	if !ok {
+		that2, ok := that.(ResponseMapper)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetFilter()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFilter()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFilter(), target.GetFilter()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetStatusCode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStatusCode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStatusCode(), target.GetStatusCode()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetBody()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBody()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBody(), target.GetBody()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetBodyFormatOverride()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBodyFormatOverride()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBodyFormatOverride(), target.GetBodyFormatOverride()) {
+			return false
+		}
+	}
+
+	if len(m.GetHeadersToAdd()) != len(target.GetHeadersToAdd()) {
+		return false
+	}
+	for idx, v := range m.GetHeadersToAdd() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeadersToAdd()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeadersToAdd()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseMapperFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseMapperFilter)
+	if !ok {
+		that2, ok := that.(ResponseMapperFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetStatusCode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStatusCode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStatusCode(), target.GetStatusCode()) {
+			return false
+		}
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetResponseFlags()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetResponseFlags()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetResponseFlags(), target.GetResponseFlags()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *StatusCodeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*StatusCodeFilter)
+	if !ok {
+		that2, ok := that.(StatusCodeFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetOp() != target.GetOp() {
+		return false
+	}
+
+	if m.GetValue() != target.GetValue() {
+		return false
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseFlagFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseFlagFilter)
+	if !ok {
+		that2, ok := that.(ResponseFlagFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetFlags()) != len(target.GetFlags()) {
+		return false
+	}
+	for idx, v := range m.GetFlags() {
+
+		if strings.Compare(v, target.GetFlags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseBody) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseBody)
+	if !ok {
+		that2, ok := that.(ResponseBody)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.Source.(type) {
+
+	case *ResponseBody_InlineString:
+		if _, ok := target.Source.(*ResponseBody_InlineString); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetInlineString(), target.GetInlineString()) != 0 {
+			return false
+		}
+
+	case *ResponseBody_Artifact:
+		if _, ok := target.Source.(*ResponseBody_Artifact); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetArtifact()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifact()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetArtifact(), target.GetArtifact()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Source != target.Source {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *SubstitutionFormatString) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*SubstitutionFormatString)
+	if !ok {
+		that2, ok := that.(SubstitutionFormatString)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetOmitEmptyValues() != target.GetOmitEmptyValues() {
+		return false
+	}
+
+	if strings.Compare(m.GetContentType(), target.GetContentType()) != 0 {
+		return false
+	}
+
+	switch m.Format.(type) {
+
+	case *SubstitutionFormatString_TextFormat:
+		if _, ok := target.Format.(*SubstitutionFormatString_TextFormat); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetTextFormat(), target.GetTextFormat()) != 0 {
+			return false
+		}
+
+	case *SubstitutionFormatString_JsonFormat:
+		if _, ok := target.Format.(*SubstitutionFormatString_JsonFormat); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetJsonFormat()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetJsonFormat()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetJsonFormat(), target.GetJsonFormat()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Format != target.Format {
+			return false
+		}
+	}
+
+	return true
+}
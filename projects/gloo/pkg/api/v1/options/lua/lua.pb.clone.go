@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/lua/lua.proto
+
+package lua
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *Lua) Clone() proto.Message {
+	var target *Lua
+	if m == nil {
+		return target
+	}
+	target = &Lua{}
+
+	if h, ok := interface{}(m.GetDefaultScript()).(clone.Cloner); ok {
+		target.DefaultScript = h.Clone().(*LuaScript)
+	} else {
+		target.DefaultScript = proto.Clone(m.GetDefaultScript()).(*LuaScript)
+	}
+
+	if m.GetNamedScripts() != nil {
+		target.NamedScripts = make(map[string]*LuaScript, len(m.GetNamedScripts()))
+		for k, v := range m.GetNamedScripts() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.NamedScripts[k] = h.Clone().(*LuaScript)
+			} else {
+				target.NamedScripts[k] = proto.Clone(v).(*LuaScript)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetFilterStage()).(clone.Cloner); ok {
+		target.FilterStage = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm.FilterStage)
+	} else {
+		target.FilterStage = proto.Clone(m.GetFilterStage()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_wasm.FilterStage)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	return target
+}
+
+// Clone function
+func (m *LuaScript) Clone() proto.Message {
+	var target *LuaScript
+	if m == nil {
+		return target
+	}
+	target = &LuaScript{}
+
+	switch m.Source.(type) {
+
+	case *LuaScript_InlineCode:
+
+		target.Source = &LuaScript_InlineCode{
+			InlineCode: m.GetInlineCode(),
+		}
+
+	case *LuaScript_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(clone.Cloner); ok {
+			target.Source = &LuaScript_Artifact{
+				Artifact: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts.ArtifactKeyRef),
+			}
+		} else {
+			target.Source = &LuaScript_Artifact{
+				Artifact: proto.Clone(m.GetArtifact()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_artifacts.ArtifactKeyRef),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LuaPerRoute) Clone() proto.Message {
+	var target *LuaPerRoute
+	if m == nil {
+		return target
+	}
+	target = &LuaPerRoute{}
+
+	switch m.Override.(type) {
+
+	case *LuaPerRoute_Disabled:
+
+		target.Override = &LuaPerRoute_Disabled{
+			Disabled: m.GetDisabled(),
+		}
+
+	case *LuaPerRoute_Name:
+
+		target.Override = &LuaPerRoute_Name{
+			Name: m.GetName(),
+		}
+
+	}
+
+	return target
+}
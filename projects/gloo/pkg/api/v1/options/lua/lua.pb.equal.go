@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/lua/lua.proto
+
+package lua
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *Lua) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Lua)
+	if !ok {
+		that2, ok := that.(Lua)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDefaultScript()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDefaultScript()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDefaultScript(), target.GetDefaultScript()) {
+			return false
+		}
+	}
+
+	if len(m.GetNamedScripts()) != len(target.GetNamedScripts()) {
+		return false
+	}
+	for k, v := range m.GetNamedScripts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetNamedScripts()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetNamedScripts()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetFilterStage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFilterStage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFilterStage(), target.GetFilterStage()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LuaScript) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LuaScript)
+	if !ok {
+		that2, ok := that.(LuaScript)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.Source.(type) {
+
+	case *LuaScript_InlineCode:
+		if _, ok := target.Source.(*LuaScript_InlineCode); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetInlineCode(), target.GetInlineCode()) != 0 {
+			return false
+		}
+
+	case *LuaScript_Artifact:
+		if _, ok := target.Source.(*LuaScript_Artifact); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetArtifact()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifact()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetArtifact(), target.GetArtifact()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Source != target.Source {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LuaPerRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LuaPerRoute)
+	if !ok {
+		that2, ok := that.(LuaPerRoute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.Override.(type) {
+
+	case *LuaPerRoute_Disabled:
+		if _, ok := target.Override.(*LuaPerRoute_Disabled); !ok {
+			return false
+		}
+
+		if m.GetDisabled() != target.GetDisabled() {
+			return false
+		}
+
+	case *LuaPerRoute_Name:
+		if _, ok := target.Override.(*LuaPerRoute_Name); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetName(), target.GetName()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Override != target.Override {
+			return false
+		}
+	}
+
+	return true
+}
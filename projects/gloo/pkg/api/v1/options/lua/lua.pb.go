@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/lua/lua.proto
+
+package lua
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	artifacts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Configures Envoy's Lua filter, which runs Lua scripts on the requests and responses.
+// The scripts must define `envoy_on_request` and/or `envoy_on_response` functions.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/lua/v3/lua.proto
+// Example:
+// ```
+// lua:
+//   defaultScript:
+//     inlineCode: |
+//       function envoy_on_response(response_handle)
+//         response_handle:headers():add("x-lua", "true")
+//       end
+//   namedScripts:
+//     strip-cookies:
+//       artifact:
+//         artifactRef:
+//           name: lua-scripts
+//           namespace: gloo-system
+//         key: strip-cookies.lua
+//   filterStage:
+//     stage: RouteStage
+//     predicate: Before
+// ```
+type Lua struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The script that runs on every route, unless a route selects one of the named scripts or disables the filter.
+	DefaultScript *LuaScript `protobuf:"bytes,1,opt,name=default_script,json=defaultScript,proto3" json:"default_script,omitempty"`
+	// Scripts that can be selected by name in the `lua` option of the routes.
+	NamedScripts map[string]*LuaScript `protobuf:"bytes,2,rep,name=named_scripts,json=namedScripts,proto3" json:"named_scripts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The stage in the filter chain where the filter should be placed. Defaults to the
+	// AcceptedStage, after authentication, authorization and rate limiting have been performed.
+	FilterStage *wasm.FilterStage `protobuf:"bytes,3,opt,name=filter_stage,json=filterStage,proto3" json:"filter_stage,omitempty"`
+	// Optional prefix for the stats emitted by the filter.
+	StatPrefix string `protobuf:"bytes,4,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+}
+
+func (x *Lua) Reset() {
+	*x = Lua{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lua) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lua) ProtoMessage() {}
+
+func (x *Lua) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lua.ProtoReflect.Descriptor instead.
+func (*Lua) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Lua) GetDefaultScript() *LuaScript {
+	if x != nil {
+		return x.DefaultScript
+	}
+	return nil
+}
+
+func (x *Lua) GetNamedScripts() map[string]*LuaScript {
+	if x != nil {
+		return x.NamedScripts
+	}
+	return nil
+}
+
+func (x *Lua) GetFilterStage() *wasm.FilterStage {
+	if x != nil {
+		return x.FilterStage
+	}
+	return nil
+}
+
+func (x *Lua) GetStatPrefix() string {
+	if x != nil {
+		return x.StatPrefix
+	}
+	return ""
+}
+
+// The source of a Lua script.
+type LuaScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*LuaScript_InlineCode
+	//	*LuaScript_Artifact
+	Source isLuaScript_Source `protobuf_oneof:"source"`
+}
+
+func (x *LuaScript) Reset() {
+	*x = LuaScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LuaScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LuaScript) ProtoMessage() {}
+
+func (x *LuaScript) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LuaScript.ProtoReflect.Descriptor instead.
+func (*LuaScript) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *LuaScript) GetSource() isLuaScript_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *LuaScript) GetInlineCode() string {
+	if x, ok := x.GetSource().(*LuaScript_InlineCode); ok {
+		return x.InlineCode
+	}
+	return ""
+}
+
+func (x *LuaScript) GetArtifact() *artifacts.ArtifactKeyRef {
+	if x, ok := x.GetSource().(*LuaScript_Artifact); ok {
+		return x.Artifact
+	}
+	return nil
+}
+
+type isLuaScript_Source interface {
+	isLuaScript_Source()
+}
+
+type LuaScript_InlineCode struct {
+	// The script as an inline string.
+	InlineCode string `protobuf:"bytes,1,opt,name=inline_code,json=inlineCode,proto3,oneof"`
+}
+
+type LuaScript_Artifact struct {
+	// The script from an artifact, e.g. a Kubernetes ConfigMap.
+	Artifact *artifacts.ArtifactKeyRef `protobuf:"bytes,2,opt,name=artifact,proto3,oneof"`
+}
+
+func (*LuaScript_InlineCode) isLuaScript_Source() {}
+
+func (*LuaScript_Artifact) isLuaScript_Source() {}
+
+// Overrides the Lua filter configured on the listener for a route.
+type LuaPerRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Override:
+	//	*LuaPerRoute_Disabled
+	//	*LuaPerRoute_Name
+	Override isLuaPerRoute_Override `protobuf_oneof:"override"`
+}
+
+func (x *LuaPerRoute) Reset() {
+	*x = LuaPerRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LuaPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LuaPerRoute) ProtoMessage() {}
+
+func (x *LuaPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LuaPerRoute.ProtoReflect.Descriptor instead.
+func (*LuaPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *LuaPerRoute) GetOverride() isLuaPerRoute_Override {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+func (x *LuaPerRoute) GetDisabled() bool {
+	if x, ok := x.GetOverride().(*LuaPerRoute_Disabled); ok {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *LuaPerRoute) GetName() string {
+	if x, ok := x.GetOverride().(*LuaPerRoute_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isLuaPerRoute_Override interface {
+	isLuaPerRoute_Override()
+}
+
+type LuaPerRoute_Disabled struct {
+	// Disables the Lua filter for the route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3,oneof"`
+}
+
+type LuaPerRoute_Name struct {
+	// Runs the named script of the listener's `lua` option instead of its default script.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*LuaPerRoute_Disabled) isLuaPerRoute_Override() {}
+
+func (*LuaPerRoute_Name) isLuaPerRoute_Override() {}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDesc = []byte{
+	0x0a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6c, 0x75, 0x61, 0x2f, 0x6c, 0x75, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6c, 0x75, 0x61, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x4e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x44,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf9, 0x02, 0x0a, 0x03, 0x4c, 0x75, 0x61, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x75, 0x61, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x75, 0x61,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c,
+	0x75, 0x61, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x75, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x1a, 0x64, 0x0a, 0x11, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6c, 0x75, 0x61, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x75, 0x61, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01,
+	0x0a, 0x09, 0x4c, 0x75, 0x61, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x69,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4c,
+	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66,
+	0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x42, 0x0d, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x64, 0x0a, 0x0b, 0x4c,
+	0x75, 0x61, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0f, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x03, 0xf8, 0x42,
+	0x01, 0x42, 0x4a, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6c, 0x75,
+	0x61, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_goTypes = []interface{}{
+	(*Lua)(nil),                      // 0: lua.options.gloo.solo.io.Lua
+	(*LuaScript)(nil),                // 1: lua.options.gloo.solo.io.LuaScript
+	(*LuaPerRoute)(nil),              // 2: lua.options.gloo.solo.io.LuaPerRoute
+	nil,                              // 3: lua.options.gloo.solo.io.Lua.NamedScriptsEntry
+	(*wasm.FilterStage)(nil),         // 4: wasm.options.gloo.solo.io.FilterStage
+	(*artifacts.ArtifactKeyRef)(nil), // 5: artifacts.options.gloo.solo.io.ArtifactKeyRef
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_depIdxs = []int32{
+	1, // 0: lua.options.gloo.solo.io.Lua.default_script:type_name -> lua.options.gloo.solo.io.LuaScript
+	3, // 1: lua.options.gloo.solo.io.Lua.named_scripts:type_name -> lua.options.gloo.solo.io.Lua.NamedScriptsEntry
+	4, // 2: lua.options.gloo.solo.io.Lua.filter_stage:type_name -> wasm.options.gloo.solo.io.FilterStage
+	5, // 3: lua.options.gloo.solo.io.LuaScript.artifact:type_name -> artifacts.options.gloo.solo.io.ArtifactKeyRef
+	1, // 4: lua.options.gloo.solo.io.Lua.NamedScriptsEntry.value:type_name -> lua.options.gloo.solo.io.LuaScript
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_init() }
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lua); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LuaScript); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LuaPerRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*LuaScript_InlineCode)(nil),
+		(*LuaScript_Artifact)(nil),
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*LuaPerRoute_Disabled)(nil),
+		(*LuaPerRoute_Name)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_lua_lua_proto_depIdxs = nil
+}
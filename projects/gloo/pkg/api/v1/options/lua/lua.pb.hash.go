@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/lua/lua.proto
+
+package lua
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *Lua) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("lua.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua.Lua")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetDefaultScript()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("DefaultScript")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDefaultScript(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("DefaultScript")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetNamedScripts() {
+			innerHash.Reset()
+
+			if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+				if _, err = innerHash.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if _, err = h.Hash(innerHash); err != nil {
+					return 0, err
+				}
+			} else {
+				if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+					return 0, err
+				} else {
+					if _, err = innerHash.Write([]byte("")); err != nil {
+						return 0, err
+					}
+					if err := binary.Write(innerHash, binary.LittleEndian, fieldValue); err != nil {
+						return 0, err
+					}
+				}
+			}
+
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetFilterStage()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("FilterStage")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFilterStage(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("FilterStage")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte(m.GetStatPrefix())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *LuaScript) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("lua.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua.LuaScript")); err != nil {
+		return 0, err
+	}
+
+	switch m.Source.(type) {
+
+	case *LuaScript_InlineCode:
+
+		if _, err = hasher.Write([]byte(m.GetInlineCode())); err != nil {
+			return 0, err
+		}
+
+	case *LuaScript_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Artifact")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetArtifact(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Artifact")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *LuaPerRoute) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("lua.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua.LuaPerRoute")); err != nil {
+		return 0, err
+	}
+
+	switch m.Override.(type) {
+
+	case *LuaPerRoute_Disabled:
+
+		err = binary.Write(hasher, binary.LittleEndian, m.GetDisabled())
+		if err != nil {
+			return 0, err
+		}
+
+	case *LuaPerRoute_Name:
+
+		if _, err = hasher.Write([]byte(m.GetName())); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
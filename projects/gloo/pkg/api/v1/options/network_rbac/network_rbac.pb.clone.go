@@ -0,0 +1,65 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/network_rbac/network_rbac.proto
+
+package network_rbac
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *NetworkRbac) Clone() proto.Message {
+	var target *NetworkRbac
+	if m == nil {
+		return target
+	}
+	target = &NetworkRbac{}
+
+	if m.GetAllowedSourceRanges() != nil {
+		target.AllowedSourceRanges = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetAllowedSourceRanges()))
+		for idx, v := range m.GetAllowedSourceRanges() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.AllowedSourceRanges[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.AllowedSourceRanges[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	if m.GetDeniedSourceRanges() != nil {
+		target.DeniedSourceRanges = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetDeniedSourceRanges()))
+		for idx, v := range m.GetDeniedSourceRanges() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.DeniedSourceRanges[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.DeniedSourceRanges[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	return target
+}
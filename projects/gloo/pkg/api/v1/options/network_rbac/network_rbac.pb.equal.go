@@ -0,0 +1,84 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/network_rbac/network_rbac.proto
+
+package network_rbac
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *NetworkRbac) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*NetworkRbac)
+	if !ok {
+		that2, ok := that.(NetworkRbac)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetAllowedSourceRanges()) != len(target.GetAllowedSourceRanges()) {
+		return false
+	}
+	for idx, v := range m.GetAllowedSourceRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAllowedSourceRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetAllowedSourceRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetDeniedSourceRanges()) != len(target.GetDeniedSourceRanges()) {
+		return false
+	}
+	for idx, v := range m.GetDeniedSourceRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDeniedSourceRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDeniedSourceRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
@@ -48,6 +48,53 @@ func (m *ProxyProtocol) Clone() proto.Message {
 
 	target.AllowRequestsWithoutProxyProtocol = m.GetAllowRequestsWithoutProxyProtocol()
 
+	if h, ok := interface{}(m.GetPassThroughTlvs()).(clone.Cloner); ok {
+		target.PassThroughTlvs = h.Clone().(*PassThroughTlvs)
+	} else {
+		target.PassThroughTlvs = proto.Clone(m.GetPassThroughTlvs()).(*PassThroughTlvs)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *PassThroughTlvs) Clone() proto.Message {
+	var target *PassThroughTlvs
+	if m == nil {
+		return target
+	}
+	target = &PassThroughTlvs{}
+
+	target.MatchType = m.GetMatchType()
+
+	if m.GetTlvTypes() != nil {
+		target.TlvTypes = make([]uint32, len(m.GetTlvTypes()))
+		for idx, v := range m.GetTlvTypes() {
+
+			target.TlvTypes[idx] = v
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *UpstreamProxyProtocol) Clone() proto.Message {
+	var target *UpstreamProxyProtocol
+	if m == nil {
+		return target
+	}
+	target = &UpstreamProxyProtocol{}
+
+	target.Version = m.GetVersion()
+
+	if h, ok := interface{}(m.GetPassThroughTlvs()).(clone.Cloner); ok {
+		target.PassThroughTlvs = h.Clone().(*PassThroughTlvs)
+	} else {
+		target.PassThroughTlvs = proto.Clone(m.GetPassThroughTlvs()).(*PassThroughTlvs)
+	}
+
 	return target
 }
 
@@ -67,6 +67,93 @@ func (m *ProxyProtocol) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetPassThroughTlvs()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPassThroughTlvs()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPassThroughTlvs(), target.GetPassThroughTlvs()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *PassThroughTlvs) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*PassThroughTlvs)
+	if !ok {
+		that2, ok := that.(PassThroughTlvs)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMatchType() != target.GetMatchType() {
+		return false
+	}
+
+	if len(m.GetTlvTypes()) != len(target.GetTlvTypes()) {
+		return false
+	}
+	for idx, v := range m.GetTlvTypes() {
+
+		if v != target.GetTlvTypes()[idx] {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UpstreamProxyProtocol) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UpstreamProxyProtocol)
+	if !ok {
+		that2, ok := that.(UpstreamProxyProtocol)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetVersion() != target.GetVersion() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPassThroughTlvs()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPassThroughTlvs()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPassThroughTlvs(), target.GetPassThroughTlvs()) {
+			return false
+		}
+	}
+
 	return true
 }
 
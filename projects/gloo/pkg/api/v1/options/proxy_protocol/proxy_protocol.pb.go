@@ -23,6 +23,102 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PassThroughTlvs_MatchType int32
+
+const (
+	// Select all TLVs.
+	PassThroughTlvs_INCLUDE_ALL PassThroughTlvs_MatchType = 0
+	// Only select the TLVs whose type is listed in `tlvTypes`.
+	PassThroughTlvs_INCLUDE PassThroughTlvs_MatchType = 1
+)
+
+// Enum value maps for PassThroughTlvs_MatchType.
+var (
+	PassThroughTlvs_MatchType_name = map[int32]string{
+		0: "INCLUDE_ALL",
+		1: "INCLUDE",
+	}
+	PassThroughTlvs_MatchType_value = map[string]int32{
+		"INCLUDE_ALL": 0,
+		"INCLUDE":     1,
+	}
+)
+
+func (x PassThroughTlvs_MatchType) Enum() *PassThroughTlvs_MatchType {
+	p := new(PassThroughTlvs_MatchType)
+	*p = x
+	return p
+}
+
+func (x PassThroughTlvs_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PassThroughTlvs_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes[0].Descriptor()
+}
+
+func (PassThroughTlvs_MatchType) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes[0]
+}
+
+func (x PassThroughTlvs_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PassThroughTlvs_MatchType.Descriptor instead.
+func (PassThroughTlvs_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type UpstreamProxyProtocol_Version int32
+
+const (
+	// The human readable version of the header.
+	UpstreamProxyProtocol_V1 UpstreamProxyProtocol_Version = 0
+	// The binary version of the header, which can carry TLVs.
+	UpstreamProxyProtocol_V2 UpstreamProxyProtocol_Version = 1
+)
+
+// Enum value maps for UpstreamProxyProtocol_Version.
+var (
+	UpstreamProxyProtocol_Version_name = map[int32]string{
+		0: "V1",
+		1: "V2",
+	}
+	UpstreamProxyProtocol_Version_value = map[string]int32{
+		"V1": 0,
+		"V2": 1,
+	}
+)
+
+func (x UpstreamProxyProtocol_Version) Enum() *UpstreamProxyProtocol_Version {
+	p := new(UpstreamProxyProtocol_Version)
+	*p = x
+	return p
+}
+
+func (x UpstreamProxyProtocol_Version) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpstreamProxyProtocol_Version) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes[1].Descriptor()
+}
+
+func (UpstreamProxyProtocol_Version) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes[1]
+}
+
+func (x UpstreamProxyProtocol_Version) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpstreamProxyProtocol_Version.Descriptor instead.
+func (UpstreamProxyProtocol_Version) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type ProxyProtocol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -41,6 +137,9 @@ type ProxyProtocol struct {
 	//   https://www.haproxy.org/download/2.1/doc/proxy-protocol.txt
 	//
 	AllowRequestsWithoutProxyProtocol bool `protobuf:"varint,2,opt,name=allow_requests_without_proxy_protocol,json=allowRequestsWithoutProxyProtocol,proto3" json:"allow_requests_without_proxy_protocol,omitempty"`
+	// The TLVs of the PROXY protocol header which are kept on the connection, so that an upstream with the
+	// `proxyProtocol` option can pass them on. By default, no TLVs are kept.
+	PassThroughTlvs *PassThroughTlvs `protobuf:"bytes,3,opt,name=pass_through_tlvs,json=passThroughTlvs,proto3" json:"pass_through_tlvs,omitempty"`
 }
 
 func (x *ProxyProtocol) Reset() {
@@ -89,6 +188,145 @@ func (x *ProxyProtocol) GetAllowRequestsWithoutProxyProtocol() bool {
 	return false
 }
 
+func (x *ProxyProtocol) GetPassThroughTlvs() *PassThroughTlvs {
+	if x != nil {
+		return x.PassThroughTlvs
+	}
+	return nil
+}
+
+// Selects the TLVs of a PROXY protocol header.
+type PassThroughTlvs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How the TLVs are selected.
+	MatchType PassThroughTlvs_MatchType `protobuf:"varint,1,opt,name=match_type,json=matchType,proto3,enum=proxy_protocol.options.gloo.solo.io.PassThroughTlvs_MatchType" json:"match_type,omitempty"`
+	// The types of the selected TLVs, when `matchType` is INCLUDE.
+	// TLV type is defined as uint8_t in proxy protocol. See `the spec
+	// <https://www.haproxy.org/download/2.1/doc/proxy-protocol.txt>`_ for details.
+	TlvTypes []uint32 `protobuf:"varint,2,rep,packed,name=tlv_types,json=tlvTypes,proto3" json:"tlv_types,omitempty"`
+}
+
+func (x *PassThroughTlvs) Reset() {
+	*x = PassThroughTlvs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassThroughTlvs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassThroughTlvs) ProtoMessage() {}
+
+func (x *PassThroughTlvs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassThroughTlvs.ProtoReflect.Descriptor instead.
+func (*PassThroughTlvs) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PassThroughTlvs) GetMatchType() PassThroughTlvs_MatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return PassThroughTlvs_INCLUDE_ALL
+}
+
+func (x *PassThroughTlvs) GetTlvTypes() []uint32 {
+	if x != nil {
+		return x.TlvTypes
+	}
+	return nil
+}
+
+// Makes Envoy send a PROXY protocol header to the upstream when it opens a connection, so that the upstream
+// knows the address of the downstream client. If the upstream has an `sslConfig`, the header is sent before the
+// TLS handshake.
+// Maps to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/proxy_protocol/v3/upstream_proxy_protocol.proto
+// Example:
+// ```
+// proxyProtocol:
+//   version: V2
+//   passThroughTlvs:
+//     matchType: INCLUDE
+//     tlvTypes:
+//     - 0xE0
+// ```
+type UpstreamProxyProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the PROXY protocol header. Defaults to V1.
+	Version UpstreamProxyProtocol_Version `protobuf:"varint,1,opt,name=version,proto3,enum=proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol_Version" json:"version,omitempty"`
+	// The TLVs, received in the PROXY protocol header of the downstream connection, which are added to the header
+	// sent to the upstream. The listener must keep them with the `passThroughTlvs` of its `proxyProtocol` option.
+	// Requires version V2.
+	PassThroughTlvs *PassThroughTlvs `protobuf:"bytes,2,opt,name=pass_through_tlvs,json=passThroughTlvs,proto3" json:"pass_through_tlvs,omitempty"`
+}
+
+func (x *UpstreamProxyProtocol) Reset() {
+	*x = UpstreamProxyProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamProxyProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamProxyProtocol) ProtoMessage() {}
+
+func (x *UpstreamProxyProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamProxyProtocol.ProtoReflect.Descriptor instead.
+func (*UpstreamProxyProtocol) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpstreamProxyProtocol) GetVersion() UpstreamProxyProtocol_Version {
+	if x != nil {
+		return x.Version
+	}
+	return UpstreamProxyProtocol_V1
+}
+
+func (x *UpstreamProxyProtocol) GetPassThroughTlvs() *PassThroughTlvs {
+	if x != nil {
+		return x.PassThroughTlvs
+	}
+	return nil
+}
+
 type ProxyProtocol_KeyValuePair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,7 +341,7 @@ type ProxyProtocol_KeyValuePair struct {
 func (x *ProxyProtocol_KeyValuePair) Reset() {
 	*x = ProxyProtocol_KeyValuePair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[1]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -116,7 +354,7 @@ func (x *ProxyProtocol_KeyValuePair) String() string {
 func (*ProxyProtocol_KeyValuePair) ProtoMessage() {}
 
 func (x *ProxyProtocol_KeyValuePair) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[1]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,7 +401,7 @@ type ProxyProtocol_Rule struct {
 func (x *ProxyProtocol_Rule) Reset() {
 	*x = ProxyProtocol_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -176,7 +414,7 @@ func (x *ProxyProtocol_Rule) String() string {
 func (*ProxyProtocol_Rule) ProtoMessage() {}
 
 func (x *ProxyProtocol_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +457,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pro
 	0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a,
 	0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x03, 0x0a,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x04, 0x0a,
 	0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x4d,
 	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
 	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x6f,
@@ -230,28 +468,64 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pro
 	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x21, 0x61, 0x6c,
 	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f,
-	0x75, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a,
-	0x58, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12,
-	0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x92, 0x01, 0x0a, 0x04, 0x52, 0x75,
-	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x74, 0x6c, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x10, 0x80, 0x02, 0x52, 0x07,
-	0x74, 0x6c, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x6f, 0x6e, 0x5f, 0x74, 0x6c,
-	0x76, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3f, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x75, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x60, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f,
+	0x74, 0x6c, 0x76, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x6c, 0x76, 0x73,
+	0x52, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x6c, 0x76,
+	0x73, 0x1a, 0x58, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x19, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x92, 0x01, 0x0a, 0x04,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x74, 0x6c, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x10, 0x80, 0x02,
+	0x52, 0x07, 0x74, 0x6c, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6c, 0x76, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x0c, 0x6f, 0x6e, 0x54, 0x6c, 0x76, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x22, 0xd1, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x54, 0x6c, 0x76, 0x73, 0x12, 0x67, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x6c, 0x76, 0x73, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a,
+	0x09, 0x74, 0x6c, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
+	0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x2a, 0x03, 0x10, 0x80, 0x02, 0x52,
+	0x08, 0x74, 0x6c, 0x76, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44,
+	0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x43, 0x4c, 0x55,
+	0x44, 0x45, 0x10, 0x01, 0x22, 0xfc, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x66,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x42, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
 	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x0c, 0x6f, 0x6e, 0x54, 0x6c, 0x76, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x55,
-	0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04,
-	0x01, 0xd0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x74,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x54, 0x6c, 0x76, 0x73, 0x52, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x54, 0x6c, 0x76, 0x73, 0x22, 0x19, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56,
+	0x32, 0x10, 0x01, 0x42, 0x55, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0xc0, 0xf5,
+	0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -266,20 +540,29 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pr
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_goTypes = []interface{}{
-	(*ProxyProtocol)(nil),              // 0: proxy_protocol.options.gloo.solo.io.ProxyProtocol
-	(*ProxyProtocol_KeyValuePair)(nil), // 1: proxy_protocol.options.gloo.solo.io.ProxyProtocol.KeyValuePair
-	(*ProxyProtocol_Rule)(nil),         // 2: proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule
+	(PassThroughTlvs_MatchType)(0),     // 0: proxy_protocol.options.gloo.solo.io.PassThroughTlvs.MatchType
+	(UpstreamProxyProtocol_Version)(0), // 1: proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol.Version
+	(*ProxyProtocol)(nil),              // 2: proxy_protocol.options.gloo.solo.io.ProxyProtocol
+	(*PassThroughTlvs)(nil),            // 3: proxy_protocol.options.gloo.solo.io.PassThroughTlvs
+	(*UpstreamProxyProtocol)(nil),      // 4: proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol
+	(*ProxyProtocol_KeyValuePair)(nil), // 5: proxy_protocol.options.gloo.solo.io.ProxyProtocol.KeyValuePair
+	(*ProxyProtocol_Rule)(nil),         // 6: proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_depIdxs = []int32{
-	2, // 0: proxy_protocol.options.gloo.solo.io.ProxyProtocol.rules:type_name -> proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule
-	1, // 1: proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule.on_tlv_present:type_name -> proxy_protocol.options.gloo.solo.io.ProxyProtocol.KeyValuePair
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: proxy_protocol.options.gloo.solo.io.ProxyProtocol.rules:type_name -> proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule
+	3, // 1: proxy_protocol.options.gloo.solo.io.ProxyProtocol.pass_through_tlvs:type_name -> proxy_protocol.options.gloo.solo.io.PassThroughTlvs
+	0, // 2: proxy_protocol.options.gloo.solo.io.PassThroughTlvs.match_type:type_name -> proxy_protocol.options.gloo.solo.io.PassThroughTlvs.MatchType
+	1, // 3: proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol.version:type_name -> proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol.Version
+	3, // 4: proxy_protocol.options.gloo.solo.io.UpstreamProxyProtocol.pass_through_tlvs:type_name -> proxy_protocol.options.gloo.solo.io.PassThroughTlvs
+	5, // 5: proxy_protocol.options.gloo.solo.io.ProxyProtocol.Rule.on_tlv_present:type_name -> proxy_protocol.options.gloo.solo.io.ProxyProtocol.KeyValuePair
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() {
@@ -303,7 +586,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pr
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProxyProtocol_KeyValuePair); i {
+			switch v := v.(*PassThroughTlvs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -315,6 +598,30 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pr
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamProxyProtocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyProtocol_KeyValuePair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyProtocol_Rule); i {
 			case 0:
 				return &v.state
@@ -332,13 +639,14 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_pr
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_proxy_protocol_proxy_protocol_proto = out.File
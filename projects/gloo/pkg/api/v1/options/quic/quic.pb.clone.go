@@ -0,0 +1,85 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto
+
+package quic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *Http3) Clone() proto.Message {
+	var target *Http3
+	if m == nil {
+		return target
+	}
+	target = &Http3{}
+
+	if h, ok := interface{}(m.GetMaxConcurrentStreams()).(clone.Cloner); ok {
+		target.MaxConcurrentStreams = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxConcurrentStreams = proto.Clone(m.GetMaxConcurrentStreams()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetInitialStreamWindowSize()).(clone.Cloner); ok {
+		target.InitialStreamWindowSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.InitialStreamWindowSize = proto.Clone(m.GetInitialStreamWindowSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetInitialConnectionWindowSize()).(clone.Cloner); ok {
+		target.InitialConnectionWindowSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.InitialConnectionWindowSize = proto.Clone(m.GetInitialConnectionWindowSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetCryptoHandshakeTimeout()).(clone.Cloner); ok {
+		target.CryptoHandshakeTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.CryptoHandshakeTimeout = proto.Clone(m.GetCryptoHandshakeTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	target.DisableAltSvc = m.GetDisableAltSvc()
+
+	if h, ok := interface{}(m.GetAltSvcMaxAge()).(clone.Cloner); ok {
+		target.AltSvcMaxAge = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.AltSvcMaxAge = proto.Clone(m.GetAltSvcMaxAge()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetAltSvcPort()).(clone.Cloner); ok {
+		target.AltSvcPort = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.AltSvcPort = proto.Clone(m.GetAltSvcPort()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	return target
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto
+
+package quic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *Http3) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Http3)
+	if !ok {
+		that2, ok := that.(Http3)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxConcurrentStreams()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxConcurrentStreams()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxConcurrentStreams(), target.GetMaxConcurrentStreams()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetInitialStreamWindowSize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInitialStreamWindowSize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInitialStreamWindowSize(), target.GetInitialStreamWindowSize()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetInitialConnectionWindowSize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInitialConnectionWindowSize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInitialConnectionWindowSize(), target.GetInitialConnectionWindowSize()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCryptoHandshakeTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCryptoHandshakeTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCryptoHandshakeTimeout(), target.GetCryptoHandshakeTimeout()) {
+			return false
+		}
+	}
+
+	if m.GetDisableAltSvc() != target.GetDisableAltSvc() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetAltSvcMaxAge()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAltSvcMaxAge()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAltSvcMaxAge(), target.GetAltSvcMaxAge()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAltSvcPort()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAltSvcPort()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAltSvcPort(), target.GetAltSvcPort()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -74,5 +76,95 @@ func (m *RetryPolicy) Clone() proto.Message {
 		target.RetryBackOff = proto.Clone(m.GetRetryBackOff()).(*RetryBackOff)
 	}
 
+	if m.GetRetriableStatusCodes() != nil {
+		target.RetriableStatusCodes = make([]uint32, len(m.GetRetriableStatusCodes()))
+		for idx, v := range m.GetRetriableStatusCodes() {
+
+			target.RetriableStatusCodes[idx] = v
+
+		}
+	}
+
+	if m.GetRetriableHeaders() != nil {
+		target.RetriableHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetRetriableHeaders()))
+		for idx, v := range m.GetRetriableHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RetriableHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.RetriableHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	target.RetryPreviousHosts = m.GetRetryPreviousHosts()
+
+	target.HostSelectionRetryMaxAttempts = m.GetHostSelectionRetryMaxAttempts()
+
+	if h, ok := interface{}(m.GetRateLimitedRetryBackOff()).(clone.Cloner); ok {
+		target.RateLimitedRetryBackOff = h.Clone().(*RateLimitedRetryBackOff)
+	} else {
+		target.RateLimitedRetryBackOff = proto.Clone(m.GetRateLimitedRetryBackOff()).(*RateLimitedRetryBackOff)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RateLimitedRetryBackOff) Clone() proto.Message {
+	var target *RateLimitedRetryBackOff
+	if m == nil {
+		return target
+	}
+	target = &RateLimitedRetryBackOff{}
+
+	if m.GetResetHeaders() != nil {
+		target.ResetHeaders = make([]*RateLimitedRetryBackOff_ResetHeader, len(m.GetResetHeaders()))
+		for idx, v := range m.GetResetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ResetHeaders[idx] = h.Clone().(*RateLimitedRetryBackOff_ResetHeader)
+			} else {
+				target.ResetHeaders[idx] = proto.Clone(v).(*RateLimitedRetryBackOff_ResetHeader)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(clone.Cloner); ok {
+		target.MaxInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MaxInterval = proto.Clone(m.GetMaxInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *HedgePolicy) Clone() proto.Message {
+	var target *HedgePolicy
+	if m == nil {
+		return target
+	}
+	target = &HedgePolicy{}
+
+	target.HedgeOnPerTryTimeout = m.GetHedgeOnPerTryTimeout()
+
+	return target
+}
+
+// Clone function
+func (m *RateLimitedRetryBackOff_ResetHeader) Clone() proto.Message {
+	var target *RateLimitedRetryBackOff_ResetHeader
+	if m == nil {
+		return target
+	}
+	target = &RateLimitedRetryBackOff_ResetHeader{}
+
+	target.Name = m.GetName()
+
+	target.Format = m.GetFormat()
+
 	return target
 }
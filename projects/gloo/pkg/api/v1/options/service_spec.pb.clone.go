@@ -15,6 +15,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 )
 
@@ -63,6 +65,18 @@ func (m *ServiceSpec) Clone() proto.Message {
 			}
 		}
 
+	case *ServiceSpec_GrpcJsonTranscoder:
+
+		if h, ok := interface{}(m.GetGrpcJsonTranscoder()).(clone.Cloner); ok {
+			target.PluginType = &ServiceSpec_GrpcJsonTranscoder{
+				GrpcJsonTranscoder: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc_json.GrpcJsonTranscoder),
+			}
+		} else {
+			target.PluginType = &ServiceSpec_GrpcJsonTranscoder{
+				GrpcJsonTranscoder: proto.Clone(m.GetGrpcJsonTranscoder()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc_json.GrpcJsonTranscoder),
+			}
+		}
+
 	}
 
 	return target
@@ -11,6 +11,7 @@ import (
 	sync "sync"
 
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -39,6 +40,7 @@ type ServiceSpec struct {
 	// Types that are assignable to PluginType:
 	//	*ServiceSpec_Rest
 	//	*ServiceSpec_Grpc
+	//	*ServiceSpec_GrpcJsonTranscoder
 	PluginType isServiceSpec_PluginType `protobuf_oneof:"plugin_type"`
 }
 
@@ -95,6 +97,13 @@ func (x *ServiceSpec) GetGrpc() *grpc.ServiceSpec {
 	return nil
 }
 
+func (x *ServiceSpec) GetGrpcJsonTranscoder() *grpc_json.GrpcJsonTranscoder {
+	if x, ok := x.GetPluginType().(*ServiceSpec_GrpcJsonTranscoder); ok {
+		return x.GrpcJsonTranscoder
+	}
+	return nil
+}
+
 type isServiceSpec_PluginType interface {
 	isServiceSpec_PluginType()
 }
@@ -107,10 +116,19 @@ type ServiceSpec_Grpc struct {
 	Grpc *grpc.ServiceSpec `protobuf:"bytes,2,opt,name=grpc,proto3,oneof"`
 }
 
+type ServiceSpec_GrpcJsonTranscoder struct {
+	// Transcodes JSON requests to the gRPC services of this upstream.
+	// This is usually filled automatically via function discovery when
+	// `settings.discovery.fdsOptions.grpcJsonTranscodingEnabled` is set.
+	GrpcJsonTranscoder *grpc_json.GrpcJsonTranscoder `protobuf:"bytes,3,opt,name=grpc_json_transcoder,json=grpcJsonTranscoder,proto3,oneof"`
+}
+
 func (*ServiceSpec_Rest) isServiceSpec_PluginType() {}
 
 func (*ServiceSpec_Grpc) isServiceSpec_PluginType() {}
 
+func (*ServiceSpec_GrpcJsonTranscoder) isServiceSpec_PluginType() {}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_rawDesc = []byte{
@@ -128,9 +146,14 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto
 	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
 	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x4e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x98, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x80, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
 	0x3c, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x72, 0x65, 0x73, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
@@ -138,13 +161,20 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto
 	0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x72,
 	0x70, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x46, 0x5a, 0x38, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
-	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0xc0, 0xf5,
-	0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x66, 0x0a, 0x14, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4a,
+	0x73, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x12, 0x67, 0x72, 0x70, 0x63, 0x4a, 0x73, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x42, 0x46, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xb8, 0xf5,
+	0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -161,18 +191,20 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_prot
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_goTypes = []interface{}{
-	(*ServiceSpec)(nil),      // 0: options.gloo.solo.io.ServiceSpec
-	(*rest.ServiceSpec)(nil), // 1: rest.options.gloo.solo.io.ServiceSpec
-	(*grpc.ServiceSpec)(nil), // 2: grpc.options.gloo.solo.io.ServiceSpec
+	(*ServiceSpec)(nil),                  // 0: options.gloo.solo.io.ServiceSpec
+	(*rest.ServiceSpec)(nil),             // 1: rest.options.gloo.solo.io.ServiceSpec
+	(*grpc.ServiceSpec)(nil),             // 2: grpc.options.gloo.solo.io.ServiceSpec
+	(*grpc_json.GrpcJsonTranscoder)(nil), // 3: grpc_json.options.gloo.solo.io.GrpcJsonTranscoder
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_depIdxs = []int32{
 	1, // 0: options.gloo.solo.io.ServiceSpec.rest:type_name -> rest.options.gloo.solo.io.ServiceSpec
 	2, // 1: options.gloo.solo.io.ServiceSpec.grpc:type_name -> grpc.options.gloo.solo.io.ServiceSpec
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: options.gloo.solo.io.ServiceSpec.grpc_json_transcoder:type_name -> grpc_json.options.gloo.solo.io.GrpcJsonTranscoder
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_init() }
@@ -197,6 +229,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_prot
 	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_service_spec_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ServiceSpec_Rest)(nil),
 		(*ServiceSpec_Grpc)(nil),
+		(*ServiceSpec_GrpcJsonTranscoder)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
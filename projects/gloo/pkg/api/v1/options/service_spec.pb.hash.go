@@ -84,6 +84,28 @@ func (m *ServiceSpec) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *ServiceSpec_GrpcJsonTranscoder:
+
+		if h, ok := interface{}(m.GetGrpcJsonTranscoder()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("GrpcJsonTranscoder")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGrpcJsonTranscoder(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("GrpcJsonTranscoder")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -13,6 +13,8 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -43,5 +45,133 @@ func (m *RouteShadowing) Clone() proto.Message {
 
 	target.Percentage = m.GetPercentage()
 
+	if m.GetMirrors() != nil {
+		target.Mirrors = make([]*RequestMirror, len(m.GetMirrors()))
+		for idx, v := range m.GetMirrors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Mirrors[idx] = h.Clone().(*RequestMirror)
+			} else {
+				target.Mirrors[idx] = proto.Clone(v).(*RequestMirror)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RequestMirror) Clone() proto.Message {
+	var target *RequestMirror
+	if m == nil {
+		return target
+	}
+	target = &RequestMirror{}
+
+	if h, ok := interface{}(m.GetPercentage()).(clone.Cloner); ok {
+		target.Percentage = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.FloatValue)
+	} else {
+		target.Percentage = proto.Clone(m.GetPercentage()).(*github_com_golang_protobuf_ptypes_wrappers.FloatValue)
+	}
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	if h, ok := interface{}(m.GetTraceSampled()).(clone.Cloner); ok {
+		target.TraceSampled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.TraceSampled = proto.Clone(m.GetTraceSampled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	switch m.DestinationType.(type) {
+
+	case *RequestMirror_Upstream:
+
+		if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirror_Upstream{
+				Upstream: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.DestinationType = &RequestMirror_Upstream{
+				Upstream: proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	case *RequestMirror_Kube:
+
+		if h, ok := interface{}(m.GetKube()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirror_Kube{
+				Kube: h.Clone().(*KubernetesServiceDestination),
+			}
+		} else {
+			target.DestinationType = &RequestMirror_Kube{
+				Kube: proto.Clone(m.GetKube()).(*KubernetesServiceDestination),
+			}
+		}
+
+	case *RequestMirror_Consul:
+
+		if h, ok := interface{}(m.GetConsul()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirror_Consul{
+				Consul: h.Clone().(*ConsulServiceDestination),
+			}
+		} else {
+			target.DestinationType = &RequestMirror_Consul{
+				Consul: proto.Clone(m.GetConsul()).(*ConsulServiceDestination),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *KubernetesServiceDestination) Clone() proto.Message {
+	var target *KubernetesServiceDestination
+	if m == nil {
+		return target
+	}
+	target = &KubernetesServiceDestination{}
+
+	if h, ok := interface{}(m.GetRef()).(clone.Cloner); ok {
+		target.Ref = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Ref = proto.Clone(m.GetRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.Port = m.GetPort()
+
+	return target
+}
+
+// Clone function
+func (m *ConsulServiceDestination) Clone() proto.Message {
+	var target *ConsulServiceDestination
+	if m == nil {
+		return target
+	}
+	target = &ConsulServiceDestination{}
+
+	target.ServiceName = m.GetServiceName()
+
+	if m.GetTags() != nil {
+		target.Tags = make([]string, len(m.GetTags()))
+		for idx, v := range m.GetTags() {
+
+			target.Tags[idx] = v
+
+		}
+	}
+
+	if m.GetDataCenters() != nil {
+		target.DataCenters = make([]string, len(m.GetDataCenters()))
+		for idx, v := range m.GetDataCenters() {
+
+			target.DataCenters[idx] = v
+
+		}
+	}
+
 	return target
 }
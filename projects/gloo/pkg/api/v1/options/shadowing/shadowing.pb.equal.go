@@ -60,5 +60,212 @@ func (m *RouteShadowing) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetMirrors()) != len(target.GetMirrors()) {
+		return false
+	}
+	for idx, v := range m.GetMirrors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMirrors()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMirrors()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RequestMirror) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RequestMirror)
+	if !ok {
+		that2, ok := that.(RequestMirror)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPercentage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPercentage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPercentage(), target.GetPercentage()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTraceSampled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTraceSampled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTraceSampled(), target.GetTraceSampled()) {
+			return false
+		}
+	}
+
+	switch m.DestinationType.(type) {
+
+	case *RequestMirror_Upstream:
+		if _, ok := target.DestinationType.(*RequestMirror_Upstream); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstream()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+				return false
+			}
+		}
+
+	case *RequestMirror_Kube:
+		if _, ok := target.DestinationType.(*RequestMirror_Kube); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetKube()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetKube()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetKube(), target.GetKube()) {
+				return false
+			}
+		}
+
+	case *RequestMirror_Consul:
+		if _, ok := target.DestinationType.(*RequestMirror_Consul); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConsul()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConsul()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConsul(), target.GetConsul()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.DestinationType != target.DestinationType {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *KubernetesServiceDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*KubernetesServiceDestination)
+	if !ok {
+		that2, ok := that.(KubernetesServiceDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ConsulServiceDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ConsulServiceDestination)
+	if !ok {
+		that2, ok := that.(ConsulServiceDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	if len(m.GetTags()) != len(target.GetTags()) {
+		return false
+	}
+	for idx, v := range m.GetTags() {
+
+		if strings.Compare(v, target.GetTags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetDataCenters()) != len(target.GetDataCenters()) {
+		return false
+	}
+	for idx, v := range m.GetDataCenters() {
+
+		if strings.Compare(v, target.GetDataCenters()[idx]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
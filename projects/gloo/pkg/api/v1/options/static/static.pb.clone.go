@@ -16,6 +16,8 @@ import (
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
 )
 
 // ensure the imports are used
@@ -64,6 +66,12 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		target.AutoSniRewrite = proto.Clone(m.GetAutoSniRewrite()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetDnsOptions()).(clone.Cloner); ok {
+		target.DnsOptions = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns.DnsOptions)
+	} else {
+		target.DnsOptions = proto.Clone(m.GetDnsOptions()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns.DnsOptions)
+	}
+
 	return target
 }
 
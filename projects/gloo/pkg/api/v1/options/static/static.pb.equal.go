@@ -87,6 +87,16 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetDnsOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDnsOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDnsOptions(), target.GetDnsOptions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/tap/tap.proto
+
+package tap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *Tap) Clone() proto.Message {
+	var target *Tap
+	if m == nil {
+		return target
+	}
+	target = &Tap{}
+
+	if h, ok := interface{}(m.GetMatch()).(clone.Cloner); ok {
+		target.Match = h.Clone().(*Tap_Match)
+	} else {
+		target.Match = proto.Clone(m.GetMatch()).(*Tap_Match)
+	}
+
+	if h, ok := interface{}(m.GetMaxBufferedBytes()).(clone.Cloner); ok {
+		target.MaxBufferedBytes = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxBufferedBytes = proto.Clone(m.GetMaxBufferedBytes()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	switch m.Sink.(type) {
+
+	case *Tap_FileSink_:
+
+		if h, ok := interface{}(m.GetFileSink()).(clone.Cloner); ok {
+			target.Sink = &Tap_FileSink_{
+				FileSink: h.Clone().(*Tap_FileSink),
+			}
+		} else {
+			target.Sink = &Tap_FileSink_{
+				FileSink: proto.Clone(m.GetFileSink()).(*Tap_FileSink),
+			}
+		}
+
+	case *Tap_AdminSink_:
+
+		if h, ok := interface{}(m.GetAdminSink()).(clone.Cloner); ok {
+			target.Sink = &Tap_AdminSink_{
+				AdminSink: h.Clone().(*Tap_AdminSink),
+			}
+		} else {
+			target.Sink = &Tap_AdminSink_{
+				AdminSink: proto.Clone(m.GetAdminSink()).(*Tap_AdminSink),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Tap_Match) Clone() proto.Message {
+	var target *Tap_Match
+	if m == nil {
+		return target
+	}
+	target = &Tap_Match{}
+
+	if m.GetRequestHeaders() != nil {
+		target.RequestHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetRequestHeaders()))
+		for idx, v := range m.GetRequestHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RequestHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.RequestHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if m.GetResponseHeaders() != nil {
+		target.ResponseHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetResponseHeaders()))
+		for idx, v := range m.GetResponseHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ResponseHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.ResponseHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	target.PathPrefix = m.GetPathPrefix()
+
+	return target
+}
+
+// Clone function
+func (m *Tap_FileSink) Clone() proto.Message {
+	var target *Tap_FileSink
+	if m == nil {
+		return target
+	}
+	target = &Tap_FileSink{}
+
+	target.PathPrefix = m.GetPathPrefix()
+
+	target.Format = m.GetFormat()
+
+	return target
+}
+
+// Clone function
+func (m *Tap_AdminSink) Clone() proto.Message {
+	var target *Tap_AdminSink
+	if m == nil {
+		return target
+	}
+	target = &Tap_AdminSink{}
+
+	target.ConfigId = m.GetConfigId()
+
+	return target
+}
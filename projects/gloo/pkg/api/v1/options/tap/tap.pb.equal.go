@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/tap/tap.proto
+
+package tap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *Tap) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Tap)
+	if !ok {
+		that2, ok := that.(Tap)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMatch()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMatch()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMatch(), target.GetMatch()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxBufferedBytes()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxBufferedBytes()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxBufferedBytes(), target.GetMaxBufferedBytes()) {
+			return false
+		}
+	}
+
+	switch m.Sink.(type) {
+
+	case *Tap_FileSink_:
+		if _, ok := target.Sink.(*Tap_FileSink_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetFileSink()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFileSink()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetFileSink(), target.GetFileSink()) {
+				return false
+			}
+		}
+
+	case *Tap_AdminSink_:
+		if _, ok := target.Sink.(*Tap_AdminSink_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetAdminSink()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAdminSink()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetAdminSink(), target.GetAdminSink()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Sink != target.Sink {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Tap_Match) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Tap_Match)
+	if !ok {
+		that2, ok := that.(Tap_Match)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetRequestHeaders()) != len(target.GetRequestHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetRequestHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRequestHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRequestHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetResponseHeaders()) != len(target.GetResponseHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetResponseHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetResponseHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetResponseHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if strings.Compare(m.GetPathPrefix(), target.GetPathPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Tap_FileSink) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Tap_FileSink)
+	if !ok {
+		that2, ok := that.(Tap_FileSink)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetPathPrefix(), target.GetPathPrefix()) != 0 {
+		return false
+	}
+
+	if m.GetFormat() != target.GetFormat() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Tap_AdminSink) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Tap_AdminSink)
+	if !ok {
+		that2, ok := that.(Tap_AdminSink)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetConfigId(), target.GetConfigId()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,87 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/udp_proxy/udp_proxy.proto
+
+package udp_proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *UdpProxySettings) Clone() proto.Message {
+	var target *UdpProxySettings
+	if m == nil {
+		return target
+	}
+	target = &UdpProxySettings{}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if m.GetHashPolicies() != nil {
+		target.HashPolicies = make([]*UdpProxySettings_HashPolicy, len(m.GetHashPolicies()))
+		for idx, v := range m.GetHashPolicies() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.HashPolicies[idx] = h.Clone().(*UdpProxySettings_HashPolicy)
+			} else {
+				target.HashPolicies[idx] = proto.Clone(v).(*UdpProxySettings_HashPolicy)
+			}
+
+		}
+	}
+
+	target.UseOriginalSrcIp = m.GetUseOriginalSrcIp()
+
+	return target
+}
+
+// Clone function
+func (m *UdpProxySettings_HashPolicy) Clone() proto.Message {
+	var target *UdpProxySettings_HashPolicy
+	if m == nil {
+		return target
+	}
+	target = &UdpProxySettings_HashPolicy{}
+
+	switch m.PolicySpecifier.(type) {
+
+	case *UdpProxySettings_HashPolicy_SourceIp:
+
+		target.PolicySpecifier = &UdpProxySettings_HashPolicy_SourceIp{
+			SourceIp: m.GetSourceIp(),
+		}
+
+	case *UdpProxySettings_HashPolicy_Key:
+
+		target.PolicySpecifier = &UdpProxySettings_HashPolicy_Key{
+			Key: m.GetKey(),
+		}
+
+	}
+
+	return target
+}
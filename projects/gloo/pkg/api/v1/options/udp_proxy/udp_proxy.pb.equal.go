@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/udp_proxy/udp_proxy.proto
+
+package udp_proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *UdpProxySettings) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpProxySettings)
+	if !ok {
+		that2, ok := that.(UdpProxySettings)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	if len(m.GetHashPolicies()) != len(target.GetHashPolicies()) {
+		return false
+	}
+	for idx, v := range m.GetHashPolicies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHashPolicies()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHashPolicies()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetUseOriginalSrcIp() != target.GetUseOriginalSrcIp() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UdpProxySettings_HashPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpProxySettings_HashPolicy)
+	if !ok {
+		that2, ok := that.(UdpProxySettings_HashPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.PolicySpecifier.(type) {
+
+	case *UdpProxySettings_HashPolicy_SourceIp:
+		if _, ok := target.PolicySpecifier.(*UdpProxySettings_HashPolicy_SourceIp); !ok {
+			return false
+		}
+
+		if m.GetSourceIp() != target.GetSourceIp() {
+			return false
+		}
+
+	case *UdpProxySettings_HashPolicy_Key:
+		if _, ok := target.PolicySpecifier.(*UdpProxySettings_HashPolicy_Key); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.PolicySpecifier != target.PolicySpecifier {
+			return false
+		}
+	}
+
+	return true
+}
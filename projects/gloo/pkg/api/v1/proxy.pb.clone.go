@@ -27,6 +27,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -124,6 +126,12 @@ func (m *Listener) Clone() proto.Message {
 		target.RouteOptions = proto.Clone(m.GetRouteOptions()).(*RouteConfigurationOptions)
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(clone.Cloner); ok {
+		target.Http3 = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.Http3)
+	} else {
+		target.Http3 = proto.Clone(m.GetHttp3()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.Http3)
+	}
+
 	switch m.ListenerType.(type) {
 
 	case *Listener_HttpListener:
@@ -174,6 +182,18 @@ func (m *Listener) Clone() proto.Message {
 			}
 		}
 
+	case *Listener_UdpListener:
+
+		if h, ok := interface{}(m.GetUdpListener()).(clone.Cloner); ok {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: h.Clone().(*UdpListener),
+			}
+		} else {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: proto.Clone(m.GetUdpListener()).(*UdpListener),
+			}
+		}
+
 	}
 
 	switch m.OpaqueMetadata.(type) {
@@ -239,6 +259,31 @@ func (m *TcpListener) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListener) Clone() proto.Message {
+	var target *UdpListener
+	if m == nil {
+		return target
+	}
+	target = &UdpListener{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(clone.Cloner); ok {
+		target.Options = h.Clone().(*UdpListenerOptions)
+	} else {
+		target.Options = proto.Clone(m.GetOptions()).(*UdpListenerOptions)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	return target
+}
+
 // Clone function
 func (m *TcpHost) Clone() proto.Message {
 	var target *TcpHost
@@ -170,6 +170,16 @@ func (m *Listener) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp3()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp3(), target.GetHttp3()) {
+			return false
+		}
+	}
+
 	switch m.ListenerType.(type) {
 
 	case *Listener_HttpListener:
@@ -232,6 +242,21 @@ func (m *Listener) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Listener_UdpListener:
+		if _, ok := target.ListenerType.(*Listener_UdpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpListener(), target.GetUdpListener()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.ListenerType != target.ListenerType {
@@ -336,6 +361,54 @@ func (m *TcpListener) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListener)
+	if !ok {
+		that2, ok := that.(UdpListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOptions(), target.GetOptions()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *TcpHost) Equal(that interface{}) bool {
 	if that == nil {
@@ -180,6 +180,12 @@ func (m *AzureSecret) Clone() proto.Message {
 		}
 	}
 
+	target.TenantId = m.GetTenantId()
+
+	target.ClientId = m.GetClientId()
+
+	target.ClientSecret = m.GetClientSecret()
+
 	return target
 }
 
@@ -29,6 +29,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -426,6 +428,12 @@ func (m *GlooOptions) Clone() proto.Message {
 
 	target.ProxyDebugBindAddr = m.GetProxyDebugBindAddr()
 
+	if h, ok := interface{}(m.GetStaticUpstreamDnsOptions()).(clone.Cloner); ok {
+		target.StaticUpstreamDnsOptions = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns.DnsOptions)
+	} else {
+		target.StaticUpstreamDnsOptions = proto.Clone(m.GetStaticUpstreamDnsOptions()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns.DnsOptions)
+	}
+
 	return target
 }
 
@@ -868,6 +876,10 @@ func (m *Settings_KubernetesConfiguration) Clone() proto.Message {
 		target.RateLimits = proto.Clone(m.GetRateLimits()).(*Settings_KubernetesConfiguration_RateLimits)
 	}
 
+	target.EndpointsSource = m.GetEndpointsSource()
+
+	target.LocalityFromNodeLabels = m.GetLocalityFromNodeLabels()
+
 	return target
 }
 
@@ -941,6 +953,24 @@ func (m *Settings_DiscoveryOptions_FdsOptions) Clone() proto.Message {
 		target.GraphqlEnabled = proto.Clone(m.GetGraphqlEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetGrpcJsonTranscodingEnabled()).(clone.Cloner); ok {
+		target.GrpcJsonTranscodingEnabled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.GrpcJsonTranscodingEnabled = proto.Clone(m.GetGrpcJsonTranscodingEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	target.AzureManagementEndpoint = m.GetAzureManagementEndpoint()
+
+	target.AzureLoginEndpoint = m.GetAzureLoginEndpoint()
+
+	target.AwsLambdaEndpoint = m.GetAwsLambdaEndpoint()
+
+	if h, ok := interface{}(m.GetAwsLambdaPollingInterval()).(clone.Cloner); ok {
+		target.AwsLambdaPollingInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.AwsLambdaPollingInterval = proto.Clone(m.GetAwsLambdaPollingInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
 	return target
 }
 
@@ -641,6 +641,16 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetStaticUpstreamDnsOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStaticUpstreamDnsOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStaticUpstreamDnsOptions(), target.GetStaticUpstreamDnsOptions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1497,6 +1507,14 @@ func (m *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetEndpointsSource() != target.GetEndpointsSource() {
+		return false
+	}
+
+	if m.GetLocalityFromNodeLabels() != target.GetLocalityFromNodeLabels() {
+		return false
+	}
+
 	return true
 }
 
@@ -1627,6 +1645,38 @@ func (m *Settings_DiscoveryOptions_FdsOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetGrpcJsonTranscodingEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGrpcJsonTranscodingEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGrpcJsonTranscodingEnabled(), target.GetGrpcJsonTranscodingEnabled()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetAzureManagementEndpoint(), target.GetAzureManagementEndpoint()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetAzureLoginEndpoint(), target.GetAzureLoginEndpoint()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetAwsLambdaEndpoint(), target.GetAwsLambdaEndpoint()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetAwsLambdaPollingInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAwsLambdaPollingInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAwsLambdaPollingInterval(), target.GetAwsLambdaPollingInterval()) {
+			return false
+		}
+	}
+
 	return true
 }
 
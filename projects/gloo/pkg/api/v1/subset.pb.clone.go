@@ -42,5 +42,14 @@ func (m *Subset) Clone() proto.Message {
 		}
 	}
 
+	if m.GetMetadataKeys() != nil {
+		target.MetadataKeys = make([]string, len(m.GetMetadataKeys()))
+		for idx, v := range m.GetMetadataKeys() {
+
+			target.MetadataKeys[idx] = v
+
+		}
+	}
+
 	return target
 }
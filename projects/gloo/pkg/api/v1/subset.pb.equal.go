@@ -57,5 +57,16 @@ func (m *Subset) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetMetadataKeys()) != len(target.GetMetadataKeys()) {
+		return false
+	}
+	for idx, v := range m.GetMetadataKeys() {
+
+		if strings.Compare(v, target.GetMetadataKeys()[idx]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_api_v2_cluster "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
@@ -31,6 +33,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_proxy_protocol "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -130,6 +134,12 @@ func (m *Upstream) Clone() proto.Message {
 		target.UseHttp2 = proto.Clone(m.GetUseHttp2()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetUseHttp3()).(clone.Cloner); ok {
+		target.UseHttp3 = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.UseHttp3 = proto.Clone(m.GetUseHttp3()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
 	if h, ok := interface{}(m.GetInitialStreamWindowSize()).(clone.Cloner); ok {
 		target.InitialStreamWindowSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
 	} else {
@@ -185,6 +195,18 @@ func (m *Upstream) Clone() proto.Message {
 		target.IgnoreHealthOnHostRemoval = proto.Clone(m.GetIgnoreHealthOnHostRemoval()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetProxyProtocol()).(clone.Cloner); ok {
+		target.ProxyProtocol = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_proxy_protocol.UpstreamProxyProtocol)
+	} else {
+		target.ProxyProtocol = proto.Clone(m.GetProxyProtocol()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_proxy_protocol.UpstreamProxyProtocol)
+	}
+
+	if h, ok := interface{}(m.GetPreferSameZone()).(clone.Cloner); ok {
+		target.PreferSameZone = h.Clone().(*PreferSameZone)
+	} else {
+		target.PreferSameZone = proto.Clone(m.GetPreferSameZone()).(*PreferSameZone)
+	}
+
 	switch m.UpstreamType.(type) {
 
 	case *Upstream_Kube:
@@ -293,6 +315,47 @@ func (m *DiscoveryMetadata) Clone() proto.Message {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFunctionDiscoveryStatus()).(clone.Cloner); ok {
+		target.FunctionDiscoveryStatus = h.Clone().(*FunctionDiscoveryStatus)
+	} else {
+		target.FunctionDiscoveryStatus = proto.Clone(m.GetFunctionDiscoveryStatus()).(*FunctionDiscoveryStatus)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *FunctionDiscoveryStatus) Clone() proto.Message {
+	var target *FunctionDiscoveryStatus
+	if m == nil {
+		return target
+	}
+	target = &FunctionDiscoveryStatus{}
+
+	target.FunctionType = m.GetFunctionType()
+
+	if h, ok := interface{}(m.GetLastAttemptTime()).(clone.Cloner); ok {
+		target.LastAttemptTime = h.Clone().(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	} else {
+		target.LastAttemptTime = proto.Clone(m.GetLastAttemptTime()).(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	}
+
+	if h, ok := interface{}(m.GetLastSuccessTime()).(clone.Cloner); ok {
+		target.LastSuccessTime = h.Clone().(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	} else {
+		target.LastSuccessTime = proto.Clone(m.GetLastSuccessTime()).(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	}
+
+	target.Error = m.GetError()
+
+	target.ConsecutiveFailures = m.GetConsecutiveFailures()
+
+	if h, ok := interface{}(m.GetNextAttemptTime()).(clone.Cloner); ok {
+		target.NextAttemptTime = h.Clone().(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	} else {
+		target.NextAttemptTime = proto.Clone(m.GetNextAttemptTime()).(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	}
+
 	return target
 }
 
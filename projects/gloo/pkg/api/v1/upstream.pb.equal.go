@@ -167,6 +167,16 @@ func (m *Upstream) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetUseHttp3()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUseHttp3()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUseHttp3(), target.GetUseHttp3()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetInitialStreamWindowSize()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetInitialStreamWindowSize()) {
 			return false
@@ -254,6 +264,26 @@ func (m *Upstream) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetProxyProtocol()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetProxyProtocol()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetProxyProtocol(), target.GetProxyProtocol()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetPreferSameZone()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPreferSameZone()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPreferSameZone(), target.GetPreferSameZone()) {
+			return false
+		}
+	}
+
 	switch m.UpstreamType.(type) {
 
 	case *Upstream_Kube:
@@ -403,6 +433,82 @@ func (m *DiscoveryMetadata) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetFunctionDiscoveryStatus()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFunctionDiscoveryStatus()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFunctionDiscoveryStatus(), target.GetFunctionDiscoveryStatus()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *FunctionDiscoveryStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*FunctionDiscoveryStatus)
+	if !ok {
+		that2, ok := that.(FunctionDiscoveryStatus)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetFunctionType(), target.GetFunctionType()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetLastAttemptTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLastAttemptTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLastAttemptTime(), target.GetLastAttemptTime()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetLastSuccessTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLastSuccessTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLastSuccessTime(), target.GetLastSuccessTime()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetError(), target.GetError()) != 0 {
+		return false
+	}
+
+	if m.GetConsecutiveFailures() != target.GetConsecutiveFailures() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetNextAttemptTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNextAttemptTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNextAttemptTime(), target.GetNextAttemptTime()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_retry_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -12,6 +13,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
@@ -22,6 +25,21 @@ var (
 
 const (
 	ExtensionName = "basic_route"
+
+	PreviousHostsPredicateName = "envoy.retry_host_predicates.previous_hosts"
+)
+
+var (
+	HedgingWithShadowingError = errors.Errorf("hedging on per try timeout cannot be combined with shadowing, " +
+		"as the shadowed requests would not be hedged")
+	HedgingWithoutPerTryTimeoutError = errors.Errorf("hedging on per try timeout requires a retry policy with " +
+		"a per try timeout on the route or on its virtual host")
+	InvalidRedirectResponseCodeError = func(code uint32) error {
+		return errors.Errorf("internal redirect response code %d is not one of 301, 302, 303, 307 or 308", code)
+	}
+
+	// the response codes for which envoy can follow an internal redirect
+	validRedirectResponseCodes = map[uint32]struct{}{301: {}, 302: {}, 303: {}, 307: {}, 308: {}}
 )
 
 // Handles a RoutePlugin APIs which map directly to basic Envoy config
@@ -46,7 +64,7 @@ func (p *plugin) ProcessVirtualHost(
 	if in.GetOptions() == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params.Ctx, in, out)
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -74,7 +92,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyMaxStreamDuration(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyRetries(params.Ctx, in, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(params.Ctx, in, out); err != nil {
@@ -83,6 +101,12 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyUpgrades(in, out); err != nil {
 		return err
 	}
+	if err := applyHedgePolicy(params, in, out); err != nil {
+		return err
+	}
+	if err := applyInternalRedirectPolicy(in, out); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -178,7 +202,7 @@ func applyMaxStreamDuration(in *v1.Route, out *envoy_config_route_v3.Route) erro
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
+func applyRetries(ctx context.Context, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	policy := in.GetOptions().GetRetries()
 	if policy == nil {
 		return nil
@@ -193,7 +217,7 @@ func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	}
 
 	var err error
-	routeAction.Route.RetryPolicy, err = convertPolicy(policy)
+	routeAction.Route.RetryPolicy, err = convertPolicy(ctx, policy)
 	if err != nil {
 		return err
 	}
@@ -278,16 +302,79 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.GetUpgradeConfigs())
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
+func applyHedgePolicy(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	hedgePolicy := in.GetOptions().GetHedgePolicy()
+	if hedgePolicy == nil {
+		return nil
+	}
+	routeAction, ok := out.GetAction().(*envoy_config_route_v3.Route_Route)
+	if !ok {
+		return errors.Errorf("hedge policy is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified a hedge policy, but output Envoy object "+
+			"had nil route", in.GetAction())
+	}
+
+	if hedgePolicy.GetHedgeOnPerTryTimeout() {
+		if in.GetOptions().GetShadowing() != nil {
+			return HedgingWithShadowingError
+		}
+		// the retry policy of the route replaces the one of the virtual host
+		retryPolicy := in.GetOptions().GetRetries()
+		if retryPolicy == nil {
+			retryPolicy = params.VirtualHost.GetOptions().GetRetries()
+		}
+		if retryPolicy.GetPerTryTimeout() == nil {
+			return HedgingWithoutPerTryTimeoutError
+		}
+	}
+
+	routeAction.Route.HedgePolicy = &envoy_config_route_v3.HedgePolicy{
+		HedgeOnPerTryTimeout: hedgePolicy.GetHedgeOnPerTryTimeout(),
+	}
+	return nil
+}
+
+func applyInternalRedirectPolicy(in *v1.Route, out *envoy_config_route_v3.Route) error {
+	policy := in.GetOptions().GetInternalRedirectPolicy()
+	if policy == nil {
+		return nil
+	}
+	routeAction, ok := out.GetAction().(*envoy_config_route_v3.Route_Route)
+	if !ok {
+		return errors.Errorf("internal redirect policy is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified an internal redirect policy, but output Envoy object "+
+			"had nil route", in.GetAction())
+	}
+
+	// envoy silently ignores the codes it cannot redirect, reject them instead
+	for _, code := range policy.GetRedirectResponseCodes() {
+		if _, ok := validRedirectResponseCodes[code]; !ok {
+			return InvalidRedirectResponseCodeError(code)
+		}
+	}
+
+	routeAction.Route.InternalRedirectPolicy = &envoy_config_route_v3.InternalRedirectPolicy{
+		MaxInternalRedirects:     policy.GetMaxInternalRedirects(),
+		RedirectResponseCodes:    policy.GetRedirectResponseCodes(),
+		AllowCrossSchemeRedirect: policy.GetAllowCrossSchemeRedirect(),
+	}
+	return routeAction.Route.GetInternalRedirectPolicy().Validate()
+}
+
+func applyRetriesVhost(ctx context.Context, in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
 	var err error
-	out.RetryPolicy, err = convertPolicy(in.GetOptions().GetRetries())
+	out.RetryPolicy, err = convertPolicy(ctx, in.GetOptions().GetRetries())
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
 	if policy == nil {
 		return nil, nil
 	}
@@ -297,10 +384,17 @@ func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPol
 		numRetries = 1
 	}
 
-	v3RetryPolicyBackOff := &envoy_config_route_v3.RetryPolicy_RetryBackOff{}
+	out := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:                       policy.GetRetryOn(),
+		NumRetries:                    &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout:                 policy.GetPerTryTimeout(),
+		RetriableHeaders:              translator.EnvoyHeaderMatcher(ctx, policy.GetRetriableHeaders()),
+		HostSelectionRetryMaxAttempts: policy.GetHostSelectionRetryMaxAttempts(),
+	}
 
 	// Let's make some checks
 	if retryPolicyInterval := policy.GetRetryBackOff(); retryPolicyInterval != nil {
+		v3RetryPolicyBackOff := &envoy_config_route_v3.RetryPolicy_RetryBackOff{}
 
 		baseInterval := retryPolicyInterval.GetBaseInterval()
 		maxInterval := retryPolicyInterval.GetMaxInterval()
@@ -344,18 +438,49 @@ func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPol
 			}
 		}
 
-		// If max and/or/both base intervals are defined, return a RetryPolicy object that contains them
-		return &envoy_config_route_v3.RetryPolicy{
-			RetryOn:       policy.GetRetryOn(),
-			NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-			PerTryTimeout: policy.GetPerTryTimeout(),
-			RetryBackOff:  v3RetryPolicyBackOff,
-		}, nil
+		out.RetryBackOff = v3RetryPolicyBackOff
+	}
+
+	for _, code := range policy.GetRetriableStatusCodes() {
+		if code < 100 || code >= 600 {
+			return nil, errors.Errorf("retriable status code %d is not a valid HTTP status code", code)
+		}
+		out.RetriableStatusCodes = append(out.RetriableStatusCodes, code)
+	}
+
+	if policy.GetHostSelectionRetryMaxAttempts() < 0 {
+		return nil, errors.Errorf("host selection retry max attempts must not be negative | you provided: %d",
+			policy.GetHostSelectionRetryMaxAttempts())
+	}
+
+	if policy.GetRetryPreviousHosts() {
+		typedConfig, err := utils.MessageToAny(&envoy_retry_previous_hosts_v3.PreviousHostsPredicate{})
+		if err != nil {
+			return nil, err
+		}
+		out.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+			Name: PreviousHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		}}
+	}
+
+	if rateLimitedBackOff := policy.GetRateLimitedRetryBackOff(); rateLimitedBackOff != nil {
+		out.RateLimitedRetryBackOff = &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+			MaxInterval: rateLimitedBackOff.GetMaxInterval(),
+		}
+		for _, resetHeader := range rateLimitedBackOff.GetResetHeaders() {
+			out.GetRateLimitedRetryBackOff().ResetHeaders = append(out.GetRateLimitedRetryBackOff().GetResetHeaders(),
+				&envoy_config_route_v3.RetryPolicy_ResetHeader{
+					Name:   resetHeader.GetName(),
+					Format: envoy_config_route_v3.RetryPolicy_ResetHeaderFormat(resetHeader.GetFormat()),
+				})
+		}
+		if err := out.GetRateLimitedRetryBackOff().Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	return &envoy_config_route_v3.RetryPolicy{
-		RetryOn:       policy.GetRetryOn(),
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: policy.GetPerTryTimeout(),
-	}, nil
+	return out, nil
 }
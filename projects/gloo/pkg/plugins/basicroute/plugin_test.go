@@ -12,17 +12,24 @@ import (
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_retry_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/internal_redirect"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	test_matchers "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("prefix rewrite", func() {
@@ -490,6 +497,119 @@ var _ = Describe("retries with both intervals", func() {
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
 })
+
+var _ = Describe("retries with retriable responses and host predicates", func() {
+	var (
+		retryPolicy         *retries.RetryPolicy
+		expectedRetryPolicy *envoy_config_route_v3.RetryPolicy
+	)
+
+	BeforeEach(func() {
+		previousHosts, err := utils.MessageToAny(&envoy_retry_previous_hosts_v3.PreviousHostsPredicate{})
+		Expect(err).NotTo(HaveOccurred())
+
+		retryPolicy = &retries.RetryPolicy{
+			RetryOn:              "retriable-status-codes,retriable-headers",
+			RetriableStatusCodes: []uint32{409, 503},
+			RetriableHeaders: []*matchers.HeaderMatcher{{
+				Name:  "x-retry",
+				Value: "true",
+			}},
+			RetryPreviousHosts:            true,
+			HostSelectionRetryMaxAttempts: 3,
+			RateLimitedRetryBackOff: &retries.RateLimitedRetryBackOff{
+				ResetHeaders: []*retries.RateLimitedRetryBackOff_ResetHeader{{
+					Name: "Retry-After",
+				}, {
+					Name:   "X-RateLimit-Reset",
+					Format: retries.RateLimitedRetryBackOff_ResetHeader_UNIX_TIMESTAMP,
+				}},
+				MaxInterval: durationpb.New(time.Minute),
+			},
+		}
+		expectedRetryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn: "retriable-status-codes,retriable-headers",
+			NumRetries: &wrappers.UInt32Value{
+				Value: 1,
+			},
+			RetriableStatusCodes: []uint32{409, 503},
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{{
+				Name: "x-retry",
+				HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+					ExactMatch: "true",
+				},
+			}},
+			RetryHostPredicate: []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+				Name: PreviousHostsPredicateName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+					TypedConfig: previousHosts,
+				},
+			}},
+			HostSelectionRetryMaxAttempts: 3,
+			RateLimitedRetryBackOff: &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+				ResetHeaders: []*envoy_config_route_v3.RetryPolicy_ResetHeader{{
+					Name: "Retry-After",
+				}, {
+					Name:   "X-RateLimit-Reset",
+					Format: envoy_config_route_v3.RetryPolicy_UNIX_TIMESTAMP,
+				}},
+				MaxInterval: durationpb.New(time.Minute),
+			},
+		}
+	})
+
+	It("works", func() {
+		plugin := NewPlugin()
+		routeAction := &envoy_config_route_v3.RouteAction{}
+		out := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Retries: retryPolicy,
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.RetryPolicy).To(test_matchers.MatchProto(expectedRetryPolicy))
+	})
+
+	It("works on vhost", func() {
+		plugin := NewPlugin()
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.RetryPolicy).To(test_matchers.MatchProto(expectedRetryPolicy))
+	})
+
+	It("errors on invalid status codes", func() {
+		retryPolicy.RetriableStatusCodes = []uint32{42}
+		plugin := NewPlugin()
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, &envoy_config_route_v3.VirtualHost{})
+		Expect(err).To(MatchError(ContainSubstring("retriable status code 42 is not a valid HTTP status code")))
+	})
+
+	It("errors when the rate limited backoff has no reset headers", func() {
+		retryPolicy.RateLimitedRetryBackOff.ResetHeaders = nil
+		plugin := NewPlugin()
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, &envoy_config_route_v3.VirtualHost{})
+		Expect(err).To(HaveOccurred())
+	})
+})
 var _ = Describe("host rewrite", func() {
 	It("rewrites using provided string", func() {
 
@@ -712,3 +832,125 @@ var _ = Describe("upgrades", func() {
 		Expect(err).To(MatchError(ContainSubstring("upgrade config websocket is not unique")))
 	})
 })
+
+var _ = Describe("hedge policy", func() {
+	var (
+		p           plugins.RoutePlugin
+		routeAction *envoy_config_route_v3.RouteAction
+		out         *envoy_config_route_v3.Route
+		options     *v1.RouteOptions
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		routeAction = &envoy_config_route_v3.RouteAction{}
+		out = &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+		options = &v1.RouteOptions{
+			Retries: &retries.RetryPolicy{
+				RetryOn:       "5xx",
+				PerTryTimeout: prototime.DurationToProto(time.Second),
+			},
+			HedgePolicy: &retries.HedgePolicy{HedgeOnPerTryTimeout: true},
+		}
+	})
+
+	It("works", func() {
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: options,
+			Action:  &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.GetHedgePolicy()).To(test_matchers.MatchProto(&envoy_config_route_v3.HedgePolicy{
+			HedgeOnPerTryTimeout: true,
+		}))
+	})
+
+	It("uses the per try timeout of the virtual host", func() {
+		vhostRetries := options.GetRetries()
+		options.Retries = nil
+		err := p.ProcessRoute(plugins.RouteParams{
+			VirtualHost: &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{Retries: vhostRetries},
+			},
+		}, &v1.Route{
+			Options: options,
+			Action:  &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.GetHedgePolicy().GetHedgeOnPerTryTimeout()).To(BeTrue())
+	})
+
+	It("errors without a per try timeout", func() {
+		options.GetRetries().PerTryTimeout = nil
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: options,
+			Action:  &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).To(MatchError(HedgingWithoutPerTryTimeoutError))
+	})
+
+	It("errors when combined with shadowing", func() {
+		options.Shadowing = &shadowing.RouteShadowing{
+			Upstream:   &core.ResourceRef{Name: "shadow", Namespace: "default"},
+			Percentage: 100,
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: options,
+			Action:  &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).To(MatchError(HedgingWithShadowingError))
+	})
+})
+
+var _ = Describe("internal redirect policy", func() {
+	var (
+		p           plugins.RoutePlugin
+		routeAction *envoy_config_route_v3.RouteAction
+		out         *envoy_config_route_v3.Route
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		routeAction = &envoy_config_route_v3.RouteAction{}
+		out = &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+	})
+
+	It("works", func() {
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				InternalRedirectPolicy: &internal_redirect.InternalRedirectPolicy{
+					MaxInternalRedirects:     &wrappers.UInt32Value{Value: 3},
+					RedirectResponseCodes:    []uint32{301, 307},
+					AllowCrossSchemeRedirect: true,
+				},
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.GetInternalRedirectPolicy()).To(test_matchers.MatchProto(&envoy_config_route_v3.InternalRedirectPolicy{
+			MaxInternalRedirects:     &wrappers.UInt32Value{Value: 3},
+			RedirectResponseCodes:    []uint32{301, 307},
+			AllowCrossSchemeRedirect: true,
+		}))
+	})
+
+	It("errors on response codes which cannot be redirected", func() {
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				InternalRedirectPolicy: &internal_redirect.InternalRedirectPolicy{
+					RedirectResponseCodes: []uint32{302, 304},
+				},
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).To(MatchError(InvalidRedirectResponseCodeError(304).Error()))
+	})
+})
@@ -0,0 +1,312 @@
+package compression
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstd "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "compression"
+
+	// Compressor not in wellknown names
+	CompressorFilterName = "envoy.filters.http.compressor"
+	GzipLibrary          = "envoy.compression.gzip.compressor"
+	BrotliLibrary        = "envoy.compression.brotli.compressor"
+	ZstdLibrary          = "envoy.compression.zstd.compressor"
+)
+
+// filter should be called during the final stage on the response path, to ensure
+// compression happens after any transformations. this means that we need to put it
+// in the first stage of the request path (since filters are executed in the reverse
+// order on the response path)
+var pluginStage = plugins.DuringStage(plugins.FaultStage)
+
+var (
+	MissingLibraryError   = eris.New("compressor must specify a compression library")
+	DuplicateLibraryError = func(library string) error {
+		return eris.Errorf("only one %s compressor can be configured per listener", library)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if listener.GetOptions().GetCompression() == nil {
+		return legacyGzipFilters(listener.GetOptions().GetGzip())
+	}
+
+	var filters []plugins.StagedHttpFilter
+	seenLibraries := map[string]struct{}{}
+	for _, compressor := range listener.GetOptions().GetCompression().GetCompressors() {
+		library, envoyCompressor, err := glooToEnvoyCompressor(compressor)
+		if err != nil {
+			return nil, eris.Wrapf(err, "converting compression config")
+		}
+		if _, ok := seenLibraries[library]; ok {
+			return nil, DuplicateLibraryError(library)
+		}
+		seenLibraries[library] = struct{}{}
+
+		filter, err := plugins.NewStagedFilter(FilterName(library), envoyCompressor, pluginStage)
+		if err != nil {
+			return nil, eris.Wrapf(err, "generating filter config")
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !in.GetOptions().GetCompression().GetDisabled() {
+		return nil
+	}
+
+	filterNames, err := compressorFilterNames(params.HttpListener)
+	if err != nil {
+		return err
+	}
+
+	for _, filterName := range filterNames {
+		perRoute := &envoycompressor.CompressorPerRoute{
+			Override: &envoycompressor.CompressorPerRoute_Disabled{
+				Disabled: true,
+			},
+		}
+		if err := pluginutils.SetRoutePerFilterConfig(out, filterName, perRoute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FilterName returns the name of the compressor filter using the given compression library.
+// Each library gets its own filter, so that the compressors can be disabled on a per-route basis.
+func FilterName(library string) string {
+	switch library {
+	case GzipLibrary:
+		return CompressorFilterName + ".gzip"
+	case BrotliLibrary:
+		return CompressorFilterName + ".brotli"
+	case ZstdLibrary:
+		return CompressorFilterName + ".zstd"
+	}
+	return CompressorFilterName
+}
+
+// compressorFilterNames returns the names of the compressor filters of the listener. The filter of the
+// deprecated gzip setting keeps the name it had before the compression setting was introduced.
+func compressorFilterNames(listener *v1.HttpListener) ([]string, error) {
+	if compressionConfig := listener.GetOptions().GetCompression(); compressionConfig != nil {
+		var filterNames []string
+		for _, compressor := range compressionConfig.GetCompressors() {
+			library, err := libraryName(compressor)
+			if err != nil {
+				return nil, err
+			}
+			filterNames = append(filterNames, FilterName(library))
+		}
+		return filterNames, nil
+	}
+
+	if listener.GetOptions().GetGzip() != nil {
+		return []string{CompressorFilterName}, nil
+	}
+
+	return nil, nil
+}
+
+// legacyGzipFilters returns the compressor filter of the deprecated gzip setting, which is only used
+// when compression is not set. The setting is converted into a gzip compressor, whose filter keeps the
+// deprecated fields it had before the compression setting was introduced, so that existing listeners are not changed.
+func legacyGzipFilters(gzipConfig *v2.Gzip) ([]plugins.StagedHttpFilter, error) {
+	if gzipConfig == nil {
+		return nil, nil
+	}
+
+	compressor, err := legacyGzipToCompressor(gzipConfig)
+	if err != nil {
+		return nil, eris.Wrapf(err, "converting gzip config")
+	}
+	_, envoyCompressor, err := glooToEnvoyCompressor(compressor)
+	if err != nil {
+		return nil, eris.Wrapf(err, "converting gzip config")
+	}
+	responseConfig := envoyCompressor.GetResponseDirectionConfig()
+	envoyCompressor.ResponseDirectionConfig = nil
+	envoyCompressor.ContentLength = responseConfig.GetCommonConfig().GetMinContentLength()
+	envoyCompressor.ContentType = responseConfig.GetCommonConfig().GetContentType()
+	envoyCompressor.DisableOnEtagHeader = responseConfig.GetDisableOnEtagHeader()
+	envoyCompressor.RemoveAcceptEncodingHeader = responseConfig.GetRemoveAcceptEncodingHeader()
+
+	gzipFilter, err := plugins.NewStagedFilter(CompressorFilterName, envoyCompressor, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{gzipFilter}, nil
+}
+
+func legacyGzipToCompressor(gzip *v2.Gzip) (*compression.Compressor, error) {
+	compressionGzip := &compression.Gzip{
+		MemoryLevel: gzip.GetMemoryLevel(),
+		WindowBits:  gzip.GetWindowBits(),
+	}
+
+	switch gzip.GetCompressionLevel() {
+	case v2.Gzip_CompressionLevel_DEFAULT:
+		// the default compression level of envoy
+	case v2.Gzip_CompressionLevel_BEST:
+		compressionGzip.CompressionLevel = &wrappers.UInt32Value{Value: uint32(envoygzip.Gzip_BEST_COMPRESSION)}
+	case v2.Gzip_CompressionLevel_SPEED:
+		compressionGzip.CompressionLevel = &wrappers.UInt32Value{Value: uint32(envoygzip.Gzip_BEST_SPEED)}
+	default:
+		return nil, eris.Errorf("invalid CompressionLevel %v", gzip.GetCompressionLevel())
+	}
+
+	switch gzip.GetCompressionStrategy() {
+	case v2.Gzip_DEFAULT:
+		compressionGzip.CompressionStrategy = compression.Gzip_DEFAULT
+	case v2.Gzip_FILTERED:
+		compressionGzip.CompressionStrategy = compression.Gzip_FILTERED
+	case v2.Gzip_HUFFMAN:
+		compressionGzip.CompressionStrategy = compression.Gzip_HUFFMAN
+	case v2.Gzip_RLE:
+		compressionGzip.CompressionStrategy = compression.Gzip_RLE
+	default:
+		return nil, eris.Errorf("invalid CompressionStrategy %v", gzip.GetCompressionStrategy())
+	}
+
+	// ChunkSize field isn't used in gloo v2 gzip so it should always be nil
+
+	return &compression.Compressor{
+		Library:                    &compression.Compressor_Gzip{Gzip: compressionGzip},
+		MinContentLength:           gzip.GetContentLength(),
+		ContentType:                gzip.GetContentType(),
+		DisableOnEtagHeader:        gzip.GetDisableOnEtagHeader(),
+		RemoveAcceptEncodingHeader: gzip.GetRemoveAcceptEncodingHeader(),
+	}, nil
+}
+
+func glooToEnvoyCompressor(compressor *compression.Compressor) (string, *envoycompressor.Compressor, error) {
+	library, err := libraryName(compressor)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var libraryConfig proto.Message
+	switch typed := compressor.GetLibrary().(type) {
+	case *compression.Compressor_Gzip:
+		libraryConfig, err = glooToEnvoyGzip(typed.Gzip)
+	case *compression.Compressor_Brotli:
+		libraryConfig, err = glooToEnvoyBrotli(typed.Brotli)
+	case *compression.Compressor_Zstd:
+		libraryConfig, err = glooToEnvoyZstd(typed.Zstd)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	typedConfig, err := utils.MessageToAny(libraryConfig)
+	if err != nil {
+		return "", nil, err
+	}
+
+	envoyCompressor := &envoycompressor.Compressor{
+		CompressorLibrary: &envoy_config_core_v3.TypedExtensionConfig{
+			Name:        library,
+			TypedConfig: typedConfig,
+		},
+		ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+				MinContentLength: compressor.GetMinContentLength(),
+				ContentType:      compressor.GetContentType(),
+			},
+			DisableOnEtagHeader:        compressor.GetDisableOnEtagHeader(),
+			RemoveAcceptEncodingHeader: compressor.GetRemoveAcceptEncodingHeader(),
+		},
+	}
+
+	return library, envoyCompressor, envoyCompressor.Validate()
+}
+
+func libraryName(compressor *compression.Compressor) (string, error) {
+	switch compressor.GetLibrary().(type) {
+	case *compression.Compressor_Gzip:
+		return GzipLibrary, nil
+	case *compression.Compressor_Brotli:
+		return BrotliLibrary, nil
+	case *compression.Compressor_Zstd:
+		return ZstdLibrary, nil
+	}
+	return "", MissingLibraryError
+}
+
+func glooToEnvoyGzip(gzip *compression.Gzip) (*envoygzip.Gzip, error) {
+	envoyGzip := &envoygzip.Gzip{
+		MemoryLevel:         gzip.GetMemoryLevel(),
+		CompressionStrategy: envoygzip.Gzip_CompressionStrategy(gzip.GetCompressionStrategy()),
+		WindowBits:          gzip.GetWindowBits(),
+		ChunkSize:           gzip.GetChunkSize(),
+	}
+
+	if gzip.GetCompressionLevel() != nil {
+		envoyGzip.CompressionLevel = envoygzip.Gzip_CompressionLevel(gzip.GetCompressionLevel().GetValue())
+	}
+
+	return envoyGzip, envoyGzip.Validate()
+}
+
+func glooToEnvoyBrotli(brotli *compression.Brotli) (*envoybrotli.Brotli, error) {
+	envoyBrotli := &envoybrotli.Brotli{
+		Quality:                       brotli.GetQuality(),
+		EncoderMode:                   envoybrotli.Brotli_EncoderMode(brotli.GetEncoderMode()),
+		WindowBits:                    brotli.GetWindowBits(),
+		InputBlockBits:                brotli.GetInputBlockBits(),
+		ChunkSize:                     brotli.GetChunkSize(),
+		DisableLiteralContextModeling: brotli.GetDisableLiteralContextModeling(),
+	}
+
+	return envoyBrotli, envoyBrotli.Validate()
+}
+
+func glooToEnvoyZstd(zstd *compression.Zstd) (*envoyzstd.Zstd, error) {
+	envoyZstd := &envoyzstd.Zstd{
+		CompressionLevel: zstd.GetCompressionLevel(),
+		EnableChecksum:   zstd.GetEnableChecksum(),
+		Strategy:         envoyzstd.Zstd_Strategy(zstd.GetStrategy()),
+		ChunkSize:        zstd.GetChunkSize(),
+	}
+
+	return envoyZstd, envoyZstd.Validate()
+}
@@ -0,0 +1,381 @@
+package compression_test
+
+import (
+	v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstd "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	expectedFilter := func(library string, libraryConfig proto.Message, compressorConfig *envoycompressor.Compressor) plugins.StagedHttpFilter {
+		typedConfig, err := utils.MessageToAny(libraryConfig)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		compressorConfig.CompressorLibrary = &v3.TypedExtensionConfig{
+			Name:        library,
+			TypedConfig: typedConfig,
+		}
+		if compressorConfig.ResponseDirectionConfig == nil {
+			compressorConfig.ResponseDirectionConfig = &envoycompressor.Compressor_ResponseDirectionConfig{}
+		}
+		if compressorConfig.ResponseDirectionConfig.CommonConfig == nil {
+			compressorConfig.ResponseDirectionConfig.CommonConfig = &envoycompressor.Compressor_CommonDirectionConfig{}
+		}
+		typedConfig, err = utils.MessageToAny(compressorConfig)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return plugins.StagedHttpFilter{
+			HttpFilter: &envoyhcm.HttpFilter{
+				Name: FilterName(library),
+				ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+					TypedConfig: typedConfig,
+				},
+			},
+			Stage: plugins.DuringStage(plugins.FaultStage),
+		}
+	}
+
+	// the filter of the deprecated gzip setting is translated as it was before the compression setting
+	legacyGzipFilter := func(gzipConfig *envoygzip.Gzip, compressorConfig *envoycompressor.Compressor) plugins.StagedHttpFilter {
+		typedConfig, err := utils.MessageToAny(gzipConfig)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		compressorConfig.CompressorLibrary = &v3.TypedExtensionConfig{
+			Name:        GzipLibrary,
+			TypedConfig: typedConfig,
+		}
+		typedConfig, err = utils.MessageToAny(compressorConfig)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return plugins.StagedHttpFilter{
+			HttpFilter: &envoyhcm.HttpFilter{
+				Name: "envoy.filters.http.compressor",
+				ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+					TypedConfig: typedConfig,
+				},
+			},
+			Stage: plugins.DuringStage(plugins.FaultStage),
+		}
+	}
+
+	Context("gzip", func() {
+
+		It("copies the gzip config from the listener to the filter", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{
+						MemoryLevel: &wrappers.UInt32Value{
+							Value: 9,
+						},
+						CompressionLevel:    v2.Gzip_CompressionLevel_SPEED,
+						CompressionStrategy: v2.Gzip_HUFFMAN,
+						WindowBits: &wrappers.UInt32Value{
+							Value: 10,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+				legacyGzipFilter(&envoygzip.Gzip{
+					MemoryLevel:         &wrappers.UInt32Value{Value: 9},
+					CompressionLevel:    envoygzip.Gzip_BEST_SPEED,
+					CompressionStrategy: envoygzip.Gzip_HUFFMAN_ONLY,
+					WindowBits:          &wrappers.UInt32Value{Value: 10},
+				}, &envoycompressor.Compressor{}),
+			}))
+
+			By("with correct defaults")
+			filters, err = NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+				legacyGzipFilter(&envoygzip.Gzip{
+					CompressionLevel:    envoygzip.Gzip_DEFAULT_COMPRESSION,
+					CompressionStrategy: envoygzip.Gzip_DEFAULT_STRATEGY,
+				}, &envoycompressor.Compressor{}),
+			}))
+		})
+
+		It("maps every compression level and strategy of the gzip config", func() {
+			for _, tc := range []struct {
+				level            v2.Gzip_CompressionLevel_Enum
+				strategy         v2.Gzip_CompressionStrategy
+				expectedLevel    envoygzip.Gzip_CompressionLevel
+				expectedStrategy envoygzip.Gzip_CompressionStrategy
+			}{
+				{v2.Gzip_CompressionLevel_BEST, v2.Gzip_FILTERED, envoygzip.Gzip_BEST_COMPRESSION, envoygzip.Gzip_FILTERED},
+				{v2.Gzip_CompressionLevel_SPEED, v2.Gzip_RLE, envoygzip.Gzip_BEST_SPEED, envoygzip.Gzip_RLE},
+			} {
+				filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+					Options: &v1.HttpListenerOptions{
+						Gzip: &v2.Gzip{
+							CompressionLevel:    tc.level,
+							CompressionStrategy: tc.strategy,
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+					legacyGzipFilter(&envoygzip.Gzip{
+						CompressionLevel:    tc.expectedLevel,
+						CompressionStrategy: tc.expectedStrategy,
+					}, &envoycompressor.Compressor{}),
+				}))
+			}
+		})
+
+		It("copies the gzip configs from the listener to the compressor filter", func() {
+			By("when all deprecated fields are present")
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{
+						ContentLength: &wrappers.UInt32Value{
+							Value: 10,
+						},
+						ContentType:                []string{"type1", "type2"},
+						DisableOnEtagHeader:        true,
+						RemoveAcceptEncodingHeader: true,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+				legacyGzipFilter(&envoygzip.Gzip{}, &envoycompressor.Compressor{
+					ContentLength:              &wrappers.UInt32Value{Value: 10},
+					ContentType:                []string{"type1", "type2"},
+					DisableOnEtagHeader:        true,
+					RemoveAcceptEncodingHeader: true,
+				}),
+			}))
+
+			By("copies a single gzip config field")
+			filters, err = NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{
+						RemoveAcceptEncodingHeader: true,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+				legacyGzipFilter(&envoygzip.Gzip{}, &envoycompressor.Compressor{
+					RemoveAcceptEncodingHeader: true,
+				}),
+			}))
+		})
+
+		It("errors copying the gzip config from the listener to the filter when an invalid enum value is used", func() {
+			By("CompressionLevel")
+			_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{
+						CompressionLevel: 3,
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid CompressionLevel 3"))
+
+			By("CompressionStrategy")
+			_, err = NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{
+						CompressionStrategy: 4,
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid CompressionStrategy 4"))
+		})
+
+		It("ignores the gzip config when compression is set", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Gzip: &v2.Gzip{},
+					Compression: &compression.Compression{
+						Compressors: []*compression.Compressor{{
+							Library: &compression.Compressor_Brotli{Brotli: &compression.Brotli{}},
+						}},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName(BrotliLibrary)))
+		})
+	})
+
+	Context("compression", func() {
+
+		It("creates a compressor filter for each library", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Compression: &compression.Compression{
+						Compressors: []*compression.Compressor{
+							{
+								Library: &compression.Compressor_Gzip{
+									Gzip: &compression.Gzip{
+										CompressionLevel:    &wrappers.UInt32Value{Value: 6},
+										CompressionStrategy: compression.Gzip_FIXED,
+										ChunkSize:           &wrappers.UInt32Value{Value: 8192},
+									},
+								},
+								MinContentLength: &wrappers.UInt32Value{Value: 100},
+								ContentType:      []string{"application/json"},
+							},
+							{
+								Library: &compression.Compressor_Brotli{
+									Brotli: &compression.Brotli{
+										Quality:     &wrappers.UInt32Value{Value: 5},
+										EncoderMode: compression.Brotli_TEXT,
+									},
+								},
+								DisableOnEtagHeader: true,
+							},
+							{
+								Library: &compression.Compressor_Zstd{
+									Zstd: &compression.Zstd{
+										CompressionLevel: &wrappers.UInt32Value{Value: 10},
+										EnableChecksum:   true,
+										Strategy:         compression.Zstd_BTOPT,
+									},
+								},
+								RemoveAcceptEncodingHeader: true,
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+				expectedFilter(GzipLibrary, &envoygzip.Gzip{
+					CompressionLevel:    envoygzip.Gzip_COMPRESSION_LEVEL_6,
+					CompressionStrategy: envoygzip.Gzip_FIXED,
+					ChunkSize:           &wrappers.UInt32Value{Value: 8192},
+				}, &envoycompressor.Compressor{
+					ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+						CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+							MinContentLength: &wrappers.UInt32Value{Value: 100},
+							ContentType:      []string{"application/json"},
+						},
+					},
+				}),
+				expectedFilter(BrotliLibrary, &envoybrotli.Brotli{
+					Quality:     &wrappers.UInt32Value{Value: 5},
+					EncoderMode: envoybrotli.Brotli_TEXT,
+				}, &envoycompressor.Compressor{
+					ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+						DisableOnEtagHeader: true,
+					},
+				}),
+				expectedFilter(ZstdLibrary, &envoyzstd.Zstd{
+					CompressionLevel: &wrappers.UInt32Value{Value: 10},
+					EnableChecksum:   true,
+					Strategy:         envoyzstd.Zstd_BTOPT,
+				}, &envoycompressor.Compressor{
+					ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+						RemoveAcceptEncodingHeader: true,
+					},
+				}),
+			}))
+		})
+
+		It("errors when a library is configured twice", func() {
+			_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Compression: &compression.Compression{
+						Compressors: []*compression.Compressor{
+							{Library: &compression.Compressor_Gzip{Gzip: &compression.Gzip{}}},
+							{Library: &compression.Compressor_Gzip{Gzip: &compression.Gzip{}}},
+						},
+					},
+				},
+			})
+			Expect(err).To(MatchError(DuplicateLibraryError(GzipLibrary)))
+		})
+
+		It("errors when no library is configured", func() {
+			_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Compression: &compression.Compression{
+						Compressors: []*compression.Compressor{{}},
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(MissingLibraryError.Error()))
+		})
+	})
+
+	Context("per-route", func() {
+
+		var listener *v1.HttpListener
+
+		BeforeEach(func() {
+			listener = &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Compression: &compression.Compression{
+						Compressors: []*compression.Compressor{
+							{Library: &compression.Compressor_Gzip{Gzip: &compression.Gzip{}}},
+							{Library: &compression.Compressor_Brotli{Brotli: &compression.Brotli{}}},
+						},
+					},
+				},
+			}
+		})
+
+		processRoute := func(options *v1.RouteOptions) *envoy_config_route_v3.Route {
+			out := &envoy_config_route_v3.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{HttpListener: listener},
+			}, &v1.Route{Options: options}, out)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("disables every compressor of the listener", func() {
+			out := processRoute(&v1.RouteOptions{
+				Compression: &compression.CompressionPerRoute{Disabled: true},
+			})
+			Expect(out.GetTypedPerFilterConfig()).To(HaveLen(2))
+			for _, library := range []string{GzipLibrary, BrotliLibrary} {
+				var cfg envoycompressor.CompressorPerRoute
+				err := ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName(library)], &cfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.GetDisabled()).To(BeTrue())
+			}
+		})
+
+		It("disables the compressor of the gzip config", func() {
+			listener.Options = &v1.HttpListenerOptions{Gzip: &v2.Gzip{}}
+			out := processRoute(&v1.RouteOptions{
+				Compression: &compression.CompressionPerRoute{Disabled: true},
+			})
+			Expect(out.GetTypedPerFilterConfig()).To(HaveLen(1))
+			Expect(out.GetTypedPerFilterConfig()).To(HaveKey(CompressorFilterName))
+		})
+
+		It("does nothing when compression is not disabled", func() {
+			out := processRoute(&v1.RouteOptions{
+				Compression: &compression.CompressionPerRoute{},
+			})
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package connection_limit
+
+import (
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_filters_network_connection_limit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var (
+	_ plugins.Plugin         = new(plugin)
+	_ plugins.ListenerPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "connection_limit"
+	FilterName    = "envoy.filters.network.connection_limit"
+	StatPrefix    = "connection_limit"
+)
+
+var (
+	MissingMaxActiveConnectionsError = eris.New("max active connections per filter chain must be specified for connection limit")
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+// ProcessListener adds the connection limit filter to every filter chain of the listener.
+// Envoy counts the connections of each filter separately, so the limit applies to each filter chain.
+// The filter runs first, unless the filter chain starts with network RBAC filters: connections
+// which are rejected based on their source should not count towards the limit.
+func (p *plugin) ProcessListener(_ plugins.Params, in *v1.Listener, out *envoy_config_listener_v3.Listener) error {
+	connectionLimit := in.GetOptions().GetConnectionLimit()
+	if connectionLimit == nil {
+		return nil
+	}
+	if connectionLimit.GetMaxActiveConnectionsPerFilterChain() == nil {
+		return MissingMaxActiveConnectionsError
+	}
+
+	config := &envoy_extensions_filters_network_connection_limit_v3.ConnectionLimit{
+		StatPrefix:     StatPrefix,
+		MaxConnections: connectionLimit.GetMaxActiveConnectionsPerFilterChain(),
+		Delay:          connectionLimit.GetDelayBeforeClose(),
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	for _, filterChain := range out.GetFilterChains() {
+		filter, err := translator.NewFilterWithTypedConfig(FilterName, config)
+		if err != nil {
+			return eris.Wrap(err, "generating filter config")
+		}
+
+		idx := 0
+		for idx < len(filterChain.GetFilters()) && filterChain.GetFilters()[idx].GetName() == wellknown.RoleBasedAccessControl {
+			idx++
+		}
+		filters := make([]*envoy_config_listener_v3.Filter, 0, len(filterChain.GetFilters())+1)
+		filters = append(filters, filterChain.GetFilters()[:idx]...)
+		filters = append(filters, filter)
+		filterChain.Filters = append(filters, filterChain.GetFilters()[idx:]...)
+	}
+	return nil
+}
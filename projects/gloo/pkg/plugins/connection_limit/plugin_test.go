@@ -0,0 +1,120 @@
+package connection_limit_test
+
+import (
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_filters_network_connection_limit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/connection_limit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params   plugins.Params
+		listener *v1.Listener
+		out      *envoy_config_listener_v3.Listener
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{}
+		listener = &v1.Listener{
+			Options: &v1.ListenerOptions{
+				ConnectionLimit: &connection_limit.ConnectionLimit{
+					MaxActiveConnectionsPerFilterChain: &wrappers.UInt64Value{Value: 100},
+					DelayBeforeClose:                   prototime.DurationToProto(time.Second),
+				},
+			},
+		}
+		out = &envoy_config_listener_v3.Listener{
+			FilterChains: []*envoy_config_listener_v3.FilterChain{
+				{Filters: []*envoy_config_listener_v3.Filter{{Name: wellknown.HTTPConnectionManager}}},
+				{Filters: []*envoy_config_listener_v3.Filter{
+					{Name: wellknown.RoleBasedAccessControl},
+					{Name: wellknown.RoleBasedAccessControl},
+					{Name: wellknown.TCPProxy},
+				}},
+			},
+		}
+	})
+
+	It("adds the connection limit filter to every filter chain, after the network rbac filters", func() {
+		err := NewPlugin().ProcessListener(params, listener, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedConfig := &envoy_extensions_filters_network_connection_limit_v3.ConnectionLimit{
+			StatPrefix:     StatPrefix,
+			MaxConnections: &wrappers.UInt64Value{Value: 100},
+			Delay:          prototime.DurationToProto(time.Second),
+		}
+
+		httpFilters := out.GetFilterChains()[0].GetFilters()
+		Expect(httpFilters).To(HaveLen(2))
+		Expect(httpFilters[0].GetName()).To(Equal(FilterName))
+		config := &envoy_extensions_filters_network_connection_limit_v3.ConnectionLimit{}
+		Expect(translator.ParseTypedConfig(httpFilters[0], config)).NotTo(HaveOccurred())
+		Expect(config).To(matchers.MatchProto(expectedConfig))
+		Expect(httpFilters[1].GetName()).To(Equal(wellknown.HTTPConnectionManager))
+
+		tcpFilters := out.GetFilterChains()[1].GetFilters()
+		Expect(tcpFilters).To(HaveLen(4))
+		Expect(tcpFilters[0].GetName()).To(Equal(wellknown.RoleBasedAccessControl))
+		Expect(tcpFilters[1].GetName()).To(Equal(wellknown.RoleBasedAccessControl))
+		Expect(tcpFilters[2].GetName()).To(Equal(FilterName))
+		Expect(tcpFilters[3].GetName()).To(Equal(wellknown.TCPProxy))
+	})
+
+	It("limits the connections of each filter chain separately", func() {
+		out.FilterChains = append(out.FilterChains, &envoy_config_listener_v3.FilterChain{
+			Filters: []*envoy_config_listener_v3.Filter{{Name: wellknown.HTTPConnectionManager}},
+		})
+		err := NewPlugin().ProcessListener(params, listener, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		var configs []*envoy_extensions_filters_network_connection_limit_v3.ConnectionLimit
+		for _, filterChain := range out.GetFilterChains() {
+			for _, filter := range filterChain.GetFilters() {
+				if filter.GetName() != FilterName {
+					continue
+				}
+				config := &envoy_extensions_filters_network_connection_limit_v3.ConnectionLimit{}
+				Expect(translator.ParseTypedConfig(filter, config)).NotTo(HaveOccurred())
+				configs = append(configs, config)
+			}
+		}
+		// each of the 3 filter chains has its own filter, which allows the whole limit
+		Expect(configs).To(HaveLen(3))
+		for _, config := range configs {
+			Expect(config.GetMaxConnections().GetValue()).To(Equal(uint64(100)))
+		}
+	})
+
+	It("does nothing without a connection limit", func() {
+		err := NewPlugin().ProcessListener(params, &v1.Listener{}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetFilterChains()[0].GetFilters()).To(HaveLen(1))
+		Expect(out.GetFilterChains()[1].GetFilters()).To(HaveLen(3))
+	})
+
+	It("errors when the max active connections per filter chain are missing", func() {
+		listener.GetOptions().GetConnectionLimit().MaxActiveConnectionsPerFilterChain = nil
+		err := NewPlugin().ProcessListener(params, listener, out)
+		Expect(err).To(MatchError(MissingMaxActiveConnectionsError))
+	})
+
+	It("errors when the max active connections per filter chain are zero", func() {
+		listener.GetOptions().GetConnectionLimit().MaxActiveConnectionsPerFilterChain = &wrappers.UInt64Value{}
+		err := NewPlugin().ProcessListener(params, listener, out)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,171 @@
+package ext_proc
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/ext_proc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "ext_proc"
+	FilterName    = "envoy.filters.http.ext_proc"
+)
+
+// by default, external processing happens once the request passed all the checks
+var defaultFilterStage = plugins.DuringStage(plugins.AcceptedStage)
+
+var (
+	NoServerRefError    = eris.New("no ext_proc server reference configured")
+	ServerNotFoundError = func(usRef *core.ResourceRef) error {
+		return eris.Errorf("ext_proc server upstream not found %s", usRef.String())
+	}
+	NoListenerExtProcError = eris.New("ext_proc overrides on a route require external processing to be configured on the listener")
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	extProc := listener.GetOptions().GetExtProc()
+	if extProc == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	grpcService, err := translateGrpcService(params, extProc.GetGrpcService())
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_extensions_filters_http_ext_proc_v3.ExternalProcessor{
+		GrpcService:      grpcService,
+		FailureModeAllow: extProc.GetFailureModeAllow(),
+		ProcessingMode:   translateProcessingMode(extProc.GetProcessingMode()),
+		MessageTimeout:   extProc.GetMessageTimeout(),
+		StatPrefix:       extProc.GetStatPrefix(),
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	stage, err := plugins.TranslateFilterStage(extProc.GetFilterStage(), defaultFilterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, config, stage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	routeExtProc := in.GetOptions().GetExtProc()
+	if routeExtProc == nil {
+		return nil
+	}
+
+	// the per-route config is meaningless without the listener-level filter: disabling it is a no-op,
+	// but the overrides would be dropped
+	if params.HttpListener.GetOptions().GetExtProc() == nil {
+		if routeExtProc.GetOverrides() != nil {
+			return NoListenerExtProcError
+		}
+		return nil
+	}
+
+	perRoute := &envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute{}
+	switch override := routeExtProc.GetOverride().(type) {
+	case *ext_proc.RouteExtProc_Disabled:
+		perRoute.Override = &envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute_Disabled{
+			Disabled: override.Disabled,
+		}
+	case *ext_proc.RouteExtProc_Overrides:
+		overrides := &envoy_extensions_filters_http_ext_proc_v3.ExtProcOverrides{
+			ProcessingMode: translateProcessingMode(override.Overrides.GetProcessingMode()),
+		}
+		if override.Overrides.GetGrpcService() != nil {
+			grpcService, err := translateGrpcService(params.Params, override.Overrides.GetGrpcService())
+			if err != nil {
+				return err
+			}
+			overrides.GrpcService = grpcService
+		}
+		perRoute.Override = &envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute_Overrides{
+			Overrides: overrides,
+		}
+	}
+	if err := perRoute.Validate(); err != nil {
+		return err
+	}
+
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+}
+
+func translateGrpcService(params plugins.Params, in *ext_proc.GrpcService) (*envoy_config_core_v3.GrpcService, error) {
+	usRef := in.GetExtProcServerRef()
+	if usRef == nil {
+		return nil, NoServerRefError
+	}
+
+	// Make sure the server exists
+	if _, err := params.Snapshot.Upstreams.Find(usRef.GetNamespace(), usRef.GetName()); err != nil {
+		return nil, ServerNotFoundError(usRef)
+	}
+
+	out := &envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: translator.UpstreamToClusterName(usRef),
+				Authority:   in.GetAuthority(),
+			},
+		},
+		Timeout: in.GetTimeout(),
+	}
+	for _, metadata := range in.GetInitialMetadata() {
+		out.InitialMetadata = append(out.InitialMetadata, &envoy_config_core_v3.HeaderValue{
+			Key:   metadata.GetKey(),
+			Value: metadata.GetValue(),
+		})
+	}
+	return out, nil
+}
+
+func translateProcessingMode(in *ext_proc.ProcessingMode) *envoy_extensions_filters_http_ext_proc_v3.ProcessingMode {
+	if in == nil {
+		return nil
+	}
+
+	return &envoy_extensions_filters_http_ext_proc_v3.ProcessingMode{
+		RequestHeaderMode:   envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_HeaderSendMode(in.GetRequestHeaderMode()),
+		ResponseHeaderMode:  envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_HeaderSendMode(in.GetResponseHeaderMode()),
+		RequestBodyMode:     envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BodySendMode(in.GetRequestBodyMode()),
+		ResponseBodyMode:    envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BodySendMode(in.GetResponseBodyMode()),
+		RequestTrailerMode:  envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_HeaderSendMode(in.GetRequestTrailerMode()),
+		ResponseTrailerMode: envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_HeaderSendMode(in.GetResponseTrailerMode()),
+	}
+}
@@ -0,0 +1,233 @@
+package ext_proc_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/ext_proc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ext_proc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("ExtProc Plugin", func() {
+
+	var (
+		p            plugins.Plugin
+		params       plugins.Params
+		httpListener *v1.HttpListener
+
+		serverRef        *core.ResourceRef
+		grpcService      *ext_proc.GrpcService
+		envoyGrpcService *envoy_config_core_v3.GrpcService
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO()})
+		httpListener = &v1.HttpListener{}
+
+		serverRef = &core.ResourceRef{Name: "ext-proc", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Snapshot: &v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{
+					Metadata: &core.Metadata{Name: serverRef.GetName(), Namespace: serverRef.GetNamespace()},
+				}},
+			},
+		}
+
+		grpcService = &ext_proc.GrpcService{
+			ExtProcServerRef: serverRef,
+			Authority:        "ext-proc.example.com",
+			Timeout:          durationpb.New(time.Second),
+			InitialMetadata:  []*headers.HeaderValue{{Key: "x-source", Value: "gloo"}},
+		}
+		envoyGrpcService = &envoy_config_core_v3.GrpcService{
+			TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: translator.UpstreamToClusterName(serverRef),
+					Authority:   "ext-proc.example.com",
+				},
+			},
+			Timeout:         durationpb.New(time.Second),
+			InitialMetadata: []*envoy_config_core_v3.HeaderValue{{Key: "x-source", Value: "gloo"}},
+		}
+	})
+
+	processRoute := func(options *v1.RouteOptions) (*envoy_config_route_v3.Route, error) {
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{Params: params, HttpListener: httpListener},
+		}, &v1.Route{Options: options}, out)
+		return out, err
+	}
+
+	It("does not add the filter when external processing is not configured", func() {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the listener config into the filter config", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			ExtProc: &ext_proc.ExtProc{
+				GrpcService:      grpcService,
+				FailureModeAllow: true,
+				ProcessingMode: &ext_proc.ProcessingMode{
+					RequestHeaderMode: ext_proc.ProcessingMode_SEND,
+					RequestBodyMode:   ext_proc.ProcessingMode_BUFFERED,
+					ResponseBodyMode:  ext_proc.ProcessingMode_STREAMED,
+				},
+				MessageTimeout: durationpb.New(500 * time.Millisecond),
+				StatPrefix:     "my-ext-proc",
+			},
+		}
+
+		expectedFilter, err := plugins.NewStagedFilter(FilterName,
+			&envoy_extensions_filters_http_ext_proc_v3.ExternalProcessor{
+				GrpcService:      envoyGrpcService,
+				FailureModeAllow: true,
+				ProcessingMode: &envoy_extensions_filters_http_ext_proc_v3.ProcessingMode{
+					RequestHeaderMode: envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_SEND,
+					RequestBodyMode:   envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BUFFERED,
+					ResponseBodyMode:  envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_STREAMED,
+				},
+				MessageTimeout: durationpb.New(500 * time.Millisecond),
+				StatPrefix:     "my-ext-proc",
+			},
+			plugins.DuringStage(plugins.AcceptedStage),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter).To(matchers.MatchProto(expectedFilter.HttpFilter))
+		Expect(filters[0].Stage).To(Equal(expectedFilter.Stage))
+	})
+
+	It("places the filter at the configured stage", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			ExtProc: &ext_proc.ExtProc{
+				GrpcService: grpcService,
+				FilterStage: &wasm.FilterStage{
+					Stage:     wasm.FilterStage_AuthNStage,
+					Predicate: wasm.FilterStage_Before,
+				},
+			},
+		}
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.AuthNStage)))
+	})
+
+	It("errors when the server upstream does not exist", func() {
+		serverRef.Name = "missing"
+		httpListener.Options = &v1.HttpListenerOptions{
+			ExtProc: &ext_proc.ExtProc{GrpcService: grpcService},
+		}
+
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).To(MatchError(ServerNotFoundError(serverRef)))
+	})
+
+	It("errors when no server upstream is referenced", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			ExtProc: &ext_proc.ExtProc{GrpcService: &ext_proc.GrpcService{}},
+		}
+
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).To(MatchError(NoServerRefError))
+	})
+
+	Context("routes", func() {
+
+		BeforeEach(func() {
+			httpListener.Options = &v1.HttpListenerOptions{
+				ExtProc: &ext_proc.ExtProc{GrpcService: grpcService},
+			}
+		})
+
+		It("disables external processing on a route", func() {
+			out, err := processRoute(&v1.RouteOptions{
+				ExtProc: &ext_proc.RouteExtProc{
+					Override: &ext_proc.RouteExtProc_Disabled{Disabled: true},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetDisabled()).To(BeTrue())
+		})
+
+		It("overrides the processing mode and grpc service on a route", func() {
+			out, err := processRoute(&v1.RouteOptions{
+				ExtProc: &ext_proc.RouteExtProc{
+					Override: &ext_proc.RouteExtProc_Overrides{
+						Overrides: &ext_proc.Overrides{
+							ProcessingMode: &ext_proc.ProcessingMode{
+								ResponseHeaderMode: ext_proc.ProcessingMode_SKIP,
+							},
+							GrpcService: grpcService,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(&cfg).To(matchers.MatchProto(&envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute{
+				Override: &envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute_Overrides{
+					Overrides: &envoy_extensions_filters_http_ext_proc_v3.ExtProcOverrides{
+						ProcessingMode: &envoy_extensions_filters_http_ext_proc_v3.ProcessingMode{
+							ResponseHeaderMode: envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_SKIP,
+						},
+						GrpcService: envoyGrpcService,
+					},
+				},
+			}))
+		})
+
+		It("ignores a disabled route when the listener has no external processing", func() {
+			httpListener.Options = nil
+			out, err := processRoute(&v1.RouteOptions{
+				ExtProc: &ext_proc.RouteExtProc{
+					Override: &ext_proc.RouteExtProc_Disabled{Disabled: true},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+
+		It("errors on route overrides when the listener has no external processing", func() {
+			httpListener.Options = nil
+			_, err := processRoute(&v1.RouteOptions{
+				ExtProc: &ext_proc.RouteExtProc{
+					Override: &ext_proc.RouteExtProc_Overrides{
+						Overrides: &ext_proc.Overrides{GrpcService: grpcService},
+					},
+				},
+			})
+			Expect(err).To(MatchError(NoListenerExtProcError))
+		})
+	})
+})
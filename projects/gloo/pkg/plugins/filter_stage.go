@@ -0,0 +1,52 @@
+package plugins
+
+import (
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+)
+
+var InvalidFilterStageError = func(stage *wasm.FilterStage) error {
+	return eris.Errorf("invalid filter stage %v", stage)
+}
+
+// TranslateFilterStage converts the user-facing filter stage into the stage used to sort the filters.
+// The default stage is used if no filter stage is configured.
+func TranslateFilterStage(in *wasm.FilterStage, defaultStage FilterStage) (FilterStage, error) {
+	if in == nil {
+		return defaultStage, nil
+	}
+
+	var wellKnown WellKnownFilterStage
+	switch in.GetStage() {
+	case wasm.FilterStage_FaultStage:
+		wellKnown = FaultStage
+	case wasm.FilterStage_CorsStage:
+		wellKnown = CorsStage
+	case wasm.FilterStage_WafStage:
+		wellKnown = WafStage
+	case wasm.FilterStage_AuthNStage:
+		wellKnown = AuthNStage
+	case wasm.FilterStage_AuthZStage:
+		wellKnown = AuthZStage
+	case wasm.FilterStage_RateLimitStage:
+		wellKnown = RateLimitStage
+	case wasm.FilterStage_AcceptedStage:
+		wellKnown = AcceptedStage
+	case wasm.FilterStage_OutAuthStage:
+		wellKnown = OutAuthStage
+	case wasm.FilterStage_RouteStage:
+		wellKnown = RouteStage
+	default:
+		return FilterStage{}, InvalidFilterStageError(in)
+	}
+
+	switch in.GetPredicate() {
+	case wasm.FilterStage_During:
+		return DuringStage(wellKnown), nil
+	case wasm.FilterStage_Before:
+		return BeforeStage(wellKnown), nil
+	case wasm.FilterStage_After:
+		return AfterStage(wellKnown), nil
+	}
+	return FilterStage{}, InvalidFilterStageError(in)
+}
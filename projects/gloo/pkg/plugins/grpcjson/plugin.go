@@ -4,18 +4,28 @@ import (
 	"context"
 	"encoding/base64"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 )
 
 var (
 	_ plugins.Plugin           = new(plugin)
 	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.UpstreamPlugin   = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
 
 	NoConfigMapRefError = func() error {
 		return eris.Errorf("a configmap ref must be provided")
@@ -47,10 +57,15 @@ const (
 // filter info
 var pluginStage = plugins.BeforeStage(plugins.OutAuthStage)
 
-type plugin struct{}
+type plugin struct {
+	// the transcoder config of the upstreams with a grpc_json_transcoder service spec, by cluster name
+	upstreamTranscoders map[string]*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder
+}
 
 func NewPlugin() *plugin {
-	return &plugin{}
+	return &plugin{
+		upstreamTranscoders: make(map[string]*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder),
+	}
 }
 
 func (p *plugin) Name() string {
@@ -58,12 +73,73 @@ func (p *plugin) Name() string {
 }
 
 func (p *plugin) Init(_ plugins.InitParams) {
+	p.upstreamTranscoders = make(map[string]*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder)
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	upstreamType, ok := in.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+
+	transcoderWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_GrpcJsonTranscoder)
+	if !ok {
+		return nil
+	}
+
+	// the upstream serves grpc
+	if out.GetHttp2ProtocolOptions() == nil {
+		out.Http2ProtocolOptions = &envoy_config_core_v3.Http2ProtocolOptions{}
+	}
+
+	if len(transcoderWrapper.GrpcJsonTranscoder.GetServices()) == 0 {
+		// no services (yet), this just marks the upstream as a grpc one.
+		return nil
+	}
+
+	envoyGrpcJsonConf, err := translateGlooToEnvoyGrpcJson(params, transcoderWrapper.GrpcJsonTranscoder)
+	if err != nil {
+		return err
+	}
+	p.upstreamTranscoders[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = envoyGrpcJsonConf
+	return nil
+}
+
+// Routes to upstreams with a grpc_json_transcoder service spec transcode their requests with the config of the upstream.
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if len(p.upstreamTranscoders) == 0 {
+		return nil
+	}
+	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, wellknown.GRPCJSONTranscoder,
+		func(spec *v1.Destination) (proto.Message, error) {
+			upstreamRef, err := upstreams.DestinationToUpstreamRef(spec)
+			if err != nil {
+				return nil, err
+			}
+			envoyGrpcJsonConf, ok := p.upstreamTranscoders[translator.UpstreamToClusterName(upstreamRef)]
+			if !ok {
+				return nil, nil
+			}
+			return envoyGrpcJsonConf, nil
+		},
+	)
 }
 
 func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
 	grpcJsonConf := listener.GetOptions().GetGrpcJsonTranscoder()
 	if grpcJsonConf == nil {
-		return nil, nil
+		if !p.routesToUpstreamTranscoders(params, listener) {
+			return nil, nil
+		}
+		// the transcoder is configured on the routes to the upstreams, the listener filter has no services
+		// so that it is disabled for every other route.
+		grpcJsonFilter, err := plugins.NewStagedFilter(wellknown.GRPCJSONTranscoder, &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder{
+			DescriptorSet: &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{},
+		}, pluginStage)
+		if err != nil {
+			return nil, eris.Wrapf(err, "generating filter config")
+		}
+		return []plugins.StagedHttpFilter{grpcJsonFilter}, nil
 	}
 
 	envoyGrpcJsonConf, err := translateGlooToEnvoyGrpcJson(params, grpcJsonConf)
@@ -79,6 +155,28 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 	return []plugins.StagedHttpFilter{grpcJsonFilter}, nil
 }
 
+// routesToUpstreamTranscoders returns whether a route of the listener targets an upstream with a transcoder.
+func (p *plugin) routesToUpstreamTranscoders(params plugins.Params, listener *v1.HttpListener) bool {
+	if len(p.upstreamTranscoders) == 0 {
+		return false
+	}
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetRouteAction() == nil {
+				continue
+			}
+			// invalid destinations are reported when the route is translated
+			upstreamRefs, _ := pluginutils.DestinationUpstreams(params.Snapshot, route.GetRouteAction())
+			for _, upstreamRef := range upstreamRefs {
+				if _, ok := p.upstreamTranscoders[translator.UpstreamToClusterName(&upstreamRef)]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func translateGlooToEnvoyGrpcJson(params plugins.Params, grpcJsonConf *grpc_json.GrpcJsonTranscoder) (*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder, error) {
 
 	envoyGrpcJsonConf := &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder{
@@ -1,6 +1,11 @@
 package grpcjson_test
 
 import (
+	"context"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -8,12 +13,15 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/test/gomega/matchers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skmatchers "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("GrpcJson", func() {
@@ -217,4 +225,179 @@ var _ = Describe("GrpcJson", func() {
 		})
 	})
 
+	Context("upstream transcoder", func() {
+		var (
+			snap      *gloosnapshot.ApiSnapshot
+			upstream  *v1.Upstream
+			transcode *envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder
+		)
+
+		BeforeEach(func() {
+			snap = &gloosnapshot.ApiSnapshot{
+				Artifacts: v1.ArtifactList{
+					&v1.Artifact{
+						Metadata: &core.Metadata{
+							Name:      "grpc-upstream-descriptors",
+							Namespace: "gloo-system",
+						},
+						Data: map[string]string{
+							"protoDesc": "aGVsbG8K",
+						},
+					},
+				},
+			}
+			upstream = &v1.Upstream{
+				Metadata: &core.Metadata{Name: "grpc-upstream", Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "localhost", Port: 8080}},
+						ServiceSpec: &glooplugins.ServiceSpec{
+							PluginType: &glooplugins.ServiceSpec_GrpcJsonTranscoder{
+								GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+									DescriptorSet: &grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap{
+										ProtoDescriptorConfigMap: &grpc_json.GrpcJsonTranscoder_DescriptorConfigMap{
+											ConfigMapRef: &core.ResourceRef{Name: "grpc-upstream-descriptors", Namespace: "gloo-system"},
+											Key:          "protoDesc",
+										},
+									},
+									Services:    []string{"main.Bookstore"},
+									AutoMapping: true,
+								},
+							},
+						},
+					},
+				},
+			}
+			transcode = &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder{
+				DescriptorSet: &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{
+					ProtoDescriptorBin: []byte("hello\n"),
+				},
+				Services:    []string{"main.Bookstore"},
+				AutoMapping: true,
+			}
+		})
+
+		processUpstream := func(p plugins.UpstreamPlugin) *envoy_config_cluster_v3.Cluster {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{Snapshot: snap}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			return out
+		}
+
+		routeToUpstream := func() *v1.Route {
+			return &v1.Route{
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_Single{
+							Single: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{
+									Upstream: upstream.GetMetadata().Ref(),
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		listenerRoutingToUpstream := func() *v1.HttpListener {
+			return &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name:   "vhost",
+					Routes: []*v1.Route{routeToUpstream()},
+				}},
+			}
+		}
+
+		processRoute := func(p plugins.RoutePlugin) *envoy_config_route_v3.Route {
+			in := routeToUpstream()
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params: plugins.Params{Ctx: context.Background(), Snapshot: snap},
+				},
+			}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("should use http2 for the upstream", func() {
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			out := processUpstream(p)
+			Expect(out.GetHttp2ProtocolOptions()).To(Equal(&envoy_config_core_v3.Http2ProtocolOptions{}))
+		})
+
+		It("should transcode the routes to the upstream", func() {
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			processUpstream(p)
+			out := processRoute(p)
+
+			Expect(out.GetTypedPerFilterConfig()).To(HaveKey(wellknown.GRPCJSONTranscoder))
+			routeConfig, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[wellknown.GRPCJSONTranscoder])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routeConfig).To(skmatchers.MatchProto(transcode))
+		})
+
+		It("should add a disabled filter to listeners without a transcoder which route to the upstream", func() {
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			processUpstream(p)
+			f, err := p.HttpFilters(plugins.Params{Snapshot: snap}, listenerRoutingToUpstream())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(HaveLen(1))
+
+			filterConfig, err := utils.AnyToMessage(f[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig.(*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder).GetServices()).To(BeEmpty())
+		})
+
+		It("should not add a filter to listeners which do not route to the upstream", func() {
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			processUpstream(p)
+			f, err := p.HttpFilters(plugins.Params{Snapshot: snap}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name: "vhost",
+					Routes: []*v1.Route{{
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &v1.DirectResponseAction{Status: 200},
+						},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(BeEmpty())
+		})
+
+		It("should only mark the upstream as grpc when it has no services", func() {
+			upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder().Services = nil
+			// the configmap is written by discovery with the services
+			snap.Artifacts = nil
+
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			out := processUpstream(p)
+			Expect(out.GetHttp2ProtocolOptions()).NotTo(BeNil())
+			Expect(processRoute(p).GetTypedPerFilterConfig()).To(BeEmpty())
+			f, err := p.HttpFilters(plugins.Params{Snapshot: snap}, listenerRoutingToUpstream())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(BeEmpty())
+		})
+
+		It("should return error if the configmap of the upstream does not exist", func() {
+			snap.Artifacts = nil
+
+			p := grpcjson.NewPlugin()
+			p.Init(initParams)
+			err := p.ProcessUpstream(plugins.Params{Snapshot: snap}, upstream, &envoy_config_cluster_v3.Cluster{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(grpcjson.ConfigMapNotFoundError(upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder().GetProtoDescriptorConfigMap()).Error()))
+		})
+	})
 })
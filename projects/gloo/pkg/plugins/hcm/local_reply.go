@@ -0,0 +1,369 @@
+package hcm
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	envoy_config_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_reply"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// envoy requires a runtime key for status code comparisons
+	DefaultStatusCodeRuntimeKey = "local_reply.status_code_filter"
+
+	authorityHeader = ":authority"
+)
+
+var (
+	EmptyResponseMapperFilterError = errors.New("local reply mapper filter must specify at least one condition")
+	MissingHeaderValueError        = errors.New("local reply header to add must specify a header")
+	ArtifactNotFoundError          = func(ref *core.ResourceRef) error {
+		return errors.Errorf("local reply body artifact %s.%s cannot be found", ref.GetNamespace(), ref.GetName())
+	}
+	NoArtifactKeyError = func(ref *core.ResourceRef, numValues int) error {
+		return errors.Errorf("key must be provided for local reply body artifact %s.%s which contains %d values",
+			ref.GetNamespace(), ref.GetName(), numValues)
+	}
+	NoArtifactDataError = func(ref *core.ResourceRef, key string) error {
+		return errors.Errorf("local reply body artifact %s.%s does not contain a value for key %s",
+			ref.GetNamespace(), ref.GetName(), key)
+	}
+)
+
+// translateLocalReplyConfig merges the local reply config of the listener and of its virtual hosts.
+// Envoy only supports local reply config on the HttpConnectionManager, so the mappers of each virtual host
+// are scoped to the domains of the virtual host by matching on the `:authority` header, and are placed
+// before the mappers of the listener.
+func translateLocalReplyConfig(params plugins.Params, in *local_reply.LocalReplyConfig, virtualHosts []*v1.VirtualHost) (*envoyhttp.LocalReplyConfig, error) {
+	var mappers []*envoyhttp.ResponseMapper
+	for _, virtualHost := range virtualHosts {
+		vhostConfig := virtualHost.GetOptions().GetLocalReplyConfig()
+		if vhostConfig == nil {
+			continue
+		}
+
+		scope := virtualHostScopeFilter(params.Ctx, virtualHost, virtualHosts)
+		for _, mapper := range vhostConfig.GetMappers() {
+			envoyMapper, err := translateResponseMapper(params, mapper, vhostConfig.GetBodyFormat(), scope)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid local reply config on virtual host %s", virtualHost.GetName())
+			}
+			mappers = append(mappers, envoyMapper)
+		}
+	}
+
+	for _, mapper := range in.GetMappers() {
+		envoyMapper, err := translateResponseMapper(params, mapper, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		mappers = append(mappers, envoyMapper)
+	}
+
+	if in == nil && len(mappers) == 0 {
+		return nil, nil
+	}
+
+	out := &envoyhttp.LocalReplyConfig{
+		Mappers:    mappers,
+		BodyFormat: translateSubstitutionFormatString(in.GetBodyFormat()),
+	}
+	return out, out.Validate()
+}
+
+func translateResponseMapper(
+	params plugins.Params,
+	in *local_reply.ResponseMapper,
+	defaultBodyFormat *local_reply.SubstitutionFormatString,
+	scope *envoy_config_accesslog_v3.AccessLogFilter,
+) (*envoyhttp.ResponseMapper, error) {
+	filter, err := translateResponseMapperFilter(params.Ctx, in.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		filter = andFilters([]*envoy_config_accesslog_v3.AccessLogFilter{scope, filter})
+	}
+
+	body, err := translateResponseBody(params, in.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	bodyFormat := in.GetBodyFormatOverride()
+	if bodyFormat == nil {
+		bodyFormat = defaultBodyFormat
+	}
+
+	out := &envoyhttp.ResponseMapper{
+		Filter:             filter,
+		StatusCode:         in.GetStatusCode(),
+		Body:               body,
+		BodyFormatOverride: translateSubstitutionFormatString(bodyFormat),
+	}
+	for _, header := range in.GetHeadersToAdd() {
+		if header.GetHeader() == nil {
+			return nil, MissingHeaderValueError
+		}
+		out.HeadersToAdd = append(out.HeadersToAdd, &envoycore.HeaderValueOption{
+			Header: &envoycore.HeaderValue{
+				Key:   header.GetHeader().GetKey(),
+				Value: header.GetHeader().GetValue(),
+			},
+			Append: header.GetAppend(),
+		})
+	}
+	return out, nil
+}
+
+func translateResponseMapperFilter(ctx context.Context, in *local_reply.ResponseMapperFilter) (*envoy_config_accesslog_v3.AccessLogFilter, error) {
+	var filters []*envoy_config_accesslog_v3.AccessLogFilter
+
+	if statusCode := in.GetStatusCode(); statusCode != nil {
+		runtimeKey := statusCode.GetRuntimeKey()
+		if runtimeKey == "" {
+			runtimeKey = DefaultStatusCodeRuntimeKey
+		}
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoy_config_accesslog_v3.StatusCodeFilter{
+					Comparison: &envoy_config_accesslog_v3.ComparisonFilter{
+						Op: envoy_config_accesslog_v3.ComparisonFilter_Op(statusCode.GetOp()),
+						Value: &envoycore.RuntimeUInt32{
+							DefaultValue: statusCode.GetValue(),
+							RuntimeKey:   runtimeKey,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	filters = append(filters, headerFilters(ctx, in.GetHeaders())...)
+
+	if responseFlags := in.GetResponseFlags(); responseFlags != nil {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &envoy_config_accesslog_v3.ResponseFlagFilter{
+					Flags: responseFlags.GetFlags(),
+				},
+			},
+		})
+	}
+
+	if len(filters) == 0 {
+		return nil, EmptyResponseMapperFilterError
+	}
+	return andFilters(filters), nil
+}
+
+func headerFilters(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_accesslog_v3.AccessLogFilter {
+	var out []*envoy_config_accesslog_v3.AccessLogFilter
+	for _, header := range translator.EnvoyHeaderMatcher(ctx, in) {
+		out = append(out, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &envoy_config_accesslog_v3.HeaderFilter{
+					Header: header,
+				},
+			},
+		})
+	}
+	return out
+}
+
+// envoy requires and/or filters to contain at least two filters
+func andFilters(filters []*envoy_config_accesslog_v3.AccessLogFilter) *envoy_config_accesslog_v3.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoy_config_accesslog_v3.AccessLogFilter{
+		FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_AndFilter{
+			AndFilter: &envoy_config_accesslog_v3.AndFilter{
+				Filters: filters,
+			},
+		},
+	}
+}
+
+func orFilters(filters []*envoy_config_accesslog_v3.AccessLogFilter) *envoy_config_accesslog_v3.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoy_config_accesslog_v3.AccessLogFilter{
+		FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_OrFilter{
+			OrFilter: &envoy_config_accesslog_v3.OrFilter{
+				Filters: filters,
+			},
+		},
+	}
+}
+
+// virtualHostScopeFilter returns a filter matching the requests that envoy routes to the given virtual host.
+// A request matches a domain of the virtual host if its authority matches the domain, and does not match
+// a domain of another virtual host which envoy would select first.
+// Returns nil if the virtual host matches all requests.
+func virtualHostScopeFilter(ctx context.Context, virtualHost *v1.VirtualHost, virtualHosts []*v1.VirtualHost) *envoy_config_accesslog_v3.AccessLogFilter {
+	var domainFilters []*envoy_config_accesslog_v3.AccessLogFilter
+	for _, domain := range virtualHost.GetDomains() {
+		var headerMatchers []*matchers.HeaderMatcher
+		if domain != "*" {
+			headerMatchers = append(headerMatchers, &matchers.HeaderMatcher{
+				Name:  authorityHeader,
+				Value: domainRegex(domain),
+				Regex: true,
+			})
+		}
+
+		var preferredDomains []string
+		for _, other := range virtualHosts {
+			if other == virtualHost {
+				continue
+			}
+			for _, otherDomain := range other.GetDomains() {
+				if domainPrecedes(otherDomain, domain) {
+					preferredDomains = append(preferredDomains, domainRegex(otherDomain))
+				}
+			}
+		}
+		if len(preferredDomains) > 0 {
+			headerMatchers = append(headerMatchers, &matchers.HeaderMatcher{
+				Name:        authorityHeader,
+				Value:       strings.Join(preferredDomains, "|"),
+				Regex:       true,
+				InvertMatch: true,
+			})
+		}
+
+		if len(headerMatchers) == 0 {
+			// this domain matches every request
+			return nil
+		}
+		domainFilters = append(domainFilters, andFilters(headerFilters(ctx, headerMatchers)))
+	}
+
+	if len(domainFilters) == 0 {
+		return nil
+	}
+	return orFilters(domainFilters)
+}
+
+// domainRegex converts a virtual host domain into a case-insensitive regex matching the `:authority` header
+func domainRegex(domain string) string {
+	var pattern string
+	switch {
+	case domain == "*":
+		pattern = ".*"
+	case strings.HasPrefix(domain, "*"):
+		pattern = ".+" + regexp.QuoteMeta(strings.TrimPrefix(domain, "*"))
+	case strings.HasSuffix(domain, "*"):
+		pattern = regexp.QuoteMeta(strings.TrimSuffix(domain, "*")) + ".+"
+	default:
+		pattern = regexp.QuoteMeta(domain)
+	}
+	return "(?i)^" + pattern + "$"
+}
+
+// domainPrecedes returns true if envoy prefers the domain a over the domain b when both match a request:
+// exact domains are preferred over suffix wildcards, which are preferred over prefix wildcards, which are
+// preferred over the catch-all domain. Longer wildcards are preferred over shorter ones.
+func domainPrecedes(a, b string) bool {
+	rankA, lenA := domainRank(a)
+	rankB, lenB := domainRank(b)
+	if rankA != rankB {
+		return rankA < rankB
+	}
+	return rankA != 0 && lenA > lenB
+}
+
+func domainRank(domain string) (int, int) {
+	switch {
+	case domain == "*":
+		return 3, 0
+	case strings.HasPrefix(domain, "*"):
+		return 1, len(domain)
+	case strings.HasSuffix(domain, "*"):
+		return 2, len(domain)
+	}
+	return 0, len(domain)
+}
+
+func translateResponseBody(params plugins.Params, in *local_reply.ResponseBody) (*envoycore.DataSource, error) {
+	switch source := in.GetSource().(type) {
+	case *local_reply.ResponseBody_InlineString:
+		return &envoycore.DataSource{
+			Specifier: &envoycore.DataSource_InlineString{
+				InlineString: source.InlineString,
+			},
+		}, nil
+	case *local_reply.ResponseBody_Artifact:
+		body, err := getArtifactValue(params, source.Artifact)
+		if err != nil {
+			return nil, err
+		}
+		return &envoycore.DataSource{
+			Specifier: &envoycore.DataSource_InlineString{
+				InlineString: body,
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func getArtifactValue(params plugins.Params, in *artifacts.ArtifactKeyRef) (string, error) {
+	ref := in.GetArtifactRef()
+	artifact, err := params.Snapshot.Artifacts.Find(ref.Strings())
+	if err != nil {
+		return "", ArtifactNotFoundError(ref)
+	}
+
+	data := artifact.GetData()
+	key := in.GetKey()
+	if key == "" {
+		// if there is exactly one value, use it
+		if len(data) != 1 {
+			return "", NoArtifactKeyError(ref, len(data))
+		}
+		for _, v := range data {
+			return v, nil
+		}
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", NoArtifactDataError(ref, key)
+	}
+	return value, nil
+}
+
+func translateSubstitutionFormatString(in *local_reply.SubstitutionFormatString) *envoycore.SubstitutionFormatString {
+	if in == nil {
+		return nil
+	}
+
+	out := &envoycore.SubstitutionFormatString{
+		OmitEmptyValues: in.GetOmitEmptyValues(),
+		ContentType:     in.GetContentType(),
+	}
+	switch format := in.GetFormat().(type) {
+	case *local_reply.SubstitutionFormatString_TextFormat:
+		out.Format = &envoycore.SubstitutionFormatString_TextFormat{
+			TextFormat: format.TextFormat,
+		}
+	case *local_reply.SubstitutionFormatString_JsonFormat:
+		out.Format = &envoycore.SubstitutionFormatString_JsonFormat{
+			JsonFormat: format.JsonFormat,
+		}
+	}
+	return out
+}
@@ -294,6 +294,12 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, _ *v1.Listener,
 		}
 	}
 
+	localReplyConfig, err := translateLocalReplyConfig(params, in.GetLocalReplyConfig(), listener.GetVirtualHosts())
+	if err != nil {
+		return errors.Wrapf(err, "invalid local reply config")
+	}
+	out.LocalReplyConfig = localReplyConfig
+
 	return nil
 
 }
@@ -10,18 +10,27 @@ import (
 	"time"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_reply"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	. "github.com/solo-io/solo-kit/test/matchers"
 )
@@ -35,7 +44,8 @@ var _ = Describe("Plugin", func() {
 		p            plugins.HttpConnectionManagerPlugin
 		pluginParams plugins.Params
 
-		settings *hcm.HttpConnectionManagerSettings
+		settings     *hcm.HttpConnectionManagerSettings
+		virtualHosts []*v1.VirtualHost
 	)
 
 	BeforeEach(func() {
@@ -46,6 +56,7 @@ var _ = Describe("Plugin", func() {
 			Ctx: ctx,
 		}
 		settings = &hcm.HttpConnectionManagerSettings{}
+		virtualHosts = nil
 	})
 
 	AfterEach(func() {
@@ -57,6 +68,7 @@ var _ = Describe("Plugin", func() {
 			Options: &v1.HttpListenerOptions{
 				HttpConnectionManagerSettings: settings,
 			},
+			VirtualHosts: virtualHosts,
 		}
 		listener := &v1.Listener{}
 		return p.ProcessHcmNetworkFilter(pluginParams, listener, httpListener, cfg)
@@ -352,6 +364,308 @@ var _ = Describe("Plugin", func() {
 
 	})
 
+	Context("local reply config", func() {
+
+		var (
+			cfg *envoyhttp.HttpConnectionManager
+		)
+
+		BeforeEach(func() {
+			cfg = &envoyhttp.HttpConnectionManager{}
+			pluginParams.Snapshot = &gloosnapshot.ApiSnapshot{
+				Artifacts: v1.ArtifactList{
+					&v1.Artifact{
+						Metadata: &core.Metadata{
+							Name:      "local-reply",
+							Namespace: "gloo-system",
+						},
+						Data: map[string]string{
+							"unavailable": "upstream unavailable",
+							"not-found":   "not found",
+						},
+					},
+				},
+			}
+		})
+
+		statusCodeFilter := func(value uint32) *envoyal.AccessLogFilter {
+			return &envoyal.AccessLogFilter{
+				FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+					StatusCodeFilter: &envoyal.StatusCodeFilter{
+						Comparison: &envoyal.ComparisonFilter{
+							Op: envoyal.ComparisonFilter_EQ,
+							Value: &envoycore.RuntimeUInt32{
+								DefaultValue: value,
+								RuntimeKey:   DefaultStatusCodeRuntimeKey,
+							},
+						},
+					},
+				},
+			}
+		}
+
+		authorityFilter := func(regex string, invert bool) *envoyal.AccessLogFilter {
+			return &envoyal.AccessLogFilter{
+				FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+					HeaderFilter: &envoyal.HeaderFilter{
+						Header: &envoy_config_route_v3.HeaderMatcher{
+							Name: ":authority",
+							HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+								SafeRegexMatch: regexutils.NewRegex(ctx, regex),
+							},
+							InvertMatch: invert,
+						},
+					},
+				},
+			}
+		}
+
+		It("does not set local reply config by default", func() {
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetLocalReplyConfig()).To(BeNil())
+		})
+
+		It("translates the listener mappers", func() {
+			jsonFormat := &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"message": structpb.NewStringValue("%LOCAL_REPLY_BODY%"),
+				},
+			}
+			settings.LocalReplyConfig = &local_reply.LocalReplyConfig{
+				Mappers: []*local_reply.ResponseMapper{
+					{
+						Filter: &local_reply.ResponseMapperFilter{
+							StatusCode: &local_reply.StatusCodeFilter{
+								Op:    local_reply.StatusCodeFilter_EQ,
+								Value: 503,
+							},
+							ResponseFlags: &local_reply.ResponseFlagFilter{
+								Flags: []string{"UH"},
+							},
+						},
+						StatusCode: &wrappers.UInt32Value{Value: 502},
+						Body: &local_reply.ResponseBody{
+							Source: &local_reply.ResponseBody_Artifact{
+								Artifact: &artifacts.ArtifactKeyRef{
+									ArtifactRef: &core.ResourceRef{Name: "local-reply", Namespace: "gloo-system"},
+									Key:         "unavailable",
+								},
+							},
+						},
+						HeadersToAdd: []*headers.HeaderValueOption{
+							{Header: &headers.HeaderValue{Key: "x-local-reply", Value: "true"}},
+						},
+					},
+				},
+				BodyFormat: &local_reply.SubstitutionFormatString{
+					Format: &local_reply.SubstitutionFormatString_JsonFormat{
+						JsonFormat: jsonFormat,
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetLocalReplyConfig()).To(MatchProto(&envoyhttp.LocalReplyConfig{
+				Mappers: []*envoyhttp.ResponseMapper{
+					{
+						Filter: &envoyal.AccessLogFilter{
+							FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+								AndFilter: &envoyal.AndFilter{
+									Filters: []*envoyal.AccessLogFilter{
+										statusCodeFilter(503),
+										{
+											FilterSpecifier: &envoyal.AccessLogFilter_ResponseFlagFilter{
+												ResponseFlagFilter: &envoyal.ResponseFlagFilter{
+													Flags: []string{"UH"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						StatusCode: &wrappers.UInt32Value{Value: 502},
+						Body: &envoycore.DataSource{
+							Specifier: &envoycore.DataSource_InlineString{
+								InlineString: "upstream unavailable",
+							},
+						},
+						HeadersToAdd: []*envoycore.HeaderValueOption{
+							{Header: &envoycore.HeaderValue{Key: "x-local-reply", Value: "true"}},
+						},
+					},
+				},
+				BodyFormat: &envoycore.SubstitutionFormatString{
+					Format: &envoycore.SubstitutionFormatString_JsonFormat{
+						JsonFormat: jsonFormat,
+					},
+				},
+			}))
+		})
+
+		It("scopes virtual host mappers to the virtual host domains", func() {
+			settings.LocalReplyConfig = &local_reply.LocalReplyConfig{
+				Mappers: []*local_reply.ResponseMapper{
+					{
+						Filter: &local_reply.ResponseMapperFilter{
+							StatusCode: &local_reply.StatusCodeFilter{Value: 404},
+						},
+						Body: &local_reply.ResponseBody{
+							Source: &local_reply.ResponseBody_InlineString{InlineString: "listener"},
+						},
+					},
+				},
+			}
+			virtualHosts = []*v1.VirtualHost{
+				{
+					Name:    "exact",
+					Domains: []string{"api.example.com"},
+				},
+				{
+					Name:    "wildcard",
+					Domains: []string{"*.example.com"},
+					Options: &v1.VirtualHostOptions{
+						LocalReplyConfig: &local_reply.LocalReplyConfig{
+							Mappers: []*local_reply.ResponseMapper{
+								{
+									Filter: &local_reply.ResponseMapperFilter{
+										StatusCode: &local_reply.StatusCodeFilter{Value: 404},
+									},
+									Body: &local_reply.ResponseBody{
+										Source: &local_reply.ResponseBody_InlineString{InlineString: "vhost"},
+									},
+								},
+							},
+							BodyFormat: &local_reply.SubstitutionFormatString{
+								Format: &local_reply.SubstitutionFormatString_TextFormat{
+									TextFormat: "%LOCAL_REPLY_BODY%",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			mappers := cfg.GetLocalReplyConfig().GetMappers()
+			Expect(mappers).To(HaveLen(2))
+			Expect(mappers[0]).To(MatchProto(&envoyhttp.ResponseMapper{
+				Filter: &envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+						AndFilter: &envoyal.AndFilter{
+							Filters: []*envoyal.AccessLogFilter{
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+										AndFilter: &envoyal.AndFilter{
+											Filters: []*envoyal.AccessLogFilter{
+												authorityFilter(`(?i)^.+\.example\.com$`, false),
+												authorityFilter(`(?i)^api\.example\.com$`, true),
+											},
+										},
+									},
+								},
+								statusCodeFilter(404),
+							},
+						},
+					},
+				},
+				Body: &envoycore.DataSource{
+					Specifier: &envoycore.DataSource_InlineString{InlineString: "vhost"},
+				},
+				BodyFormatOverride: &envoycore.SubstitutionFormatString{
+					Format: &envoycore.SubstitutionFormatString_TextFormat{
+						TextFormat: "%LOCAL_REPLY_BODY%",
+					},
+				},
+			}))
+			Expect(mappers[1].GetFilter()).To(MatchProto(statusCodeFilter(404)))
+			Expect(mappers[1].GetBody().GetInlineString()).To(Equal("listener"))
+		})
+
+		It("does not scope mappers of catch-all virtual hosts", func() {
+			virtualHosts = []*v1.VirtualHost{
+				{
+					Name:    "catch-all",
+					Domains: []string{"*"},
+					Options: &v1.VirtualHostOptions{
+						LocalReplyConfig: &local_reply.LocalReplyConfig{
+							Mappers: []*local_reply.ResponseMapper{
+								{
+									Filter: &local_reply.ResponseMapperFilter{
+										StatusCode: &local_reply.StatusCodeFilter{Value: 404},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetLocalReplyConfig().GetMappers()).To(HaveLen(1))
+			Expect(cfg.GetLocalReplyConfig().GetMappers()[0].GetFilter()).To(MatchProto(statusCodeFilter(404)))
+		})
+
+		It("errors when a mapper filter is empty", func() {
+			settings.LocalReplyConfig = &local_reply.LocalReplyConfig{
+				Mappers: []*local_reply.ResponseMapper{
+					{
+						Filter: &local_reply.ResponseMapperFilter{},
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).To(MatchError(ContainSubstring(EmptyResponseMapperFilterError.Error())))
+		})
+
+		It("errors when the artifact key is ambiguous", func() {
+			ref := &core.ResourceRef{Name: "local-reply", Namespace: "gloo-system"}
+			settings.LocalReplyConfig = &local_reply.LocalReplyConfig{
+				Mappers: []*local_reply.ResponseMapper{
+					{
+						Filter: &local_reply.ResponseMapperFilter{
+							StatusCode: &local_reply.StatusCodeFilter{Value: 404},
+						},
+						Body: &local_reply.ResponseBody{
+							Source: &local_reply.ResponseBody_Artifact{
+								Artifact: &artifacts.ArtifactKeyRef{ArtifactRef: ref},
+							},
+						},
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).To(MatchError(ContainSubstring(NoArtifactKeyError(ref, 2).Error())))
+		})
+
+		It("errors when the artifact does not exist", func() {
+			ref := &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+			settings.LocalReplyConfig = &local_reply.LocalReplyConfig{
+				Mappers: []*local_reply.ResponseMapper{
+					{
+						Filter: &local_reply.ResponseMapperFilter{
+							StatusCode: &local_reply.StatusCodeFilter{Value: 404},
+						},
+						Body: &local_reply.ResponseBody{
+							Source: &local_reply.ResponseBody_Artifact{
+								Artifact: &artifacts.ArtifactKeyRef{ArtifactRef: ref, Key: "body"},
+							},
+						},
+					},
+				},
+			}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).To(MatchError(ContainSubstring(ArtifactNotFoundError(ref).Error())))
+		})
+	})
+
 	Context("supported Envoy HCM settings", func() {
 		// obtain all field names of a given instance's type
 		getTypeFieldsFromInstance := func(instance interface{}) []string {
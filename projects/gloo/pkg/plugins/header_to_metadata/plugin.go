@@ -0,0 +1,178 @@
+package header_to_metadata
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_header_to_metadata_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "header_to_metadata"
+	FilterName    = "envoy.filters.http.header_to_metadata"
+)
+
+var (
+	// the metadata is written early, so that it is available to the filters which authenticate, authorize and
+	// rate limit the request
+	pluginStage = plugins.AfterStage(plugins.FaultStage)
+
+	MissingRulesError        = eris.New("header to metadata must specify at least one request rule")
+	MissingKeyValuePairError = func(header string) error {
+		return eris.Errorf("header to metadata rule for header %s must specify onHeaderPresent or onHeaderMissing", header)
+	}
+	MissingValueError = func(header string) error {
+		return eris.Errorf("header to metadata rule for header %s must specify the value written when the header is missing", header)
+	}
+	ValueAndRegexRewriteError = func(header string) error {
+		return eris.Errorf("header to metadata rule for header %s cannot specify both a value and a regex value rewrite", header)
+	}
+
+	valueTypes = map[header_to_metadata.HeaderToMetadata_ValueType]envoy_extensions_filters_http_header_to_metadata_v3.Config_ValueType{
+		header_to_metadata.HeaderToMetadata_STRING: envoy_extensions_filters_http_header_to_metadata_v3.Config_STRING,
+		header_to_metadata.HeaderToMetadata_NUMBER: envoy_extensions_filters_http_header_to_metadata_v3.Config_NUMBER,
+	}
+)
+
+type plugin struct {
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	headerToMetadata := in.GetOptions().GetHeaderToMetadata()
+	if headerToMetadata == nil {
+		return nil
+	}
+
+	config, err := translateHeaderToMetadata(params.Ctx, headerToMetadata)
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, config)
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	headerToMetadata := in.GetOptions().GetHeaderToMetadata()
+	if headerToMetadata == nil {
+		return nil
+	}
+
+	config, err := translateHeaderToMetadata(params.Ctx, headerToMetadata)
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, config)
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if _, ok := p.filterRequiredForListener[listener]; !ok {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	// the listener-level filter has no rules, it only applies the virtual host and route configurations
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, &envoy_extensions_filters_http_header_to_metadata_v3.Config{}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func translateHeaderToMetadata(
+	ctx context.Context,
+	in *header_to_metadata.HeaderToMetadata,
+) (*envoy_extensions_filters_http_header_to_metadata_v3.Config, error) {
+	if len(in.GetRequestRules()) == 0 {
+		return nil, MissingRulesError
+	}
+
+	out := &envoy_extensions_filters_http_header_to_metadata_v3.Config{}
+	for _, rule := range in.GetRequestRules() {
+		if rule.GetOnHeaderPresent() == nil && rule.GetOnHeaderMissing() == nil {
+			return nil, MissingKeyValuePairError(rule.GetHeader())
+		}
+		if rule.GetOnHeaderMissing() != nil && rule.GetOnHeaderMissing().GetValue() == "" {
+			return nil, MissingValueError(rule.GetHeader())
+		}
+
+		onHeaderPresent, err := translateKeyValuePair(ctx, rule.GetHeader(), rule.GetOnHeaderPresent())
+		if err != nil {
+			return nil, err
+		}
+		onHeaderMissing, err := translateKeyValuePair(ctx, rule.GetHeader(), rule.GetOnHeaderMissing())
+		if err != nil {
+			return nil, err
+		}
+
+		out.RequestRules = append(out.GetRequestRules(), &envoy_extensions_filters_http_header_to_metadata_v3.Config_Rule{
+			Header:          rule.GetHeader(),
+			OnHeaderPresent: onHeaderPresent,
+			OnHeaderMissing: onHeaderMissing,
+			Remove:          rule.GetRemove(),
+		})
+	}
+
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func translateKeyValuePair(
+	ctx context.Context,
+	header string,
+	in *header_to_metadata.HeaderToMetadata_KeyValuePair,
+) (*envoy_extensions_filters_http_header_to_metadata_v3.Config_KeyValuePair, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if in.GetValue() != "" && in.GetRegexValueRewrite() != nil {
+		return nil, ValueAndRegexRewriteError(header)
+	}
+
+	regexValueRewrite, err := regexutils.ConvertRegexMatchAndSubstitute(ctx, in.GetRegexValueRewrite())
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_extensions_filters_http_header_to_metadata_v3.Config_KeyValuePair{
+		MetadataNamespace: in.GetMetadataNamespace(),
+		Key:               in.GetKey(),
+		Value:             in.GetValue(),
+		RegexValueRewrite: regexValueRewrite,
+		Type:              valueTypes[in.GetType()],
+	}, nil
+}
@@ -0,0 +1,160 @@
+package header_to_metadata_test
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_header_to_metadata_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_to_metadata"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/header_to_metadata"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("HeaderToMetadata Plugin", func() {
+
+	var (
+		p                plugins.Plugin
+		params           plugins.Params
+		httpListener     *v1.HttpListener
+		headerToMetadata *header_to_metadata.HeaderToMetadata
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO()})
+		params = plugins.Params{Ctx: context.TODO()}
+		httpListener = &v1.HttpListener{}
+
+		headerToMetadata = &header_to_metadata.HeaderToMetadata{
+			RequestRules: []*header_to_metadata.HeaderToMetadata_Rule{
+				{
+					Header: "x-tenant-id",
+					OnHeaderPresent: &header_to_metadata.HeaderToMetadata_KeyValuePair{
+						MetadataNamespace: "envoy.lb",
+						Key:               "tenant",
+					},
+					OnHeaderMissing: &header_to_metadata.HeaderToMetadata_KeyValuePair{
+						MetadataNamespace: "envoy.lb",
+						Key:               "tenant",
+						Value:             "default",
+					},
+					Remove: true,
+				},
+				{
+					Header: "x-client-version",
+					OnHeaderPresent: &header_to_metadata.HeaderToMetadata_KeyValuePair{
+						Key: "client-major-version",
+						RegexValueRewrite: &v3.RegexMatchAndSubstitute{
+							Pattern:      &v3.RegexMatcher{Regex: `^(\d+)\..*$`},
+							Substitution: `\1`,
+						},
+						Type: header_to_metadata.HeaderToMetadata_NUMBER,
+					},
+				},
+			},
+		}
+	})
+
+	httpFilters := func() []plugins.StagedHttpFilter {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return filters
+	}
+
+	processRoute := func(options *v1.RouteOptions) (*envoy_config_route_v3.Route, error) {
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{Params: params, HttpListener: httpListener},
+		}, &v1.Route{Options: options}, out)
+		return out, err
+	}
+
+	processVirtualHost := func(options *v1.VirtualHostOptions) (*envoy_config_route_v3.VirtualHost, error) {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{
+			Params:       params,
+			HttpListener: httpListener,
+		}, &v1.VirtualHost{Options: options}, out)
+		return out, err
+	}
+
+	It("does not add the filter when no virtual host or route writes metadata", func() {
+		_, err := processRoute(&v1.RouteOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(httpFilters()).To(BeEmpty())
+	})
+
+	It("translates the rules of a virtual host and adds an empty listener-level filter", func() {
+		out, err := processVirtualHost(&v1.VirtualHostOptions{HeaderToMetadata: headerToMetadata})
+		Expect(err).NotTo(HaveOccurred())
+
+		var cfg envoy_extensions_filters_http_header_to_metadata_v3.Config
+		err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetRequestRules()).To(HaveLen(2))
+		Expect(cfg.GetRequestRules()[0]).To(matchers.MatchProto(&envoy_extensions_filters_http_header_to_metadata_v3.Config_Rule{
+			Header: "x-tenant-id",
+			OnHeaderPresent: &envoy_extensions_filters_http_header_to_metadata_v3.Config_KeyValuePair{
+				MetadataNamespace: "envoy.lb",
+				Key:               "tenant",
+			},
+			OnHeaderMissing: &envoy_extensions_filters_http_header_to_metadata_v3.Config_KeyValuePair{
+				MetadataNamespace: "envoy.lb",
+				Key:               "tenant",
+				Value:             "default",
+			},
+			Remove: true,
+		}))
+		secondRule := cfg.GetRequestRules()[1].GetOnHeaderPresent()
+		Expect(secondRule.GetType()).To(Equal(envoy_extensions_filters_http_header_to_metadata_v3.Config_NUMBER))
+		Expect(secondRule.GetRegexValueRewrite().GetPattern().GetRegex()).To(Equal(`^(\d+)\..*$`))
+		Expect(secondRule.GetRegexValueRewrite().GetSubstitution()).To(Equal(`\1`))
+
+		filters := httpFilters()
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.FaultStage)))
+
+		var listenerCfg envoy_extensions_filters_http_header_to_metadata_v3.Config
+		Expect(translator.ParseTypedConfig(filters[0].HttpFilter, &listenerCfg)).NotTo(HaveOccurred())
+		Expect(listenerCfg.GetRequestRules()).To(BeEmpty())
+	})
+
+	It("translates the rules of a route", func() {
+		out, err := processRoute(&v1.RouteOptions{HeaderToMetadata: headerToMetadata})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveKey(FilterName))
+		Expect(httpFilters()).To(HaveLen(1))
+	})
+
+	It("errors without rules", func() {
+		_, err := processRoute(&v1.RouteOptions{HeaderToMetadata: &header_to_metadata.HeaderToMetadata{}})
+		Expect(err).To(MatchError(MissingRulesError))
+	})
+
+	It("errors when a rule writes no metadata", func() {
+		headerToMetadata.RequestRules[0].OnHeaderPresent = nil
+		headerToMetadata.RequestRules[0].OnHeaderMissing = nil
+		_, err := processRoute(&v1.RouteOptions{HeaderToMetadata: headerToMetadata})
+		Expect(err).To(MatchError(MissingKeyValuePairError("x-tenant-id")))
+	})
+
+	It("errors when the value written for a missing header is empty", func() {
+		headerToMetadata.RequestRules[0].OnHeaderMissing.Value = ""
+		_, err := processRoute(&v1.RouteOptions{HeaderToMetadata: headerToMetadata})
+		Expect(err).To(MatchError(MissingValueError("x-tenant-id")))
+	})
+
+	It("errors when both a value and a regex value rewrite are specified", func() {
+		headerToMetadata.RequestRules[1].OnHeaderPresent.Value = "1"
+		_, err := processRoute(&v1.RouteOptions{HeaderToMetadata: headerToMetadata})
+		Expect(err).To(MatchError(ValueAndRegexRewriteError("x-client-version")))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +23,9 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister
+	// returns nil when the nodes are not watched
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,17 +33,19 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister      map[string]kubelisters.EndpointsLister
+	endpointSlicesLister map[string]discoverylisters.EndpointSliceLister
+	nodeLister           kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchEndpointSlices, watchNodes bool) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, watchEndpointSlices, watchNodes)
 	if kubePluginSharedFactory.initError != nil {
 		// This is an unrecoverable error (no shared informer factory means all of kube EDS won't work, which is
 		// probably the most valuable / important role for gloo) and  users know immediately about e.g. any rbac errors
@@ -49,15 +55,26 @@ func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchN
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+// only one of the Endpoints and EndpointSlices informers is started, so that gloo does not need the permission to
+// watch both kinds of resources.
+// The nodes are only watched when their labels set the locality of the endpoints. Node updates do not trigger an
+// update of the endpoints, as the nodes report their status much more often than their labels change.
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchEndpointSlices, watchNodes bool) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:      map[string]kubelisters.EndpointsLister{},
+		endpointSlicesLister: map[string]discoverylisters.EndpointSliceLister{},
 	}
 	for _, nsToWatch := range watchNamespaces {
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
+		if watchEndpointSlices {
+			endpointSliceInformer := kubeInformerFactory.Discovery().V1().EndpointSlices()
+			informers = append(informers, endpointSliceInformer.Informer())
+			k.endpointSlicesLister[nsToWatch] = endpointSliceInformer.Lister()
+			continue
+		}
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
@@ -79,6 +96,14 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 		syncFuncs = append(syncFuncs, informer.HasSynced)
 	}
 
+	if watchNodes {
+		nodeInformerFactory := kubeinformers.NewSharedInformerFactory(client, resyncDuration)
+		nodeInformer := nodeInformerFactory.Core().V1().Nodes()
+		k.nodeLister = nodeInformer.Lister()
+		syncFuncs = append(syncFuncs, nodeInformer.Informer().HasSynced)
+		nodeInformerFactory.Start(stop)
+	}
+
 	ok := cache.WaitForCacheSync(stop, syncFuncs...)
 	if !ok && ctx.Err() == nil {
 		// if initError is non-nil, the kube resource client will panic
@@ -92,6 +117,14 @@ func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsList
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister {
+	return k.endpointSlicesLister[ns]
+}
+
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
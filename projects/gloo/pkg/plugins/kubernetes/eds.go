@@ -19,6 +19,7 @@ import (
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -77,7 +78,7 @@ func (pm *podMap) getPodLabelsForIp(ip string, podName, podNamespace string) (ma
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, useEndpointSlices(p.settings), useNodeLocality(p.settings))
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts, p.settings)
 	if err != nil {
@@ -113,7 +114,12 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack, useEndpointSlices(settings), useNodeLocality(settings)), nil
+}
+
+// Returns true when the endpoints of kube upstreams are discovered from EndpointSlices rather than Endpoints.
+func useEndpointSlices(settings *v1.Settings) bool {
+	return settings.GetKubernetes().GetEndpointsSource() == v1.Settings_KubernetesConfiguration_ENDPOINT_SLICES
 }
 
 type edsWatcher struct {
@@ -121,10 +127,12 @@ type edsWatcher struct {
 	kubeShareFactory  KubePluginSharedFactory
 	kubeCoreCache     corecache.KubeCoreCache
 	namespaces        []string
+	endpointSlices    bool
+	nodeLocality      bool
 	lastEndpointsHash uint64
 }
 
-func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
+func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList, endpointSlices, nodeLocality bool) *edsWatcher {
 	upstreamSpecs := make(map[*core.ResourceRef]*kubeplugin.UpstreamSpec)
 	for _, us := range upstreams {
 		kubeUpstream, ok := us.GetUpstreamType().(*v1.Upstream_Kube)
@@ -139,11 +147,14 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 		kubeShareFactory: kubeShareFactory,
 		kubeCoreCache:    kubeCoreCache,
 		namespaces:       namespaces,
+		endpointSlices:   endpointSlices,
+		nodeLocality:     nodeLocality,
 	}
 }
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*discoveryv1.EndpointSlice
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -166,6 +177,15 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if c.endpointSlices {
+			endpointSlices, err := c.kubeShareFactory.EndpointSlicesLister(ns).List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, endpointSlices...)
+			continue
+		}
+
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
@@ -173,7 +193,22 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		endpointList = append(endpointList, endpoints...)
 	}
 
-	eps, warns, errsToLog := filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	var nodeLocalities map[string]*v1.Locality
+	if c.nodeLocality && c.kubeShareFactory.NodeLister() != nil {
+		nodes, err := c.kubeShareFactory.NodeLister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		nodeLocalities = generateNodeLocalities(nodes)
+	}
+
+	var eps v1.EndpointList
+	var warns, errsToLog []string
+	if c.endpointSlices {
+		eps, warns, errsToLog = filterEndpointSlices(ctx, writeNamespace, endpointSliceList, serviceList, podList, nodeLocalities, c.upstreams)
+	} else {
+		eps, warns, errsToLog = filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, nodeLocalities, c.upstreams)
+	}
 
 	warnsToLog = append(warnsToLog, warns...)
 
@@ -294,12 +329,14 @@ func filterEndpoints(
 	kubeEndpoints []*kubev1.Endpoints,
 	services []*kubev1.Service,
 	pods []*kubev1.Pod,
+	nodeLocalities map[string]*v1.Locality,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
 ) (v1.EndpointList, []string, []string) {
 	var endpoints v1.EndpointList
 
 	var warnsToLog, errorsToLog []string
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsDetails := make(map[Epkey]*endpointDetails)
 	podMap := generatePodsMap(pods)
 
 	istioIntegrationEnabled := isIstioIntegrationEnabled()
@@ -314,16 +351,7 @@ func filterEndpoints(
 
 		// Istio uses the service's port for routing requests
 		if istioIntegrationEnabled {
-			hostname := fmt.Sprintf("%v.%v", spec.GetServiceName(), spec.GetServiceNamespace())
-			copyRef := *usRef
-			key := Epkey{
-				Address:     hostname,
-				Port:        uint32(kubeServicePort.Port),
-				Name:        spec.GetServiceName(),
-				Namespace:   spec.GetServiceNamespace(),
-				UpstreamRef: &copyRef,
-			}
-			endpointsMap[key] = append(endpointsMap[key], &copyRef)
+			addServiceHostnameEndpoint(spec, usRef, kubeServicePort, endpointsMap)
 			continue
 		}
 
@@ -339,51 +367,91 @@ func filterEndpoints(
 					continue
 				}
 
-				warnings := processSubsetAddresses(subset, spec, podMap, usRef, port, endpointsMap)
+				warnings := processSubsetAddresses(subset, spec, podMap, nodeLocalities, usRef, port, endpointsMap, endpointsDetails)
 				warnsToLog = append(warnsToLog, warnings...)
 			}
 		}
 	}
 
-	endpoints = generateFilteredEndpointList(endpointsMap, services, podMap, writeNamespace, endpoints, istioIntegrationEnabled)
+	endpoints = generateFilteredEndpointList(endpointsMap, endpointsDetails, services, podMap, writeNamespace, endpoints, istioIntegrationEnabled)
 
 	return endpoints, warnsToLog, errorsToLog
 }
 
-func processSubsetAddresses(subset kubev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, pods *podMap, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef) []string {
+// adds a single endpoint for the hostname of the service, used by the Istio integration
+func addServiceHostnameEndpoint(spec *kubeplugin.UpstreamSpec, usRef *core.ResourceRef, kubeServicePort *kubev1.ServicePort, endpointsMap map[Epkey][]*core.ResourceRef) {
+	hostname := fmt.Sprintf("%v.%v", spec.GetServiceName(), spec.GetServiceNamespace())
+	copyRef := *usRef
+	key := Epkey{
+		Address:     hostname,
+		Port:        uint32(kubeServicePort.Port),
+		Name:        spec.GetServiceName(),
+		Namespace:   spec.GetServiceNamespace(),
+		UpstreamRef: &copyRef,
+	}
+	endpointsMap[key] = append(endpointsMap[key], &copyRef)
+}
+
+func processSubsetAddresses(
+	subset kubev1.EndpointSubset,
+	spec *kubeplugin.UpstreamSpec,
+	pods *podMap,
+	nodeLocalities map[string]*v1.Locality,
+	usRef *core.ResourceRef,
+	port uint32,
+	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsDetails map[Epkey]*endpointDetails,
+) []string {
 	var warnings []string
 	for _, addr := range subset.Addresses {
-		var podName, podNamespace string
-		targetRef := addr.TargetRef
-		if targetRef != nil {
-			if targetRef.Kind == "Pod" {
-				podName = targetRef.Name
-				podNamespace = targetRef.Namespace
-			}
+		key, selected, err := endpointKeyForAddress(addr.IP, addr.TargetRef, spec, pods, usRef, port)
+		if err != nil {
+			warnings = append(warnings, err.Error())
+			continue
 		}
-		if len(spec.GetSelector()) != 0 {
-			// determine whether labels for the owner of this ip (pod) matches the spec
-			podLabels, err := pods.getPodLabelsForIp(addr.IP, podName, podNamespace)
-			if err != nil {
-				// pod not found for IP? what's that about?
-				warnings = append(warnings, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err))
-				continue
-			}
-			if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
-				continue
-			}
-			// pod hasn't been assigned address yet
-			if addr.IP == "" {
-				continue
-			}
+		if !selected {
+			continue
 		}
-		key := Epkey{addr.IP, port, podName, podNamespace, usRef}
 		copyRef := *usRef
 		endpointsMap[key] = append(endpointsMap[key], &copyRef)
+
+		if addr.NodeName != nil {
+			if locality, ok := nodeLocalities[*addr.NodeName]; ok {
+				endpointsDetails[key] = &endpointDetails{locality: locality.Clone().(*v1.Locality)}
+			}
+		}
 	}
 	return warnings
 }
 
+// Returns the key of the endpoint for the address, and false if the address does not belong to a pod selected by
+// the upstream.
+func endpointKeyForAddress(ip string, targetRef *kubev1.ObjectReference, spec *kubeplugin.UpstreamSpec, pods *podMap, usRef *core.ResourceRef, port uint32) (Epkey, bool, error) {
+	var podName, podNamespace string
+	if targetRef != nil {
+		if targetRef.Kind == "Pod" {
+			podName = targetRef.Name
+			podNamespace = targetRef.Namespace
+		}
+	}
+	if len(spec.GetSelector()) != 0 {
+		// determine whether labels for the owner of this ip (pod) matches the spec
+		podLabels, err := pods.getPodLabelsForIp(ip, podName, podNamespace)
+		if err != nil {
+			// pod not found for IP? what's that about?
+			return Epkey{}, false, errors.Errorf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err)
+		}
+		if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
+			return Epkey{}, false, nil
+		}
+		// pod hasn't been assigned address yet
+		if ip == "" {
+			return Epkey{}, false, nil
+		}
+	}
+	return Epkey{ip, port, podName, podNamespace, usRef}, true, nil
+}
+
 func findFirstPortInEndpointSubsets(subset kubev1.EndpointSubset, singlePortService bool, kubeServicePort *kubev1.ServicePort) uint32 {
 	var port uint32
 	for _, p := range subset.Ports {
@@ -402,6 +470,7 @@ func findFirstPortInEndpointSubsets(subset kubev1.EndpointSubset, singlePortServ
 
 func generateFilteredEndpointList(
 	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsDetails map[Epkey]*endpointDetails,
 	services []*kubev1.Service,
 	pods *podMap,
 	writeNamespace string,
@@ -433,6 +502,10 @@ func generateFilteredEndpointList(
 			podLabels, _ := pods.getPodLabelsForIp(addr.Address, addr.Name, addr.Namespace)
 			ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, podLabels)
 		}
+		if details, ok := endpointsDetails[addr]; ok {
+			ep.Locality = details.locality
+			ep.HealthStatus = details.healthStatus
+		}
 		endpoints = append(endpoints, ep)
 	}
 
@@ -8,11 +8,19 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubev1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 var _ = Describe("Eds", func() {
@@ -54,6 +62,299 @@ var _ = Describe("Eds", func() {
 
 	})
 
+	Context("EndpointSlices", func() {
+
+		var (
+			upstream       *v1.Upstream
+			service        *corev1.Service
+			nodeLocalities map[string]*v1.Locality
+		)
+
+		BeforeEach(func() {
+			nodeLocalities = nil
+			upstream = v1.NewUpstream("gloo-system", "default-petstore-8080")
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "petstore",
+					ServiceNamespace: "default",
+					ServicePort:      8080,
+				},
+			}
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "petstore", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 8080},
+						{Name: "metrics", Port: 9090},
+					},
+				},
+			}
+		})
+
+		boolPtr := func(b bool) *bool { return &b }
+		stringPtr := func(s string) *string { return &s }
+		int32Ptr := func(i int32) *int32 { return &i }
+
+		endpointSlice := func(name string, addressType discoveryv1.AddressType, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "petstore"},
+				},
+				AddressType: addressType,
+				Endpoints:   endpoints,
+				Ports: []discoveryv1.EndpointPort{
+					{Name: stringPtr("metrics"), Port: int32Ptr(9091)},
+					{Name: stringPtr("http"), Port: int32Ptr(8081)},
+				},
+			}
+		}
+
+		filter := func(slices ...*discoveryv1.EndpointSlice) (v1.EndpointList, []string, []string) {
+			upstreams := map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				upstream.GetMetadata().Ref(): upstream.GetKube(),
+			}
+			return filterEndpointSlices(context.TODO(), "gloo-system", slices, []*corev1.Service{service}, nil, nodeLocalities, upstreams)
+		}
+
+		It("discovers the endpoints of the service port with their zone", func() {
+			eps, warns, errs := filter(endpointSlice("petstore-abcde", discoveryv1.AddressTypeIPv4,
+				discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(true)},
+					Zone:       stringPtr("us-east-1a"),
+				},
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.2"},
+				},
+			))
+			Expect(warns).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+			Expect(eps).To(HaveLen(2))
+
+			Expect(eps[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(eps[0].GetPort()).To(Equal(uint32(8081)))
+			Expect(eps[0].GetLocality()).To(Equal(&v1.Locality{Zone: "us-east-1a"}))
+			Expect(eps[0].GetHealthStatus()).To(Equal(envoycore.HealthStatus_UNKNOWN))
+			Expect(eps[0].GetUpstreams()).To(ConsistOf(upstream.GetMetadata().Ref()))
+
+			Expect(eps[1].GetAddress()).To(Equal("10.0.0.2"))
+			Expect(eps[1].GetLocality()).To(BeNil())
+		})
+
+		It("sets the locality from the labels of the node, then from the zone and the zone hints", func() {
+			nodeLocalities = map[string]*v1.Locality{
+				"node-1": {Region: "us-east-1", Zone: "us-east-1a", SubZone: "rack-1"},
+			}
+			eps, _, _ := filter(endpointSlice("petstore-abcde", discoveryv1.AddressTypeIPv4,
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.1"},
+					NodeName:  stringPtr("node-1"),
+					Zone:      stringPtr("us-east-1a"),
+				},
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.2"},
+					NodeName:  stringPtr("node-2"),
+					Zone:      stringPtr("us-east-1b"),
+				},
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.3"},
+					Hints:     &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "us-east-1c"}}},
+				},
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.4"},
+					Hints:     &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "us-east-1a"}, {Name: "us-east-1b"}}},
+				},
+			))
+			Expect(eps).To(HaveLen(4))
+			localities := map[string]*v1.Locality{}
+			for _, ep := range eps {
+				localities[ep.GetAddress()] = ep.GetLocality()
+			}
+			Expect(localities).To(Equal(map[string]*v1.Locality{
+				"10.0.0.1": {Region: "us-east-1", Zone: "us-east-1a", SubZone: "rack-1"},
+				"10.0.0.2": {Zone: "us-east-1b"},
+				"10.0.0.3": {Zone: "us-east-1c"},
+				"10.0.0.4": nil,
+			}))
+		})
+
+		It("drains the terminating endpoints which are still serving", func() {
+			eps, _, _ := filter(endpointSlice("petstore-abcde", discoveryv1.AddressTypeIPv4,
+				discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)},
+				},
+				discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(false), Terminating: boolPtr(true)},
+				},
+				discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.3"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(false)},
+				},
+			))
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(eps[0].GetHealthStatus()).To(Equal(envoycore.HealthStatus_DRAINING))
+		})
+
+		It("discovers the endpoints which are in several slices once, ready if they are ready in any of them", func() {
+			eps, _, _ := filter(
+				endpointSlice("petstore-abcde", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)},
+				}),
+				endpointSlice("petstore-fghij", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(true)},
+				}),
+			)
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetUpstreams()).To(ConsistOf(upstream.GetMetadata().Ref()))
+			Expect(eps[0].GetHealthStatus()).To(Equal(envoycore.HealthStatus_UNKNOWN))
+		})
+
+		It("discovers the endpoints of both IP families of dual-stack services", func() {
+			ready := discoveryv1.EndpointConditions{Ready: boolPtr(true)}
+			slices := []*discoveryv1.EndpointSlice{
+				endpointSlice("petstore-ipv4", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: ready}),
+				endpointSlice("petstore-ipv6", discoveryv1.AddressTypeIPv6, discoveryv1.Endpoint{Addresses: []string{"fd00::1"}, Conditions: ready}),
+				endpointSlice("petstore-fqdn", discoveryv1.AddressTypeFQDN, discoveryv1.Endpoint{Addresses: []string{"petstore.example.com"}, Conditions: ready}),
+			}
+
+			service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}
+			eps, warns, _ := filter(slices...)
+			Expect(eps).To(HaveLen(2))
+			Expect([]string{eps[0].GetAddress(), eps[1].GetAddress()}).To(ConsistOf("10.0.0.1", "fd00::1"))
+			Expect(warns).To(ConsistOf(ContainSubstring("ignoring endpoint slice petstore-fqdn")))
+
+			service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+			eps, _, _ = filter(slices...)
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetAddress()).To(Equal("10.0.0.1"))
+		})
+
+		It("ignores the endpoint slices of other services", func() {
+			slice := endpointSlice("other-abcde", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}})
+			slice.Labels[discoveryv1.LabelServiceName] = "other"
+			eps, _, _ := filter(slice)
+			Expect(eps).To(BeEmpty())
+		})
+
+		It("lists the endpoint slices when configured in the settings", func() {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			Expect(indexer.Add(service)).NotTo(HaveOccurred())
+			sliceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			Expect(sliceIndexer.Add(endpointSlice("petstore-abcde", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}))).NotTo(HaveOccurred())
+			podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+			mockCache.EXPECT().NamespacedServiceLister("default").Return(kubelisters.NewServiceLister(indexer).Services("default")).AnyTimes()
+			mockCache.EXPECT().NamespacedPodLister("default").Return(kubelisters.NewPodLister(podIndexer).Pods("default"))
+			mockSharedFactory.EXPECT().EndpointSlicesLister("default").Return(discoverylisters.NewEndpointSliceLister(sliceIndexer))
+
+			settings := &v1.Settings{
+				WatchNamespaces: []string{"default"},
+				Kubernetes: &v1.Settings_KubernetesConfiguration{
+					EndpointsSource: v1.Settings_KubernetesConfiguration_ENDPOINT_SLICES,
+				},
+			}
+			watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx}, settings)
+			Expect(err).NotTo(HaveOccurred())
+			eps, err := watcher.List("gloo-system", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetAddress()).To(Equal("10.0.0.1"))
+		})
+	})
+
+	Context("Node locality", func() {
+
+		It("reads the locality of the nodes from their topology labels", func() {
+			node := func(name string, labels map[string]string) *corev1.Node {
+				return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+			}
+			Expect(generateNodeLocalities([]*corev1.Node{
+				node("node-1", map[string]string{
+					corev1.LabelTopologyRegion: "us-east-1",
+					corev1.LabelTopologyZone:   "us-east-1a",
+					subZoneLabel:               "rack-1",
+				}),
+				node("node-2", map[string]string{
+					corev1.LabelFailureDomainBetaRegion: "us-west-2",
+					corev1.LabelFailureDomainBetaZone:   "us-west-2b",
+				}),
+				node("node-3", map[string]string{"kubernetes.io/os": "linux"}),
+			})).To(Equal(map[string]*v1.Locality{
+				"node-1": {Region: "us-east-1", Zone: "us-east-1a", SubZone: "rack-1"},
+				"node-2": {Region: "us-west-2", Zone: "us-west-2b"},
+			}))
+		})
+
+		It("sets the locality of the endpoints from their node when configured in the settings", func() {
+			upstream := v1.NewUpstream("gloo-system", "default-petstore-8080")
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "petstore",
+					ServiceNamespace: "default",
+					ServicePort:      8080,
+				},
+			}
+			nodeName := "node-1"
+
+			serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			Expect(serviceIndexer.Add(&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "petstore", Namespace: "default"},
+				Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+			})).NotTo(HaveOccurred())
+			endpointsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			Expect(endpointsIndexer.Add(&corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Name: "petstore", Namespace: "default"},
+				Subsets: []corev1.EndpointSubset{{
+					Addresses: []corev1.EndpointAddress{
+						{IP: "10.0.0.1", NodeName: &nodeName},
+						{IP: "10.0.0.2"},
+					},
+					Ports: []corev1.EndpointPort{{Port: 8081}},
+				}},
+			})).NotTo(HaveOccurred())
+			podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			Expect(nodeIndexer.Add(&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   nodeName,
+					Labels: map[string]string{corev1.LabelTopologyRegion: "us-east-1", corev1.LabelTopologyZone: "us-east-1a"},
+				},
+			})).NotTo(HaveOccurred())
+
+			mockCache.EXPECT().NamespacedServiceLister("default").Return(kubelisters.NewServiceLister(serviceIndexer).Services("default")).AnyTimes()
+			mockCache.EXPECT().NamespacedPodLister("default").Return(kubelisters.NewPodLister(podIndexer).Pods("default"))
+			mockSharedFactory.EXPECT().EndpointsLister("default").Return(kubelisters.NewEndpointsLister(endpointsIndexer))
+			mockSharedFactory.EXPECT().NodeLister().Return(kubelisters.NewNodeLister(nodeIndexer)).AnyTimes()
+
+			settings := &v1.Settings{
+				WatchNamespaces: []string{"default"},
+				Kubernetes: &v1.Settings_KubernetesConfiguration{
+					LocalityFromNodeLabels: true,
+				},
+			}
+			watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx}, settings)
+			Expect(err).NotTo(HaveOccurred())
+			eps, err := watcher.List("gloo-system", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eps).To(HaveLen(2))
+			localities := map[string]*v1.Locality{}
+			for _, ep := range eps {
+				localities[ep.GetAddress()] = ep.GetLocality()
+			}
+			Expect(localities).To(Equal(map[string]*v1.Locality{
+				"10.0.0.1": {Region: "us-east-1", Zone: "us-east-1a"},
+				"10.0.0.2": nil,
+			}))
+		})
+	})
+
 	Context("Istio integration", func() {
 
 		It("isIstioIntegrationEnabled should respond correctly to ENABLE_ISTIO_INTEGRATION env var", func() {
@@ -0,0 +1,186 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// the information of an endpoint which is only reported by EndpointSlices
+type endpointDetails struct {
+	locality     *v1.Locality
+	healthStatus envoycore.HealthStatus
+}
+
+func filterEndpointSlices(
+	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
+	writeNamespace string,
+	endpointSlices []*discoveryv1.EndpointSlice,
+	services []*kubev1.Service,
+	pods []*kubev1.Pod,
+	nodeLocalities map[string]*v1.Locality,
+	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+) (v1.EndpointList, []string, []string) {
+	var endpoints v1.EndpointList
+
+	var warnsToLog, errorsToLog []string
+	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsDetails := make(map[Epkey]*endpointDetails)
+	podMap := generatePodsMap(pods)
+
+	istioIntegrationEnabled := isIstioIntegrationEnabled()
+
+	// for each upstream
+	for usRef, spec := range upstreams {
+		kubeServicePort, singlePortService := findPortForService(services, spec)
+		if kubeServicePort == nil {
+			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName()))
+			continue
+		}
+
+		// Istio uses the service's port for routing requests
+		if istioIntegrationEnabled {
+			addServiceHostnameEndpoint(spec, usRef, kubeServicePort, endpointsMap)
+			continue
+		}
+
+		service, err := getServiceForHostname("", spec.GetServiceName(), spec.GetServiceNamespace(), services)
+		if err != nil {
+			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: %v", usRef.Key(), err))
+			continue
+		}
+
+		// find each matching endpoint slice
+		for _, slice := range endpointSlices {
+			if slice.Namespace != spec.GetServiceNamespace() || slice.Labels[discoveryv1.LabelServiceName] != spec.GetServiceName() {
+				continue
+			}
+			if !serviceHasAddressType(service, slice.AddressType) {
+				if slice.AddressType == discoveryv1.AddressTypeFQDN {
+					warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: ignoring endpoint slice %v, FQDN endpoints are not supported", usRef.Key(), slice.Name))
+				}
+				continue
+			}
+
+			port := findPortInEndpointSlice(slice, singlePortService, kubeServicePort)
+			if port == 0 {
+				warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), slice.Name))
+				continue
+			}
+
+			warnings := processEndpointSliceEndpoints(slice, spec, podMap, nodeLocalities, usRef, port, endpointsMap, endpointsDetails)
+			warnsToLog = append(warnsToLog, warnings...)
+		}
+	}
+
+	endpoints = generateFilteredEndpointList(endpointsMap, endpointsDetails, services, podMap, writeNamespace, endpoints, istioIntegrationEnabled)
+
+	return endpoints, warnsToLog, errorsToLog
+}
+
+// Returns true if the endpoints of the address type can be used to reach the service.
+// Dual-stack services have an endpoint slice for each of their IP families.
+func serviceHasAddressType(service *kubev1.Service, addressType discoveryv1.AddressType) bool {
+	if addressType != discoveryv1.AddressTypeIPv4 && addressType != discoveryv1.AddressTypeIPv6 {
+		return false
+	}
+	// the IP families are only set on services which have a cluster IP
+	if len(service.Spec.IPFamilies) == 0 {
+		return true
+	}
+	for _, ipFamily := range service.Spec.IPFamilies {
+		if string(ipFamily) == string(addressType) {
+			return true
+		}
+	}
+	return false
+}
+
+func processEndpointSliceEndpoints(
+	slice *discoveryv1.EndpointSlice,
+	spec *kubeplugin.UpstreamSpec,
+	pods *podMap,
+	nodeLocalities map[string]*v1.Locality,
+	usRef *core.ResourceRef,
+	port uint32,
+	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsDetails map[Epkey]*endpointDetails,
+) []string {
+	var warnings []string
+	for _, endpoint := range slice.Endpoints {
+		healthStatus, ok := healthStatusForConditions(endpoint.Conditions)
+		if !ok || len(endpoint.Addresses) == 0 {
+			continue
+		}
+
+		// the addresses of an endpoint are fungible, so only the first one is used
+		key, selected, err := endpointKeyForAddress(endpoint.Addresses[0], endpoint.TargetRef, spec, pods, usRef, port)
+		if err != nil {
+			warnings = append(warnings, err.Error())
+			continue
+		}
+		if !selected {
+			continue
+		}
+		// an endpoint can be in several slices of the service, e.g. while it moves between them,
+		// in which case it is only draining if it is not ready in any of them
+		if _, ok := endpointsMap[key]; !ok {
+			copyRef := *usRef
+			endpointsMap[key] = []*core.ResourceRef{&copyRef}
+		} else if endpointsDetails[key].healthStatus != envoycore.HealthStatus_DRAINING {
+			continue
+		}
+
+		endpointsDetails[key] = &endpointDetails{
+			locality:     localityForEndpointSliceEndpoint(endpoint, nodeLocalities),
+			healthStatus: healthStatus,
+		}
+	}
+	return warnings
+}
+
+// Returns the health status of an endpoint with the conditions, and false if the endpoint cannot receive traffic.
+// Ready endpoints are healthy. Terminating endpoints which still serve requests are draining, so that Envoy
+// completes the requests in flight, but only sends them new requests if there are no ready endpoints left.
+func healthStatusForConditions(conditions discoveryv1.EndpointConditions) (envoycore.HealthStatus, bool) {
+	// unknown conditions must be interpreted as ready and serving
+	ready := conditions.Ready == nil || *conditions.Ready
+	serving := ready
+	if conditions.Serving != nil {
+		serving = *conditions.Serving
+	}
+	terminating := conditions.Terminating != nil && *conditions.Terminating
+
+	switch {
+	case ready:
+		return envoycore.HealthStatus_UNKNOWN, true
+	case serving && terminating:
+		return envoycore.HealthStatus_DRAINING, true
+	default:
+		return envoycore.HealthStatus_UNKNOWN, false
+	}
+}
+
+func findPortInEndpointSlice(slice *discoveryv1.EndpointSlice, singlePortService bool, kubeServicePort *kubev1.ServicePort) uint32 {
+	for _, p := range slice.Ports {
+		if p.Port == nil {
+			continue
+		}
+		// if the endpoint port is not named, it implies that
+		// the kube service only has a single unnamed port as well.
+		var name string
+		if p.Name != nil {
+			name = *p.Name
+		}
+		if singlePortService || name == kubeServicePort.Name {
+			return uint32(*p.Port)
+		}
+	}
+	return 0
+}
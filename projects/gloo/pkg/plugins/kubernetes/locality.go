@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// the label of the nodes used by Istio for the sub zone of their locality, Kubernetes has no standard label for it
+const subZoneLabel = "topology.istio.io/subzone"
+
+// Returns true when the locality of the endpoints of kube upstreams is set from the labels of the nodes of their pods.
+func useNodeLocality(settings *v1.Settings) bool {
+	return settings.GetKubernetes().GetLocalityFromNodeLabels()
+}
+
+// Returns the locality of each node which has topology labels, by node name.
+func generateNodeLocalities(nodes []*kubev1.Node) map[string]*v1.Locality {
+	nodeLocalities := make(map[string]*v1.Locality)
+	for _, node := range nodes {
+		if locality := localityForNode(node); locality != nil {
+			nodeLocalities[node.Name] = locality
+		}
+	}
+	return nodeLocalities
+}
+
+// Returns the locality of the node from its topology labels, or nil if the node has none.
+// The deprecated failure-domain labels are used when the topology labels are missing.
+func localityForNode(node *kubev1.Node) *v1.Locality {
+	nodeLabels := node.GetLabels()
+	locality := &v1.Locality{
+		Region:  nodeLabels[kubev1.LabelTopologyRegion],
+		Zone:    nodeLabels[kubev1.LabelTopologyZone],
+		SubZone: nodeLabels[subZoneLabel],
+	}
+	if locality.GetRegion() == "" {
+		locality.Region = nodeLabels[kubev1.LabelFailureDomainBetaRegion]
+	}
+	if locality.GetZone() == "" {
+		locality.Zone = nodeLabels[kubev1.LabelFailureDomainBetaZone]
+	}
+	if locality.GetRegion() == "" && locality.GetZone() == "" && locality.GetSubZone() == "" {
+		return nil
+	}
+	return locality
+}
+
+// Returns the locality of the endpoint of an EndpointSlice. The labels of its node take precedence, then its zone,
+// and finally the zone it is hinted for when there is a single one.
+func localityForEndpointSliceEndpoint(endpoint discoveryv1.Endpoint, nodeLocalities map[string]*v1.Locality) *v1.Locality {
+	if endpoint.NodeName != nil {
+		if locality, ok := nodeLocalities[*endpoint.NodeName]; ok {
+			return locality.Clone().(*v1.Locality)
+		}
+	}
+	if endpoint.Zone != nil && *endpoint.Zone != "" {
+		return &v1.Locality{Zone: *endpoint.Zone}
+	}
+	if endpoint.Hints != nil && len(endpoint.Hints.ForZones) == 1 && endpoint.Hints.ForZones[0].Name != "" {
+		return &v1.Locality{Zone: endpoint.Hints.ForZones[0].Name}
+	}
+	return nil
+}
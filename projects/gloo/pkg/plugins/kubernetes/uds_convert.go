@@ -14,6 +14,7 @@ import (
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
@@ -65,6 +66,10 @@ func (uc *KubeUpstreamConverter) CreateUpstream(ctx context.Context, svc *kubev1
 		},
 	}
 
+	if svc.Spec.Type == kubev1.ServiceTypeExternalName {
+		us.UpstreamType = externalNameUpstreamType(svc, port)
+	}
+
 	for _, sc := range uc.serviceConverters {
 		if err := sc.ConvertService(ctx, svc, port, us); err != nil {
 			contextutils.LoggerFrom(ctx).Errorf("error: failed to process service options with err %v", err)
@@ -74,6 +79,19 @@ func (uc *KubeUpstreamConverter) CreateUpstream(ctx context.Context, svc *kubev1
 	return us
 }
 
+// ExternalName services are DNS aliases for a host outside of the cluster, so they have no endpoints to discover.
+// Their upstreams are static upstreams which resolve the external name with DNS.
+func externalNameUpstreamType(svc *kubev1.Service, port kubev1.ServicePort) *v1.Upstream_Static {
+	return &v1.Upstream_Static{
+		Static: &static.UpstreamSpec{
+			Hosts: []*static.Host{{
+				Addr: svc.Spec.ExternalName,
+				Port: uint32(port.Port),
+			}},
+		},
+	}
+}
+
 func UpstreamName(serviceNamespace, serviceName string, servicePort int32) string {
 	return sanitizer.SanitizeNameV2(fmt.Sprintf("%s-%s-%v", serviceNamespace, serviceName, servicePort))
 }
@@ -109,18 +127,27 @@ func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 }
 
 func UpdateUpstream(original, desired *v1.Upstream) (didChange bool, err error) {
-	originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_Kube)
-	if !ok {
-		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube, got %v", reflect.TypeOf(original.GetUpstreamType()).Name())
-	}
-	desiredSpec, ok := desired.GetUpstreamType().(*v1.Upstream_Kube)
-	if !ok {
-		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube, got %v", reflect.TypeOf(original.GetUpstreamType()).Name())
+	// the upstream type changes when the type of the service changes from or to ExternalName, in which case the
+	// desired spec replaces the original one
+	switch desiredSpec := desired.GetUpstreamType().(type) {
+	case *v1.Upstream_Kube:
+		if originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_Kube); ok {
+			// copy service spec, we don't want to overwrite that
+			desiredSpec.Kube.ServiceSpec = originalSpec.Kube.GetServiceSpec()
+			// copy labels; user may have written them over. cannot be auto-discovered
+			desiredSpec.Kube.Selector = originalSpec.Kube.GetSelector()
+		}
+	case *v1.Upstream_Static:
+		if originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_Static); ok {
+			// copy service spec, we don't want to overwrite that
+			desiredSpec.Static.ServiceSpec = originalSpec.Static.GetServiceSpec()
+			if desiredSpec.Static.GetDnsOptions() == nil {
+				desiredSpec.Static.DnsOptions = originalSpec.Static.GetDnsOptions()
+			}
+		}
+	default:
+		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube or *v1.Upstream_Static, got %v", reflect.TypeOf(desired.GetUpstreamType()).Name())
 	}
-	// copy service spec, we don't want to overwrite that
-	desiredSpec.Kube.ServiceSpec = originalSpec.Kube.GetServiceSpec()
-	// copy labels; user may have written them over. cannot be auto-discovered
-	desiredSpec.Kube.Selector = originalSpec.Kube.GetSelector()
 
 	utils.UpdateUpstream(original, desired)
 
@@ -13,10 +13,12 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	kubev1 "k8s.io/api/core/v1"
 
@@ -45,6 +47,45 @@ var _ = Describe("UdsConvert", func() {
 		Expect(name).ToNot(Equal(name2))
 	})
 
+	Context("ExternalName services", func() {
+		var svc *kubev1.Service
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "payments",
+					Namespace: "default",
+					Annotations: map[string]string{
+						serviceconverter.GlooAnnotationPrefix: `{"connectionConfig": {"maxRequestsPerConnection": 5}}`,
+					},
+				},
+				Spec: kubev1.ServiceSpec{
+					Type:         kubev1.ServiceTypeExternalName,
+					ExternalName: "payments.example.com",
+				},
+			}
+		})
+
+		It("should create a static upstream resolving the external name", func() {
+			up := uc.CreateUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 443, TargetPort: intstr.FromInt(443)})
+			Expect(up.GetMetadata().GetName()).To(Equal("default-payments-443"))
+			Expect(up.GetKube()).To(BeNil())
+			Expect(up.GetStatic()).To(Equal(&static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "payments.example.com", Port: 443}},
+			}))
+			Expect(up.GetConnectionConfig().GetMaxRequestsPerConnection()).To(BeEquivalentTo(5))
+		})
+
+		It("should use the service port rather than the target port", func() {
+			up := uc.CreateUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80, TargetPort: intstr.FromInt(8080)})
+			Expect(up.GetMetadata().GetName()).To(Equal("default-payments-80"))
+			Expect(up.GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(80))
+
+			up = uc.CreateUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80, TargetPort: intstr.FromString("http")})
+			Expect(up.GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(80))
+		})
+	})
+
 	Context("h2 upstream", func() {
 		It("should not normally create upstream with grpc service spec", func() {
 			svc := &kubev1.Service{
@@ -2,7 +2,10 @@ package kubernetes_test
 
 import (
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	gloov1kube "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	gloov1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	. "github.com/onsi/ginkgo"
@@ -59,4 +62,48 @@ var _ = Describe("Uds", func() {
 		Expect(desired.SslConfig).To(BeIdenticalTo(desiredSslConfig))
 	})
 
+	It("should preserve the service spec of the upstreams of ExternalName services", func() {
+		serviceSpec := &options.ServiceSpec{
+			PluginType: &options.ServiceSpec_Rest{Rest: &rest.ServiceSpec{}},
+		}
+		desired := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &gloov1static.UpstreamSpec{
+					Hosts: []*gloov1static.Host{{Addr: "payments.example.com", Port: 443}},
+				},
+			},
+		}
+		original := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &gloov1static.UpstreamSpec{
+					Hosts:       []*gloov1static.Host{{Addr: "payments.example.com", Port: 443}},
+					ServiceSpec: serviceSpec,
+				},
+			},
+		}
+		updated, err := UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeFalse())
+		Expect(desired.GetStatic().GetServiceSpec()).To(BeIdenticalTo(serviceSpec))
+	})
+
+	It("should replace the upstream when the service becomes an ExternalName service", func() {
+		desired := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &gloov1static.UpstreamSpec{
+					Hosts: []*gloov1static.Host{{Addr: "payments.example.com", Port: 443}},
+				},
+			},
+		}
+		original := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Kube{
+				Kube: &gloov1kube.UpstreamSpec{ServiceName: "payments"},
+			},
+		}
+		updated, err := UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		Expect(desired.GetKube()).To(BeNil())
+	})
+
 })
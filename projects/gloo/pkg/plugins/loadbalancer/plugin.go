@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -20,12 +21,17 @@ var (
 
 const (
 	ExtensionName = "load_balancer"
+
+	// runtime keys which can be used to override the slow start aggression and the active request bias at runtime
+	SlowStartAggressionRuntimeKey = "upstream.slow_start.aggression"
+	ActiveRequestBiasRuntimeKey   = "upstream.least_request.active_request_bias"
 )
 
 var (
 	InvalidRouteTypeError = func(e error) error {
 		return eris.Wrapf(e, "cannot use lbhash plugin on non-Route_Route route actions")
 	}
+	PreferSameZoneWithLocalityConfigError = eris.New("cannot use preferSameZone with the localityConfig of the loadBalancerConfig")
 )
 
 type plugin struct{}
@@ -90,7 +96,7 @@ func getHashPoliciesFromSpec(spec []*lbhash.HashPolicy) []*envoy_config_route_v3
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
 
 	cfg := in.GetLoadBalancerConfig()
-	if cfg == nil {
+	if cfg == nil && in.GetPreferSameZone() == nil {
 		return nil
 	}
 
@@ -110,25 +116,40 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
 					LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
 				}
+			case *v1.LoadBalancerConfig_ZoneAwareLbConfig_:
+				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+					ZoneAwareLbConfig: getZoneAwareLbConfig(cfg.GetZoneAwareLbConfig()),
+				}
 			}
 		}
 	}
 
+	if in.GetPreferSameZone() != nil {
+		if cfg.GetLocalityConfig() != nil {
+			return PreferSameZoneWithLocalityConfigError
+		}
+		if out.GetCommonLbConfig() == nil {
+			out.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+			ZoneAwareLbConfig: getPreferSameZoneLbConfig(in.GetPreferSameZone()),
+		}
+	}
+
 	if cfg.GetType() != nil {
 		switch lbtype := cfg.GetType().(type) {
 		case *v1.LoadBalancerConfig_RoundRobin_:
 			out.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
-		case *v1.LoadBalancerConfig_LeastRequest_:
-			out.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
-			if lbtype.LeastRequest.GetChoiceCount() != 0 {
-				out.LbConfig = &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
-					LeastRequestLbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{
-						ChoiceCount: &wrappers.UInt32Value{
-							Value: lbtype.LeastRequest.GetChoiceCount(),
-						},
+			if lbtype.RoundRobin.GetSlowStartConfig() != nil {
+				out.LbConfig = &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_{
+					RoundRobinLbConfig: &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{
+						SlowStartConfig: getSlowStartConfig(lbtype.RoundRobin.GetSlowStartConfig()),
 					},
 				}
 			}
+		case *v1.LoadBalancerConfig_LeastRequest_:
+			out.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+			setLeastRequestLbConfig(out, lbtype.LeastRequest)
 		case *v1.LoadBalancerConfig_Random_:
 			out.LbPolicy = envoy_config_cluster_v3.Cluster_RANDOM
 		case *v1.LoadBalancerConfig_RingHash_:
@@ -139,9 +160,87 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		}
 	}
 
+	if err := out.GetCommonLbConfig().Validate(); err != nil {
+		return err
+	}
+	switch lbConfig := out.GetLbConfig().(type) {
+	case *envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_:
+		return lbConfig.RoundRobinLbConfig.Validate()
+	case *envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_:
+		return lbConfig.LeastRequestLbConfig.Validate()
+	}
 	return nil
 }
 
+func setLeastRequestLbConfig(out *envoy_config_cluster_v3.Cluster, userConfig *v1.LoadBalancerConfig_LeastRequest) {
+	if userConfig.GetChoiceCount() == 0 && userConfig.GetSlowStartConfig() == nil && userConfig.GetActiveRequestBias() == nil {
+		return
+	}
+	cfg := &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{
+		SlowStartConfig: getSlowStartConfig(userConfig.GetSlowStartConfig()),
+	}
+	if userConfig.GetChoiceCount() != 0 {
+		cfg.ChoiceCount = &wrappers.UInt32Value{
+			Value: userConfig.GetChoiceCount(),
+		}
+	}
+	if userConfig.GetActiveRequestBias() != nil {
+		cfg.ActiveRequestBias = &envoy_config_core_v3.RuntimeDouble{
+			DefaultValue: userConfig.GetActiveRequestBias().GetValue(),
+			RuntimeKey:   ActiveRequestBiasRuntimeKey,
+		}
+	}
+	out.LbConfig = &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
+		LeastRequestLbConfig: cfg,
+	}
+}
+
+func getSlowStartConfig(userConfig *v1.LoadBalancerConfig_SlowStartConfig) *envoy_config_cluster_v3.Cluster_SlowStartConfig {
+	if userConfig == nil {
+		return nil
+	}
+	cfg := &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+		SlowStartWindow: userConfig.GetSlowStartWindow(),
+	}
+	if userConfig.GetAggression() != nil {
+		cfg.Aggression = &envoy_config_core_v3.RuntimeDouble{
+			DefaultValue: userConfig.GetAggression().GetValue(),
+			RuntimeKey:   SlowStartAggressionRuntimeKey,
+		}
+	}
+	if userConfig.GetMinWeightPercent() != nil {
+		cfg.MinWeightPercent = &envoy_type_v3.Percent{
+			Value: userConfig.GetMinWeightPercent().GetValue(),
+		}
+	}
+	return cfg
+}
+
+func getZoneAwareLbConfig(userConfig *v1.LoadBalancerConfig_ZoneAwareLbConfig) *envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig {
+	cfg := &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		MinClusterSize:     userConfig.GetMinClusterSize(),
+		FailTrafficOnPanic: userConfig.GetFailTrafficOnPanic(),
+	}
+	if userConfig.GetRoutingEnabled() != nil {
+		cfg.RoutingEnabled = &envoy_type_v3.Percent{
+			Value: userConfig.GetRoutingEnabled().GetValue(),
+		}
+	}
+	return cfg
+}
+
+// Envoy's zone aware routing sends all the requests it can to the zone of the proxy, and the rest to the other zones.
+func getPreferSameZoneLbConfig(userConfig *v1.PreferSameZone) *envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig {
+	minClusterSize := userConfig.GetMinEndpoints()
+	if minClusterSize == nil {
+		minClusterSize = &wrappers.UInt64Value{Value: 1}
+	}
+	return &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		RoutingEnabled: &envoy_type_v3.Percent{Value: 100},
+		MinClusterSize: minClusterSize,
+	}
+}
+
 func setRingHashLbConfig(out *envoy_config_cluster_v3.Cluster, userConfig *v1.LoadBalancerConfig_RingHashConfig) {
 	cfg := &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
 		RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{},
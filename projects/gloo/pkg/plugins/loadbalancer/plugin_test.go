@@ -6,7 +6,9 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -16,6 +18,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	. "github.com/solo-io/solo-kit/test/matchers"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 )
 
@@ -98,6 +101,30 @@ var _ = Describe("Plugin", func() {
 			Expect(out.LbPolicy).To(Equal(envoy_config_cluster_v3.Cluster_LEAST_REQUEST))
 			Expect(out.GetLeastRequestLbConfig()).To(BeNil())
 		})
+		It("should set slow start config and active request bias", func() {
+			upstream.LoadBalancerConfig.GetLeastRequest().SlowStartConfig = &v1.LoadBalancerConfig_SlowStartConfig{
+				SlowStartWindow: prototime.DurationToProto(time.Minute),
+				Aggression:      &wrappers.DoubleValue{Value: 2},
+			}
+			upstream.LoadBalancerConfig.GetLeastRequest().ActiveRequestBias = &wrappers.DoubleValue{Value: 0.5}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetLeastRequestLbConfig()).To(MatchProto(&envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{
+				ChoiceCount: &wrappers.UInt32Value{Value: 5},
+				ActiveRequestBias: &envoy_config_core_v3.RuntimeDouble{
+					DefaultValue: 0.5,
+					RuntimeKey:   ActiveRequestBiasRuntimeKey,
+				},
+				SlowStartConfig: &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+					SlowStartWindow: prototime.DurationToProto(time.Minute),
+					Aggression: &envoy_config_core_v3.RuntimeDouble{
+						DefaultValue: 2,
+						RuntimeKey:   SlowStartAggressionRuntimeKey,
+					},
+				},
+			}))
+		})
 	})
 
 	It("should set lb policy round robin", func() {
@@ -109,6 +136,43 @@ var _ = Describe("Plugin", func() {
 		err := plugin.ProcessUpstream(params, upstream, out)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.LbPolicy).To(Equal(envoy_config_cluster_v3.Cluster_ROUND_ROBIN))
+		Expect(out.LbConfig).To(BeNil())
+	})
+
+	It("should set round robin slow start config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			Type: &v1.LoadBalancerConfig_RoundRobin_{
+				RoundRobin: &v1.LoadBalancerConfig_RoundRobin{
+					SlowStartConfig: &v1.LoadBalancerConfig_SlowStartConfig{
+						SlowStartWindow:  prototime.DurationToProto(30 * time.Second),
+						MinWeightPercent: &wrappers.DoubleValue{Value: 20},
+					},
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.LbPolicy).To(Equal(envoy_config_cluster_v3.Cluster_ROUND_ROBIN))
+		Expect(out.GetRoundRobinLbConfig()).To(MatchProto(&envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{
+			SlowStartConfig: &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+				SlowStartWindow:  prototime.DurationToProto(30 * time.Second),
+				MinWeightPercent: &envoy_type_v3.Percent{Value: 20},
+			},
+		}))
+	})
+
+	It("should error on an invalid slow start config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			Type: &v1.LoadBalancerConfig_RoundRobin_{
+				RoundRobin: &v1.LoadBalancerConfig_RoundRobin{
+					SlowStartConfig: &v1.LoadBalancerConfig_SlowStartConfig{
+						MinWeightPercent: &wrappers.DoubleValue{Value: 150},
+					},
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
 	})
 
 	It("should set lb policy ring hash - basic config", func() {
@@ -215,6 +279,58 @@ status: {}
 			}))
 	})
 
+	It("should set locality config - zone aware lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &wrappers.DoubleValue{Value: 80},
+					MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetCommonLbConfig().GetZoneAwareLbConfig()).To(MatchProto(
+			&envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+				RoutingEnabled:     &envoy_type_v3.Percent{Value: 80},
+				MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+				FailTrafficOnPanic: true,
+			}))
+	})
+
+	It("should prefer the endpoints in the same zone", func() {
+		upstream.PreferSameZone = &v1.PreferSameZone{}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetCommonLbConfig().GetZoneAwareLbConfig()).To(MatchProto(
+			&envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+				RoutingEnabled: &envoy_type_v3.Percent{Value: 100},
+				MinClusterSize: &wrappers.UInt64Value{Value: 1},
+			}))
+
+		upstream.PreferSameZone.MinEndpoints = &wrappers.UInt64Value{Value: 6}
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			HealthyPanicThreshold: &wrappers.DoubleValue{Value: 50},
+		}
+		out = new(envoy_config_cluster_v3.Cluster)
+		err = plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetCommonLbConfig().GetHealthyPanicThreshold().GetValue()).To(BeEquivalentTo(50))
+		Expect(out.GetCommonLbConfig().GetZoneAwareLbConfig().GetMinClusterSize().GetValue()).To(BeEquivalentTo(6))
+	})
+
+	It("should not prefer the endpoints in the same zone with a locality config", func() {
+		upstream.PreferSameZone = &v1.PreferSameZone{}
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{
+				LocalityWeightedLbConfig: &empty.Empty{},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(PreferSameZoneWithLocalityConfigError))
+	})
+
 	It("should not set locality config if no config", func() {
 		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			// We include this, so that the plugin generates a CommonLbConfig object
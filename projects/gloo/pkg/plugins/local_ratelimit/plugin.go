@@ -0,0 +1,297 @@
+package local_ratelimit
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_extensions_filters_network_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	ratelimitplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var (
+	_ plugins.Plugin              = new(plugin)
+	_ plugins.HttpFilterPlugin    = new(plugin)
+	_ plugins.VirtualHostPlugin   = new(plugin)
+	_ plugins.RoutePlugin         = new(plugin)
+	_ plugins.NetworkFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName     = "local_ratelimit"
+	HttpFilterName    = "envoy.filters.http.local_ratelimit"
+	NetworkFilterName = "envoy.filters.network.local_ratelimit"
+
+	HttpStatPrefix    = "http_local_rate_limiter"
+	NetworkStatPrefix = "network_local_rate_limiter"
+)
+
+var (
+	// local rate limiting is cheap, and is meant to protect everything behind it
+	// (including the auth servers), so we run it before authentication
+	httpFilterStage = plugins.BeforeStage(plugins.AuthNStage)
+
+	// connections should be rejected before any other network filter processes them
+	networkFilterStage = plugins.BeforeStage(plugins.FaultStage)
+
+	// envoy rejects token buckets which are refilled more often than this
+	minFillInterval = 50 * time.Millisecond
+
+	MissingTokenBucketError = eris.New("token bucket must be specified for local rate limit")
+	MissingHeaderValueError = eris.New("header section of header value option cannot be nil")
+	FillIntervalTooLowError = func(interval time.Duration) error {
+		return eris.Errorf("token bucket fill interval %s is less than the minimum of %s", interval, minFillInterval)
+	}
+	InvalidStatusCodeError = func(code uint32) error {
+		return eris.Errorf("invalid status code %d for local rate limit", code)
+	}
+)
+
+type plugin struct {
+	rlServerSettings          *ratelimit.Settings
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.rlServerSettings = params.Settings.GetRatelimitServer()
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	localRateLimit := in.GetOptions().GetLocalRatelimit()
+	if localRateLimit == nil {
+		return nil
+	}
+
+	config, err := p.translateLocalRateLimit(params.HttpListener, localRateLimit)
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetVhostPerFilterConfig(out, HttpFilterName, config)
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	localRateLimit := in.GetOptions().GetLocalRatelimit()
+	if localRateLimit == nil {
+		return nil
+	}
+
+	config, err := p.translateLocalRateLimit(params.HttpListener, localRateLimit)
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetRoutePerFilterConfig(out, HttpFilterName, config)
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	localRateLimit := listener.GetOptions().GetLocalRatelimit()
+
+	_, ok := p.filterRequiredForListener[listener]
+	if !ok && localRateLimit == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	// without a token bucket, the listener-level filter does not rate limit anything
+	// and only serves the virtual host and route configurations
+	config := &envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix: HttpStatPrefix,
+	}
+	if localRateLimit != nil {
+		var err error
+		config, err = p.translateLocalRateLimit(listener, localRateLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stagedFilter, err := plugins.NewStagedFilter(HttpFilterName, config, httpFilterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func (p *plugin) NetworkFiltersHTTP(_ plugins.Params, _ *v1.HttpListener) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+func (p *plugin) NetworkFiltersTCP(_ plugins.Params, listener *v1.TcpListener) ([]plugins.StagedNetworkFilter, error) {
+	localRateLimit := listener.GetOptions().GetLocalRatelimit()
+	if localRateLimit == nil {
+		return nil, nil
+	}
+
+	tokenBucket, err := translateTokenBucket(localRateLimit.GetTokenBucket())
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_extensions_filters_network_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix:  NetworkStatPrefix,
+		TokenBucket: tokenBucket,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	networkFilter, err := translator.NewFilterWithTypedConfig(NetworkFilterName, config)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+
+	return []plugins.StagedNetworkFilter{{
+		NetworkFilter: networkFilter,
+		Stage:         networkFilterStage,
+	}}, nil
+}
+
+func (p *plugin) translateLocalRateLimit(
+	listener *v1.HttpListener,
+	in *local_ratelimit.LocalRateLimit,
+) (*envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit, error) {
+	tokenBucket, err := translateTokenBucket(in.GetTokenBucket())
+	if err != nil {
+		return nil, err
+	}
+
+	responseHeaders, err := translateResponseHeaders(in.GetResponseHeadersToAdd())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix:  HttpStatPrefix,
+		TokenBucket: tokenBucket,
+		// the filter is a no-op unless explicitly enabled and enforced
+		FilterEnabled:                         fullyEnabled("local_rate_limit_enabled"),
+		FilterEnforced:                        fullyEnabled("local_rate_limit_enforced"),
+		ResponseHeadersToAdd:                  responseHeaders,
+		LocalRateLimitPerDownstreamConnection: in.GetLocalRateLimitPerDownstreamConnection(),
+	}
+
+	if statusCode := in.GetStatusCode(); statusCode != nil {
+		if _, ok := envoy_type_v3.StatusCode_name[int32(statusCode.GetValue())]; !ok {
+			return nil, InvalidStatusCodeError(statusCode.GetValue())
+		}
+		out.Status = &envoy_type_v3.HttpStatus{
+			Code: envoy_type_v3.StatusCode(statusCode.GetValue()),
+		}
+	}
+
+	if in.GetEnableXRatelimitHeaders() {
+		out.EnableXRatelimitHeaders = envoy_extensions_common_ratelimit_v3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03
+	}
+
+	if len(in.GetDescriptors()) > 0 {
+		// descriptors are generated by the rate limit actions on the route and virtual host,
+		// which are created for the stage used by the rate limit plugin
+		out.Stage = ratelimitplugin.GetRateLimitStageForServerSettings(p.getServerSettingsForListener(listener))
+		for _, descriptor := range in.GetDescriptors() {
+			envoyDescriptor, err := translateDescriptor(descriptor)
+			if err != nil {
+				return nil, err
+			}
+			out.Descriptors = append(out.Descriptors, envoyDescriptor)
+		}
+	}
+
+	return out, out.Validate()
+}
+
+func (p *plugin) getServerSettingsForListener(listener *v1.HttpListener) *ratelimit.Settings {
+	if rlServer := listener.GetOptions().GetRatelimitServer(); rlServer != nil {
+		return rlServer
+	}
+
+	return p.rlServerSettings
+}
+
+func translateTokenBucket(in *local_ratelimit.TokenBucket) (*envoy_type_v3.TokenBucket, error) {
+	if in == nil {
+		return nil, MissingTokenBucketError
+	}
+	if fillInterval := in.GetFillInterval(); fillInterval != nil && fillInterval.AsDuration() < minFillInterval {
+		return nil, FillIntervalTooLowError(fillInterval.AsDuration())
+	}
+
+	return &envoy_type_v3.TokenBucket{
+		MaxTokens:     in.GetMaxTokens(),
+		TokensPerFill: in.GetTokensPerFill(),
+		FillInterval:  in.GetFillInterval(),
+	}, nil
+}
+
+func translateDescriptor(
+	in *local_ratelimit.LocalRateLimitDescriptor,
+) (*envoy_extensions_common_ratelimit_v3.LocalRateLimitDescriptor, error) {
+	tokenBucket, err := translateTokenBucket(in.GetTokenBucket())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoy_extensions_common_ratelimit_v3.LocalRateLimitDescriptor{
+		TokenBucket: tokenBucket,
+	}
+	for _, entry := range in.GetEntries() {
+		out.Entries = append(out.Entries, &envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{
+			Key:   entry.GetKey(),
+			Value: entry.GetValue(),
+		})
+	}
+	return out, nil
+}
+
+func translateResponseHeaders(in []*headers.HeaderValueOption) ([]*envoy_config_core_v3.HeaderValueOption, error) {
+	var out []*envoy_config_core_v3.HeaderValueOption
+	for _, h := range in {
+		if h.GetHeader() == nil {
+			return nil, MissingHeaderValueError
+		}
+		out = append(out, &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{
+				Key:   h.GetHeader().GetKey(),
+				Value: h.GetHeader().GetValue(),
+			},
+			Append: h.GetAppend(),
+		})
+	}
+	return out, nil
+}
+
+func fullyEnabled(runtimeKey string) *envoy_config_core_v3.RuntimeFractionalPercent {
+	return &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
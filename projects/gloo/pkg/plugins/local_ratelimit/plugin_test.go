@@ -0,0 +1,281 @@
+package local_ratelimit_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_extensions_filters_network_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/local_ratelimit"
+	ratelimitplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Local Rate Limit Plugin", func() {
+
+	var (
+		p            plugins.Plugin
+		httpListener *v1.HttpListener
+
+		tokenBucket      *local_ratelimit.TokenBucket
+		envoyTokenBucket *envoy_type_v3.TokenBucket
+		enabled          *envoy_config_core_v3.RuntimeFractionalPercent
+		enforced         *envoy_config_core_v3.RuntimeFractionalPercent
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{}})
+		httpListener = &v1.HttpListener{}
+
+		tokenBucket = &local_ratelimit.TokenBucket{
+			MaxTokens:     10,
+			TokensPerFill: &wrapperspb.UInt32Value{Value: 5},
+			FillInterval:  durationpb.New(time.Second),
+		}
+		envoyTokenBucket = &envoy_type_v3.TokenBucket{
+			MaxTokens:     10,
+			TokensPerFill: &wrapperspb.UInt32Value{Value: 5},
+			FillInterval:  durationpb.New(time.Second),
+		}
+		enabled = &envoy_config_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type_v3.FractionalPercent{
+				Numerator:   100,
+				Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: "local_rate_limit_enabled",
+		}
+		enforced = &envoy_config_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type_v3.FractionalPercent{
+				Numerator:   100,
+				Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: "local_rate_limit_enforced",
+		}
+	})
+
+	httpFilters := func() []plugins.StagedHttpFilter {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, httpListener)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return filters
+	}
+
+	processRoute := func(options *v1.RouteOptions) (*envoy_config_route_v3.Route, error) {
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{HttpListener: httpListener},
+		}, &v1.Route{Options: options}, out)
+		return out, err
+	}
+
+	processVirtualHost := func(options *v1.VirtualHostOptions) (*envoy_config_route_v3.VirtualHost, error) {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{
+			HttpListener: httpListener,
+		}, &v1.VirtualHost{Options: options}, out)
+		return out, err
+	}
+
+	Context("http", func() {
+
+		It("does not add the filter when local rate limiting is not configured", func() {
+			_, err := processRoute(&v1.RouteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpFilters()).To(BeEmpty())
+		})
+
+		It("translates the listener config into the filter config", func() {
+			httpListener.Options = &v1.HttpListenerOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{
+					TokenBucket: tokenBucket,
+					ResponseHeadersToAdd: []*headers.HeaderValueOption{{
+						Header: &headers.HeaderValue{Key: "x-local-rate-limit", Value: "true"},
+						Append: &wrapperspb.BoolValue{Value: false},
+					}},
+					StatusCode:                            &wrapperspb.UInt32Value{Value: 503},
+					LocalRateLimitPerDownstreamConnection: true,
+					EnableXRatelimitHeaders:               true,
+				},
+			}
+
+			expectedFilter, err := plugins.NewStagedFilter(HttpFilterName,
+				&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+					StatPrefix:     HttpStatPrefix,
+					TokenBucket:    envoyTokenBucket,
+					FilterEnabled:  enabled,
+					FilterEnforced: enforced,
+					ResponseHeadersToAdd: []*envoy_config_core_v3.HeaderValueOption{{
+						Header: &envoy_config_core_v3.HeaderValue{Key: "x-local-rate-limit", Value: "true"},
+						Append: &wrapperspb.BoolValue{Value: false},
+					}},
+					Status:                                &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode_ServiceUnavailable},
+					LocalRateLimitPerDownstreamConnection: true,
+					EnableXRatelimitHeaders:               envoy_extensions_common_ratelimit_v3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03,
+				},
+				plugins.BeforeStage(plugins.AuthNStage),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			filters := httpFilters()
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter).To(matchers.MatchProto(expectedFilter.HttpFilter))
+			Expect(filters[0].Stage).To(Equal(expectedFilter.Stage))
+		})
+
+		It("adds a pass-through listener filter when only a route is rate limited", func() {
+			out, err := processRoute(&v1.RouteOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{TokenBucket: tokenBucket},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[HttpFilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(&cfg).To(matchers.MatchProto(&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+				StatPrefix:     HttpStatPrefix,
+				TokenBucket:    envoyTokenBucket,
+				FilterEnabled:  enabled,
+				FilterEnforced: enforced,
+			}))
+
+			expectedFilter, err := plugins.NewStagedFilter(HttpFilterName,
+				&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+					StatPrefix: HttpStatPrefix,
+				},
+				plugins.BeforeStage(plugins.AuthNStage),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			filters := httpFilters()
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter).To(matchers.MatchProto(expectedFilter.HttpFilter))
+		})
+
+		It("allows virtual host specific config", func() {
+			out, err := processVirtualHost(&v1.VirtualHostOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{TokenBucket: tokenBucket},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[HttpFilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetTokenBucket()).To(matchers.MatchProto(envoyTokenBucket))
+			Expect(httpFilters()).To(HaveLen(1))
+		})
+
+		It("translates descriptors using the stage of the rate limit actions", func() {
+			httpListener.Options = &v1.HttpListenerOptions{
+				RatelimitServer: &ratelimit.Settings{RateLimitBeforeAuth: true},
+			}
+			out, err := processRoute(&v1.RouteOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{
+					TokenBucket: tokenBucket,
+					Descriptors: []*local_ratelimit.LocalRateLimitDescriptor{{
+						Entries: []*local_ratelimit.LocalRateLimitDescriptor_Entry{
+							{Key: "client_id", Value: "foo"},
+						},
+						TokenBucket: tokenBucket,
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[HttpFilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetStage()).To(Equal(ratelimitplugin.CustomStageBeforeAuth))
+			Expect(cfg.GetDescriptors()).To(HaveLen(1))
+			Expect(cfg.GetDescriptors()[0]).To(matchers.MatchProto(&envoy_extensions_common_ratelimit_v3.LocalRateLimitDescriptor{
+				Entries: []*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{
+					{Key: "client_id", Value: "foo"},
+				},
+				TokenBucket: envoyTokenBucket,
+			}))
+		})
+
+		It("errors when the token bucket is missing", func() {
+			_, err := processRoute(&v1.RouteOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{},
+			})
+			Expect(err).To(MatchError(MissingTokenBucketError))
+		})
+
+		It("errors when the fill interval is too low", func() {
+			tokenBucket.FillInterval = durationpb.New(10 * time.Millisecond)
+			_, err := processVirtualHost(&v1.VirtualHostOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{TokenBucket: tokenBucket},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("less than the minimum"))
+		})
+
+		It("errors on an invalid status code", func() {
+			_, err := processRoute(&v1.RouteOptions{
+				LocalRatelimit: &local_ratelimit.LocalRateLimit{
+					TokenBucket: tokenBucket,
+					StatusCode:  &wrapperspb.UInt32Value{Value: 42},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid status code 42"))
+		})
+	})
+
+	Context("tcp", func() {
+
+		networkFilters := func(tcpListener *v1.TcpListener) ([]plugins.StagedNetworkFilter, error) {
+			return p.(plugins.NetworkFilterPlugin).NetworkFiltersTCP(plugins.Params{}, tcpListener)
+		}
+
+		It("does not add a filter when local rate limiting is not configured", func() {
+			filters, err := networkFilters(&v1.TcpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("translates the listener config into a network filter", func() {
+			filters, err := networkFilters(&v1.TcpListener{
+				Options: &v1.TcpListenerOptions{
+					LocalRatelimit: &local_ratelimit.NetworkLocalRateLimit{TokenBucket: tokenBucket},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedFilter, err := translator.NewFilterWithTypedConfig(NetworkFilterName,
+				&envoy_extensions_filters_network_local_ratelimit_v3.LocalRateLimit{
+					StatPrefix:  NetworkStatPrefix,
+					TokenBucket: envoyTokenBucket,
+				})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].NetworkFilter).To(matchers.MatchProto(expectedFilter))
+			Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+		})
+
+		It("errors when the token bucket is missing", func() {
+			_, err := networkFilters(&v1.TcpListener{
+				Options: &v1.TcpListenerOptions{
+					LocalRatelimit: &local_ratelimit.NetworkLocalRateLimit{},
+				},
+			})
+			Expect(err).To(MatchError(MissingTokenBucketError))
+		})
+	})
+})
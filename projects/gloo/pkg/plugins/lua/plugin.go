@@ -0,0 +1,204 @@
+package lua
+
+import (
+	"sort"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_lua_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "lua"
+	FilterName    = wellknown.Lua
+)
+
+// by default, the scripts run once the request passed all the checks
+var defaultFilterStage = plugins.DuringStage(plugins.AcceptedStage)
+
+var (
+	MissingScriptError       = eris.New("a default script or named scripts must be specified for lua")
+	NamedScriptNotFoundError = func(name string) error {
+		return eris.Errorf("lua script %s is not one of the named scripts of the listener", name)
+	}
+	NoListenerLuaError = func(name string) error {
+		return eris.Errorf("lua script %s cannot be selected on a route, the listener has no lua filter", name)
+	}
+	ArtifactNotFoundError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("lua script artifact %s.%s cannot be found", ref.GetNamespace(), ref.GetName())
+	}
+	NoArtifactKeyError = func(ref *core.ResourceRef, numValues int) error {
+		return eris.Errorf("key must be provided for lua script artifact %s.%s which contains %d values",
+			ref.GetNamespace(), ref.GetName(), numValues)
+	}
+	NoArtifactDataError = func(ref *core.ResourceRef, key string) error {
+		return eris.Errorf("lua script artifact %s.%s does not contain a value for key %s",
+			ref.GetNamespace(), ref.GetName(), key)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	luaConfig := listener.GetOptions().GetLua()
+	if luaConfig == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+	if luaConfig.GetDefaultScript() == nil && len(luaConfig.GetNamedScripts()) == 0 {
+		return nil, MissingScriptError
+	}
+
+	config := &envoy_extensions_filters_http_lua_v3.Lua{
+		StatPrefix: luaConfig.GetStatPrefix(),
+	}
+	if luaConfig.GetDefaultScript() != nil {
+		defaultSourceCode, err := translateScript(params, luaConfig.GetDefaultScript())
+		if err != nil {
+			return nil, err
+		}
+		config.DefaultSourceCode = defaultSourceCode
+	}
+	if len(luaConfig.GetNamedScripts()) > 0 {
+		config.SourceCodes = make(map[string]*envoy_config_core_v3.DataSource, len(luaConfig.GetNamedScripts()))
+		// sort the names so the first invalid script is always the one reported
+		names := make([]string, 0, len(luaConfig.GetNamedScripts()))
+		for name := range luaConfig.GetNamedScripts() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sourceCode, err := translateScript(params, luaConfig.GetNamedScripts()[name])
+			if err != nil {
+				return nil, err
+			}
+			config.SourceCodes[name] = sourceCode
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	stage, err := plugins.TranslateFilterStage(luaConfig.GetFilterStage(), defaultFilterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, config, stage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	routeLua := in.GetOptions().GetLua()
+	if routeLua == nil {
+		return nil
+	}
+
+	// the per-route config is meaningless without the listener-level filter: disabling it is a no-op,
+	// but the selected script would never run
+	listenerLua := params.HttpListener.GetOptions().GetLua()
+	if listenerLua == nil {
+		if name := routeLua.GetName(); name != "" {
+			return NoListenerLuaError(name)
+		}
+		return nil
+	}
+
+	perRoute := &envoy_extensions_filters_http_lua_v3.LuaPerRoute{}
+	switch override := routeLua.GetOverride().(type) {
+	case *lua.LuaPerRoute_Disabled:
+		perRoute.Override = &envoy_extensions_filters_http_lua_v3.LuaPerRoute_Disabled{
+			Disabled: override.Disabled,
+		}
+	case *lua.LuaPerRoute_Name:
+		if _, ok := listenerLua.GetNamedScripts()[override.Name]; !ok {
+			return NamedScriptNotFoundError(override.Name)
+		}
+		perRoute.Override = &envoy_extensions_filters_http_lua_v3.LuaPerRoute_Name{
+			Name: override.Name,
+		}
+	}
+	if err := perRoute.Validate(); err != nil {
+		return err
+	}
+
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+}
+
+// translateScript resolves the code of the script.
+// Its syntax is left to Envoy, which runs LuaJIT and accepts more than the Lua 5.1 grammar.
+func translateScript(params plugins.Params, in *lua.LuaScript) (*envoy_config_core_v3.DataSource, error) {
+	var code string
+	switch source := in.GetSource().(type) {
+	case *lua.LuaScript_InlineCode:
+		code = source.InlineCode
+	case *lua.LuaScript_Artifact:
+		value, err := getArtifactValue(params, source.Artifact)
+		if err != nil {
+			return nil, err
+		}
+		code = value
+	default:
+		return nil, MissingScriptError
+	}
+
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineString{
+			InlineString: code,
+		},
+	}, nil
+}
+
+func getArtifactValue(params plugins.Params, in *artifacts.ArtifactKeyRef) (string, error) {
+	ref := in.GetArtifactRef()
+	artifact, err := params.Snapshot.Artifacts.Find(ref.Strings())
+	if err != nil {
+		return "", ArtifactNotFoundError(ref)
+	}
+
+	data := artifact.GetData()
+	key := in.GetKey()
+	if key == "" {
+		// if there is exactly one value, use it
+		if len(data) != 1 {
+			return "", NoArtifactKeyError(ref, len(data))
+		}
+		for _, v := range data {
+			return v, nil
+		}
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", NoArtifactDataError(ref, key)
+	}
+	return value, nil
+}
@@ -0,0 +1,256 @@
+package lua_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_lua_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/artifacts"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lua"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+const (
+	addHeaderScript = `function envoy_on_response(response_handle)
+  response_handle:headers():add("x-lua", "true")
+end
+`
+	stripCookiesScript = `function envoy_on_request(request_handle)
+  request_handle:headers():remove("cookie")
+end
+`
+)
+
+var _ = Describe("Lua Plugin", func() {
+
+	var (
+		p            plugins.Plugin
+		params       plugins.Params
+		httpListener *v1.HttpListener
+		artifactRef  *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: context.TODO()})
+		httpListener = &v1.HttpListener{}
+
+		artifactRef = &core.ResourceRef{Name: "lua-scripts", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Snapshot: &v1snap.ApiSnapshot{
+				Artifacts: v1.ArtifactList{{
+					Metadata: &core.Metadata{Name: artifactRef.GetName(), Namespace: artifactRef.GetNamespace()},
+					Data: map[string]string{
+						"strip-cookies.lua": stripCookiesScript,
+						"README":            "not a script",
+					},
+				}},
+			},
+		}
+	})
+
+	inlineScript := func(code string) *lua.LuaScript {
+		return &lua.LuaScript{Source: &lua.LuaScript_InlineCode{InlineCode: code}}
+	}
+
+	processRoute := func(options *v1.RouteOptions) (*envoy_config_route_v3.Route, error) {
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{Params: params, HttpListener: httpListener},
+		}, &v1.Route{Options: options}, out)
+		return out, err
+	}
+
+	It("does not add the filter when lua is not configured", func() {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the inline and artifact scripts into the filter config", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			Lua: &lua.Lua{
+				DefaultScript: inlineScript(addHeaderScript),
+				NamedScripts: map[string]*lua.LuaScript{
+					"strip-cookies": {
+						Source: &lua.LuaScript_Artifact{
+							Artifact: &artifacts.ArtifactKeyRef{ArtifactRef: artifactRef, Key: "strip-cookies.lua"},
+						},
+					},
+				},
+				StatPrefix: "my-lua",
+			},
+		}
+
+		expectedFilter, err := plugins.NewStagedFilter(FilterName,
+			&envoy_extensions_filters_http_lua_v3.Lua{
+				DefaultSourceCode: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: addHeaderScript},
+				},
+				SourceCodes: map[string]*envoy_config_core_v3.DataSource{
+					"strip-cookies": {
+						Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: stripCookiesScript},
+					},
+				},
+				StatPrefix: "my-lua",
+			},
+			plugins.DuringStage(plugins.AcceptedStage),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter).To(matchers.MatchProto(expectedFilter.HttpFilter))
+		Expect(filters[0].Stage).To(Equal(expectedFilter.Stage))
+	})
+
+	It("places the filter at the configured stage", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			Lua: &lua.Lua{
+				DefaultScript: inlineScript(addHeaderScript),
+				FilterStage: &wasm.FilterStage{
+					Stage:     wasm.FilterStage_RouteStage,
+					Predicate: wasm.FilterStage_Before,
+				},
+			},
+		}
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.RouteStage)))
+	})
+
+	It("errors without scripts", func() {
+		httpListener.Options = &v1.HttpListenerOptions{Lua: &lua.Lua{}}
+
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).To(MatchError(MissingScriptError))
+	})
+
+	It("accepts LuaJIT scripts", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			Lua: &lua.Lua{
+				DefaultScript: inlineScript("function envoy_on_request(request_handle)\n  goto done\n  ::done::\nend\n"),
+			},
+		}
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+	})
+
+	It("errors when the artifact does not contain the key", func() {
+		httpListener.Options = &v1.HttpListenerOptions{
+			Lua: &lua.Lua{
+				DefaultScript: &lua.LuaScript{
+					Source: &lua.LuaScript_Artifact{
+						Artifact: &artifacts.ArtifactKeyRef{ArtifactRef: artifactRef},
+					},
+				},
+			},
+		}
+
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).To(MatchError(NoArtifactKeyError(artifactRef, 2)))
+	})
+
+	It("errors when the artifact does not exist", func() {
+		missingRef := &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+		httpListener.Options = &v1.HttpListenerOptions{
+			Lua: &lua.Lua{
+				DefaultScript: &lua.LuaScript{
+					Source: &lua.LuaScript_Artifact{
+						Artifact: &artifacts.ArtifactKeyRef{ArtifactRef: missingRef, Key: "strip-cookies.lua"},
+					},
+				},
+			},
+		}
+
+		_, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, httpListener)
+		Expect(err).To(MatchError(ArtifactNotFoundError(missingRef)))
+	})
+
+	Context("routes", func() {
+
+		BeforeEach(func() {
+			httpListener.Options = &v1.HttpListenerOptions{
+				Lua: &lua.Lua{
+					DefaultScript: inlineScript(addHeaderScript),
+					NamedScripts: map[string]*lua.LuaScript{
+						"strip-cookies": inlineScript(stripCookiesScript),
+					},
+				},
+			}
+		})
+
+		It("disables the filter on a route", func() {
+			out, err := processRoute(&v1.RouteOptions{
+				Lua: &lua.LuaPerRoute{
+					Override: &lua.LuaPerRoute_Disabled{Disabled: true},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_lua_v3.LuaPerRoute
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetDisabled()).To(BeTrue())
+		})
+
+		It("selects a named script on a route", func() {
+			out, err := processRoute(&v1.RouteOptions{
+				Lua: &lua.LuaPerRoute{
+					Override: &lua.LuaPerRoute_Name{Name: "strip-cookies"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoy_extensions_filters_http_lua_v3.LuaPerRoute
+			err = ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetName()).To(Equal("strip-cookies"))
+		})
+
+		It("errors when the named script does not exist", func() {
+			_, err := processRoute(&v1.RouteOptions{
+				Lua: &lua.LuaPerRoute{
+					Override: &lua.LuaPerRoute_Name{Name: "missing"},
+				},
+			})
+			Expect(err).To(MatchError(NamedScriptNotFoundError("missing")))
+		})
+
+		It("ignores a disabled route when the listener has no lua filter", func() {
+			httpListener.Options = nil
+			out, err := processRoute(&v1.RouteOptions{
+				Lua: &lua.LuaPerRoute{
+					Override: &lua.LuaPerRoute_Disabled{Disabled: true},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+
+		It("errors when a named script is selected and the listener has no lua filter", func() {
+			httpListener.Options = nil
+			_, err := processRoute(&v1.RouteOptions{
+				Lua: &lua.LuaPerRoute{
+					Override: &lua.LuaPerRoute_Name{Name: "strip-cookies"},
+				},
+			})
+			Expect(err).To(MatchError(NoListenerLuaError("strip-cookies")))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+package network_rbac
+
+import (
+	"net"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_extensions_filters_network_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	solo_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/network_rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var (
+	_ plugins.Plugin              = new(plugin)
+	_ plugins.ListenerPlugin      = new(plugin)
+	_ plugins.NetworkFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "network_rbac"
+
+	ListenerStatPrefix    = "listener_network_rbac."
+	FilterChainStatPrefix = "network_rbac."
+
+	DeniedSourceRangesPolicy  = "denied-source-ranges"
+	AllowedSourceRangesPolicy = "allowed-source-ranges"
+)
+
+var (
+	// connections from denied sources should be rejected before any other network filter processes them,
+	// including the local rate limiter
+	networkFilterStage = plugins.RelativeToStage(plugins.FaultStage, -2)
+
+	InvalidCidrRangeError = func(addressPrefix string, prefixLen uint32) error {
+		return eris.Errorf("invalid source range %s/%d", addressPrefix, prefixLen)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+// ProcessListener applies the listener-wide policy to every filter chain of the listener,
+// ahead of the filters of the filter chain.
+func (p *plugin) ProcessListener(_ plugins.Params, in *v1.Listener, out *envoy_config_listener_v3.Listener) error {
+	filters, err := TranslateNetworkRbac(in.GetOptions().GetNetworkRbac(), ListenerStatPrefix)
+	if err != nil {
+		return err
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+
+	for _, filterChain := range out.GetFilterChains() {
+		// each filter chain gets its own copy of the filters, so that they can be modified independently later on
+		chainFilters := make([]*envoy_config_listener_v3.Filter, 0, len(filters)+len(filterChain.GetFilters()))
+		for _, filter := range filters {
+			chainFilters = append(chainFilters, proto.Clone(filter).(*envoy_config_listener_v3.Filter))
+		}
+		filterChain.Filters = append(chainFilters, filterChain.GetFilters()...)
+	}
+	return nil
+}
+
+func (p *plugin) NetworkFiltersHTTP(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedNetworkFilter, error) {
+	return stageFilters(TranslateNetworkRbac(listener.GetOptions().GetNetworkRbac(), FilterChainStatPrefix))
+}
+
+func (p *plugin) NetworkFiltersTCP(_ plugins.Params, listener *v1.TcpListener) ([]plugins.StagedNetworkFilter, error) {
+	return stageFilters(TranslateNetworkRbac(listener.GetOptions().GetNetworkRbac(), FilterChainStatPrefix))
+}
+
+func stageFilters(filters []*envoy_config_listener_v3.Filter, err error) ([]plugins.StagedNetworkFilter, error) {
+	if err != nil {
+		return nil, err
+	}
+	var stagedFilters []plugins.StagedNetworkFilter
+	for _, filter := range filters {
+		stagedFilters = append(stagedFilters, plugins.StagedNetworkFilter{
+			NetworkFilter: filter,
+			Stage:         networkFilterStage,
+		})
+	}
+	return stagedFilters, nil
+}
+
+// TranslateNetworkRbac converts the policy into Envoy network RBAC filters.
+// The denied source ranges produce a DENY filter, which runs before the ALLOW filter produced by the allowed source ranges.
+// Returns no filters if the policy is empty.
+func TranslateNetworkRbac(in *network_rbac.NetworkRbac, statPrefix string) ([]*envoy_config_listener_v3.Filter, error) {
+	var filters []*envoy_config_listener_v3.Filter
+
+	if len(in.GetDeniedSourceRanges()) > 0 {
+		filter, err := newRbacFilter(
+			envoy_config_rbac_v3.RBAC_DENY,
+			DeniedSourceRangesPolicy,
+			in.GetDeniedSourceRanges(),
+			statPrefix,
+		)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	if len(in.GetAllowedSourceRanges()) > 0 {
+		filter, err := newRbacFilter(
+			envoy_config_rbac_v3.RBAC_ALLOW,
+			AllowedSourceRangesPolicy,
+			in.GetAllowedSourceRanges(),
+			statPrefix,
+		)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func newRbacFilter(
+	action envoy_config_rbac_v3.RBAC_Action,
+	policyName string,
+	sourceRanges []*solo_envoy_config_core_v3.CidrRange,
+	statPrefix string,
+) (*envoy_config_listener_v3.Filter, error) {
+	principals := make([]*envoy_config_rbac_v3.Principal, 0, len(sourceRanges))
+	for _, sourceRange := range sourceRanges {
+		cidrRange, err := translateCidrRange(sourceRange)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_RemoteIp{
+				RemoteIp: cidrRange,
+			},
+		})
+	}
+
+	config := &envoy_extensions_filters_network_rbac_v3.RBAC{
+		StatPrefix: statPrefix,
+		Rules: &envoy_config_rbac_v3.RBAC{
+			Action: action,
+			Policies: map[string]*envoy_config_rbac_v3.Policy{
+				policyName: {
+					Permissions: []*envoy_config_rbac_v3.Permission{{
+						Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+					}},
+					Principals: principals,
+				},
+			},
+		},
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return translator.NewFilterWithTypedConfig(wellknown.RoleBasedAccessControl, config)
+}
+
+func translateCidrRange(in *solo_envoy_config_core_v3.CidrRange) (*envoy_config_core_v3.CidrRange, error) {
+	ip := net.ParseIP(in.GetAddressPrefix())
+	if ip == nil {
+		return nil, InvalidCidrRangeError(in.GetAddressPrefix(), in.GetPrefixLen().GetValue())
+	}
+
+	maxPrefixLen := uint32(128)
+	if ip.To4() != nil {
+		maxPrefixLen = 32
+	}
+	prefixLen := in.GetPrefixLen()
+	if prefixLen == nil {
+		// a range without a prefix length matches the single address, rather than every address
+		prefixLen = &wrappers.UInt32Value{Value: maxPrefixLen}
+	}
+	if prefixLen.GetValue() > maxPrefixLen {
+		return nil, InvalidCidrRangeError(in.GetAddressPrefix(), prefixLen.GetValue())
+	}
+
+	return &envoy_config_core_v3.CidrRange{
+		AddressPrefix: in.GetAddressPrefix(),
+		PrefixLen:     prefixLen,
+	}, nil
+}
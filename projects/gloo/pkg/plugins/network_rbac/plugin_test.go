@@ -0,0 +1,191 @@
+package network_rbac_test
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_extensions_filters_network_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	solo_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/network_rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/network_rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params      plugins.Params
+		networkRbac *network_rbac.NetworkRbac
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{}
+		networkRbac = &network_rbac.NetworkRbac{
+			AllowedSourceRanges: []*solo_envoy_config_core_v3.CidrRange{{
+				AddressPrefix: "10.0.0.0",
+				PrefixLen:     &wrappers.UInt32Value{Value: 8},
+			}},
+			DeniedSourceRanges: []*solo_envoy_config_core_v3.CidrRange{{
+				AddressPrefix: "10.0.1.1",
+			}},
+		}
+	})
+
+	rbacConfig := func(filter *envoy_config_listener_v3.Filter) *envoy_extensions_filters_network_rbac_v3.RBAC {
+		ExpectWithOffset(1, filter.GetName()).To(Equal(wellknown.RoleBasedAccessControl))
+		config := &envoy_extensions_filters_network_rbac_v3.RBAC{}
+		ExpectWithOffset(1, translator.ParseTypedConfig(filter, config)).NotTo(HaveOccurred())
+		return config
+	}
+
+	remoteIpPolicy := func(name, addressPrefix string, prefixLen uint32) map[string]*envoy_config_rbac_v3.Policy {
+		return map[string]*envoy_config_rbac_v3.Policy{
+			name: {
+				Permissions: []*envoy_config_rbac_v3.Permission{{
+					Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+				}},
+				Principals: []*envoy_config_rbac_v3.Principal{{
+					Identifier: &envoy_config_rbac_v3.Principal_RemoteIp{
+						RemoteIp: &envoy_config_core_v3.CidrRange{
+							AddressPrefix: addressPrefix,
+							PrefixLen:     &wrappers.UInt32Value{Value: prefixLen},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	Context("TranslateNetworkRbac", func() {
+
+		It("returns no filters for an empty policy", func() {
+			filters, err := TranslateNetworkRbac(&network_rbac.NetworkRbac{}, FilterChainStatPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+
+			filters, err = TranslateNetworkRbac(nil, FilterChainStatPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("denies the denied source ranges before allowing the allowed source ranges", func() {
+			filters, err := TranslateNetworkRbac(networkRbac, FilterChainStatPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+
+			Expect(rbacConfig(filters[0])).To(matchers.MatchProto(&envoy_extensions_filters_network_rbac_v3.RBAC{
+				StatPrefix: FilterChainStatPrefix,
+				Rules: &envoy_config_rbac_v3.RBAC{
+					Action:   envoy_config_rbac_v3.RBAC_DENY,
+					Policies: remoteIpPolicy(DeniedSourceRangesPolicy, "10.0.1.1", 32),
+				},
+			}))
+			Expect(rbacConfig(filters[1])).To(matchers.MatchProto(&envoy_extensions_filters_network_rbac_v3.RBAC{
+				StatPrefix: FilterChainStatPrefix,
+				Rules: &envoy_config_rbac_v3.RBAC{
+					Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+					Policies: remoteIpPolicy(AllowedSourceRangesPolicy, "10.0.0.0", 8),
+				},
+			}))
+		})
+
+		It("defaults the prefix length of ipv6 ranges to a single address", func() {
+			filters, err := TranslateNetworkRbac(&network_rbac.NetworkRbac{
+				DeniedSourceRanges: []*solo_envoy_config_core_v3.CidrRange{{AddressPrefix: "2001:db8::1"}},
+			}, FilterChainStatPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(rbacConfig(filters[0]).GetRules().GetPolicies()[DeniedSourceRangesPolicy]).To(
+				matchers.MatchProto(remoteIpPolicy(DeniedSourceRangesPolicy, "2001:db8::1", 128)[DeniedSourceRangesPolicy]))
+		})
+
+		It("errors on invalid source ranges", func() {
+			_, err := TranslateNetworkRbac(&network_rbac.NetworkRbac{
+				AllowedSourceRanges: []*solo_envoy_config_core_v3.CidrRange{{AddressPrefix: "not-an-ip"}},
+			}, FilterChainStatPrefix)
+			Expect(err).To(MatchError(ContainSubstring("invalid source range not-an-ip/0")))
+
+			_, err = TranslateNetworkRbac(&network_rbac.NetworkRbac{
+				DeniedSourceRanges: []*solo_envoy_config_core_v3.CidrRange{{
+					AddressPrefix: "10.0.0.0",
+					PrefixLen:     &wrappers.UInt32Value{Value: 33},
+				}},
+			}, FilterChainStatPrefix)
+			Expect(err).To(MatchError(InvalidCidrRangeError("10.0.0.0", 33).Error()))
+		})
+	})
+
+	Context("ProcessListener", func() {
+
+		It("prepends the filters to every filter chain", func() {
+			out := &envoy_config_listener_v3.Listener{
+				FilterChains: []*envoy_config_listener_v3.FilterChain{
+					{Filters: []*envoy_config_listener_v3.Filter{{Name: wellknown.HTTPConnectionManager}}},
+					{Filters: []*envoy_config_listener_v3.Filter{{Name: wellknown.TCPProxy}}},
+				},
+			}
+			err := NewPlugin().ProcessListener(params, &v1.Listener{
+				Options: &v1.ListenerOptions{NetworkRbac: networkRbac},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, filterChain := range out.GetFilterChains() {
+				Expect(filterChain.GetFilters()).To(HaveLen(3))
+				Expect(rbacConfig(filterChain.GetFilters()[0]).GetStatPrefix()).To(Equal(ListenerStatPrefix))
+				Expect(rbacConfig(filterChain.GetFilters()[1]).GetStatPrefix()).To(Equal(ListenerStatPrefix))
+			}
+			Expect(out.GetFilterChains()[0].GetFilters()[2].GetName()).To(Equal(wellknown.HTTPConnectionManager))
+			Expect(out.GetFilterChains()[1].GetFilters()[2].GetName()).To(Equal(wellknown.TCPProxy))
+			Expect(out.GetFilterChains()[0].GetFilters()[0]).NotTo(BeIdenticalTo(out.GetFilterChains()[1].GetFilters()[0]))
+		})
+
+		It("does nothing without a policy", func() {
+			out := &envoy_config_listener_v3.Listener{
+				FilterChains: []*envoy_config_listener_v3.FilterChain{
+					{Filters: []*envoy_config_listener_v3.Filter{{Name: wellknown.TCPProxy}}},
+				},
+			}
+			Expect(NewPlugin().ProcessListener(params, &v1.Listener{}, out)).NotTo(HaveOccurred())
+			Expect(out.GetFilterChains()[0].GetFilters()).To(HaveLen(1))
+		})
+	})
+
+	Context("network filters", func() {
+
+		It("stages the filters of http listeners", func() {
+			filters, err := NewPlugin().NetworkFiltersHTTP(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{NetworkRbac: networkRbac},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+			for _, filter := range filters {
+				Expect(filter.Stage).To(Equal(plugins.RelativeToStage(plugins.FaultStage, -2)))
+				Expect(rbacConfig(filter.NetworkFilter).GetStatPrefix()).To(Equal(FilterChainStatPrefix))
+			}
+		})
+
+		It("stages the filters of tcp listeners", func() {
+			filters, err := NewPlugin().NetworkFiltersTCP(params, &v1.TcpListener{
+				Options: &v1.TcpListenerOptions{NetworkRbac: &network_rbac.NetworkRbac{
+					DeniedSourceRanges: networkRbac.GetDeniedSourceRanges(),
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(rbacConfig(filters[0].NetworkFilter).GetRules().GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_DENY))
+		})
+
+		It("returns no filters without a policy", func() {
+			filters, err := NewPlugin().NetworkFiltersTCP(params, &v1.TcpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+	})
+})
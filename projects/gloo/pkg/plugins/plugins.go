@@ -139,6 +139,21 @@ type TcpFilterChainPlugin interface {
 	CreateTcpFilterChains(params Params, parentListener *v1.Listener, in *v1.TcpListener) ([]*envoy_config_listener_v3.FilterChain, error)
 }
 
+// UdpListenerPlugin generates the ListenerFilters of a UDP listener.
+// UDP listeners have no FilterChains, the datagrams are processed by listener filters such as the UdpProxy.
+type UdpListenerPlugin interface {
+	Plugin
+	CreateUdpListenerFilters(params Params, parentListener *v1.Listener, in *v1.UdpListener) ([]*envoy_config_listener_v3.ListenerFilter, error)
+}
+
+// NetworkFilterPlugin generates NetworkFilters which are added to the FilterChains of a listener,
+// alongside the terminal NetworkFilter (HttpConnectionManager or TcpProxy) of each FilterChain.
+type NetworkFilterPlugin interface {
+	Plugin
+	NetworkFiltersHTTP(params Params, listener *v1.HttpListener) ([]StagedNetworkFilter, error)
+	NetworkFiltersTCP(params Params, listener *v1.TcpListener) ([]StagedNetworkFilter, error)
+}
+
 // HttpConnectionManager Plugins
 type HttpConnectionManagerPlugin interface {
 	Plugin
@@ -178,6 +193,8 @@ type PluginRegistry interface {
 	GetPlugins() []Plugin
 	GetListenerPlugins() []ListenerPlugin
 	GetTcpFilterChainPlugins() []TcpFilterChainPlugin
+	GetUdpListenerPlugins() []UdpListenerPlugin
+	GetNetworkFilterPlugins() []NetworkFilterPlugin
 	GetHttpFilterPlugins() []HttpFilterPlugin
 	GetHttpConnectionManagerPlugins() []HttpConnectionManagerPlugin
 	GetVirtualHostPlugins() []VirtualHostPlugin
@@ -3,7 +3,10 @@ package protocoloptions
 import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	errors "github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -11,6 +14,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/httpprotocolhelpers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/httpprotocolvalidation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
 
 var (
@@ -20,6 +24,16 @@ var (
 
 const (
 	ExtensionName = "protocol_options"
+
+	httpProtocolOptionsExtensionName = "envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
+
+	// The ALPN negotiated with HTTP/3 upstreams
+	http3Alpn = "h3"
+)
+
+var (
+	Http2AndHttp3Error    = errors.New("an upstream cannot use both http2 and http3")
+	Http3RequiresSslError = errors.New("http3 requires sslConfig to be set on the upstream")
 )
 
 type plugin struct{}
@@ -37,6 +51,10 @@ func (p *plugin) Init(_ plugins.InitParams) {
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
 
+	if in.GetUseHttp3().GetValue() {
+		return processHttp3(in, out)
+	}
+
 	if in.GetUseHttp2() == nil || !in.GetUseHttp2().GetValue() {
 		return nil
 	}
@@ -119,10 +137,67 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		}
 	}
 
-	err := pluginutils.SetExtensionProtocolOptions(out, "envoy.extensions.upstreams.http.v3.HttpProtocolOptions", protobuf)
+	err := pluginutils.SetExtensionProtocolOptions(out, httpProtocolOptionsExtensionName, protobuf)
 	if err != nil {
 		return errors.Wrapf(err, "converting protocol options to struct")
 	}
 
 	return nil
 }
+
+// processHttp3 configures the cluster to speak HTTP/3 to the upstream, by wrapping the TLS transport socket
+// of the cluster in a QUIC transport socket
+func processHttp3(in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	if in.GetUseHttp2().GetValue() {
+		return Http2AndHttp3Error
+	}
+	if in.GetSslConfig() == nil || out.GetTransportSocket().GetName() != wellknown.TransportSocketTls {
+		return Http3RequiresSslError
+	}
+
+	protobuf := &envoy_extensions_upstreams_http_v3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http3ProtocolOptions{
+					Http3ProtocolOptions: &envoy_config_core_v3.Http3ProtocolOptions{
+						QuicProtocolOptions: &envoy_config_core_v3.QuicProtocolOptions{
+							MaxConcurrentStreams:        in.GetMaxConcurrentStreams(),
+							InitialStreamWindowSize:     in.GetInitialStreamWindowSize(),
+							InitialConnectionWindowSize: in.GetInitialConnectionWindowSize(),
+						},
+						OverrideStreamErrorOnInvalidHttpMessage: in.GetOverrideStreamErrorOnInvalidHttpMessage(),
+					},
+				},
+			},
+		},
+	}
+	if err := protobuf.Validate(); err != nil {
+		return err
+	}
+
+	upstreamTlsContext := &envoyauth.UpstreamTlsContext{}
+	if err := out.GetTransportSocket().GetTypedConfig().UnmarshalTo(upstreamTlsContext); err != nil {
+		return errors.Wrapf(err, "converting upstream tls context")
+	}
+	if upstreamTlsContext.GetCommonTlsContext() == nil {
+		upstreamTlsContext.CommonTlsContext = &envoyauth.CommonTlsContext{}
+	}
+	if len(upstreamTlsContext.GetCommonTlsContext().GetAlpnProtocols()) == 0 {
+		upstreamTlsContext.GetCommonTlsContext().AlpnProtocols = []string{http3Alpn}
+	}
+	typedConfig, err := utils.MessageToAny(&envoy_extensions_transport_sockets_quic_v3.QuicUpstreamTransport{
+		UpstreamTlsContext: upstreamTlsContext,
+	})
+	if err != nil {
+		return err
+	}
+	out.TransportSocket = &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketQuic,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+
+	if err := pluginutils.SetExtensionProtocolOptions(out, httpProtocolOptionsExtensionName, protobuf); err != nil {
+		return errors.Wrapf(err, "converting protocol options to struct")
+	}
+	return nil
+}
@@ -5,7 +5,10 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -16,6 +19,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("Plugin", func() {
@@ -194,6 +198,75 @@ var _ = Describe("Plugin", func() {
 			err := p.ProcessUpstream(params, upstream, out)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		Context("useHttp3 is set", func() {
+
+			var upstream *v1.Upstream
+
+			BeforeEach(func() {
+				upstream = &v1.Upstream{
+					UseHttp3:                &wrappers.BoolValue{Value: true},
+					MaxConcurrentStreams:    &wrappers.UInt32Value{Value: 100},
+					InitialStreamWindowSize: &wrappers.UInt32Value{Value: 65536},
+					SslConfig: &v1.UpstreamSslConfig{
+						Sni: "example.com",
+					},
+				}
+
+				tlsContext, err := utils.MessageToAny(&envoyauth.UpstreamTlsContext{
+					Sni: "example.com",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				out.TransportSocket = &envoy_config_core_v3.TransportSocket{
+					Name:       wellknown.TransportSocketTls,
+					ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+				}
+			})
+
+			It("should configure http3 and wrap the tls transport socket", func() {
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+
+				msg, err := utils.AnyToMessage(out.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"])
+				Expect(err).NotTo(HaveOccurred())
+				protocolOptions := msg.(*envoy_extensions_upstreams_http_v3.HttpProtocolOptions)
+				Expect(protocolOptions.GetExplicitHttpConfig().GetHttp3ProtocolOptions().GetQuicProtocolOptions()).To(MatchProto(&envoy_config_core_v3.QuicProtocolOptions{
+					MaxConcurrentStreams:    &wrappers.UInt32Value{Value: 100},
+					InitialStreamWindowSize: &wrappers.UInt32Value{Value: 65536},
+				}))
+
+				Expect(out.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketQuic))
+				msg, err = utils.AnyToMessage(out.GetTransportSocket().GetTypedConfig())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg).To(MatchProto(&envoy_extensions_transport_sockets_quic_v3.QuicUpstreamTransport{
+					UpstreamTlsContext: &envoyauth.UpstreamTlsContext{
+						Sni: "example.com",
+						CommonTlsContext: &envoyauth.CommonTlsContext{
+							AlpnProtocols: []string{"h3"},
+						},
+					},
+				}))
+			})
+
+			It("should not allow http2 and http3 at the same time", func() {
+				upstream.UseHttp2 = &wrappers.BoolValue{Value: true}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).To(MatchError(protocoloptions.Http2AndHttp3Error))
+			})
+
+			It("should require ssl", func() {
+				upstream.SslConfig = nil
+				out.TransportSocket = nil
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).To(MatchError(protocoloptions.Http3RequiresSslError))
+			})
+
+			It("should not accept stream windows that are too large for quic", func() {
+				upstream.InitialStreamWindowSize = &wrappers.UInt32Value{Value: 268435456}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
 
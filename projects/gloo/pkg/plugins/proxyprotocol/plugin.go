@@ -1,10 +1,12 @@
 package proxyprotocol
 
 import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_listener_proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
@@ -73,6 +75,12 @@ func GenerateProxyProtocolListenerFilter(in *v1.Listener) (*envoy_config_listene
 			})
 		}
 		envoyProxyProtocol.Rules = rules
+
+		passThroughTlvs, err := ConvertPassThroughTlvs(pp.GetPassThroughTlvs())
+		if err != nil {
+			return nil, err
+		}
+		envoyProxyProtocol.PassThroughTlvs = passThroughTlvs
 	}
 
 	msg, err := utils.MessageToAny(envoyProxyProtocol)
@@ -88,6 +96,23 @@ func GenerateProxyProtocolListenerFilter(in *v1.Listener) (*envoy_config_listene
 	}, nil
 }
 
+// ConvertPassThroughTlvs converts the selection of the TLVs which are passed from the downstream PROXY protocol
+// header of a listener to the header sent to an upstream.
+func ConvertPassThroughTlvs(in *proxy_protocol.PassThroughTlvs) (*envoy_config_core_v3.ProxyProtocolPassThroughTLVs, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &envoy_config_core_v3.ProxyProtocolPassThroughTLVs{
+		MatchType: envoy_config_core_v3.ProxyProtocolPassThroughTLVs_PassTLVsMatchType(in.GetMatchType()),
+		TlvType:   in.GetTlvTypes(),
+	}
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func UsesEnterpriseOnlyFeatures(in *v1.Listener) bool {
 	return in.GetOptions().GetProxyProtocol().GetAllowRequestsWithoutProxyProtocol()
 }
@@ -1,6 +1,7 @@
 package proxyprotocol
 
 import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_listener_proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -96,6 +97,30 @@ var _ = Describe("Plugin", func() {
 				Expect(msg.Rules[0].GetOnTlvPresent().GetMetadataNamespace()).To(Equal("ns"))
 			})
 
+			It("keeps the selected TLVs on the connection", func() {
+				in.Options.ProxyProtocol.PassThroughTlvs = &proxy_protocol.PassThroughTlvs{
+					MatchType: proxy_protocol.PassThroughTlvs_INCLUDE,
+					TlvTypes:  []uint32{0xE0, 0xE1},
+				}
+				err := p.ProcessListener(params, in, out)
+				Expect(err).NotTo(HaveOccurred())
+
+				var msg envoy_listener_proxy_protocol.ProxyProtocol
+				err = translator.ParseTypedConfig(out.ListenerFilters[0], &msg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg.GetPassThroughTlvs().GetMatchType()).To(Equal(envoy_config_core_v3.ProxyProtocolPassThroughTLVs_INCLUDE))
+				Expect(msg.GetPassThroughTlvs().GetTlvType()).To(Equal([]uint32{0xE0, 0xE1}))
+			})
+
+			It("errors on invalid TLV types", func() {
+				in.Options.ProxyProtocol.PassThroughTlvs = &proxy_protocol.PassThroughTlvs{
+					MatchType: proxy_protocol.PassThroughTlvs_INCLUDE,
+					TlvTypes:  []uint32{256},
+				}
+				err := p.ProcessListener(params, in, out)
+				Expect(err).To(HaveOccurred())
+			})
+
 			It("errors on enterprise only config", func() {
 				in.Options.ProxyProtocol.AllowRequestsWithoutProxyProtocol = true
 				err := p.ProcessListener(params, in, out)
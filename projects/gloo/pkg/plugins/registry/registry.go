@@ -18,17 +18,20 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/connection_limit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/csrf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ext_proc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcweb"
-	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/header_to_metadata"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_integration"
@@ -36,7 +39,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/metadata"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/network_rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/proxyprotocol"
@@ -45,12 +51,15 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tls_inspector"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tunneling"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamproxyprotocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
@@ -76,9 +85,12 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		hcm.NewPlugin(),
 		als.NewPlugin(),
 		proxyprotocol.NewPlugin(),
+		network_rbac.NewPlugin(),
+		connection_limit.NewPlugin(),
 		tls_inspector.NewPlugin(),
 		pipe.NewPlugin(),
 		tcp.NewPlugin(utils.NewSslConfigTranslator()),
+		udp.NewPlugin(),
 		static.NewPlugin(),
 		transformation.NewPlugin(),
 		grpcweb.NewPlugin(),
@@ -95,7 +107,7 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		healthcheck.NewPlugin(),
 		extauth.NewPlugin(),
 		ratelimit.NewPlugin(),
-		gzip.NewPlugin(),
+		compression.NewPlugin(),
 		buffer.NewPlugin(),
 		csrf.NewPlugin(),
 		listener.NewPlugin(),
@@ -105,6 +117,13 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		metadata.NewPlugin(),
 		tunneling.NewPlugin(),
 		dynamic_forward_proxy.NewPlugin(),
+		local_ratelimit.NewPlugin(),
+		ext_proc.NewPlugin(),
+		tap.NewPlugin(),
+		lua.NewPlugin(),
+		header_to_metadata.NewPlugin(),
+		// wraps the transport sockets set by the other plugins, so it must come after them
+		upstreamproxyprotocol.NewPlugin(),
 	)
 
 	if opts.KubeClient != nil {
@@ -138,6 +157,8 @@ type pluginRegistry struct {
 	plugins                      []plugins.Plugin
 	listenerPlugins              []plugins.ListenerPlugin
 	tcpFilterChainPlugins        []plugins.TcpFilterChainPlugin
+	udpListenerPlugins           []plugins.UdpListenerPlugin
+	networkFilterPlugins         []plugins.NetworkFilterPlugin
 	httpFilterPlugins            []plugins.HttpFilterPlugin
 	httpConnectionManagerPlugins []plugins.HttpConnectionManagerPlugin
 	virtualHostPlugins           []plugins.VirtualHostPlugin
@@ -156,6 +177,8 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 	var allPlugins []plugins.Plugin
 	var listenerPlugins []plugins.ListenerPlugin
 	var tcpFilterChainPlugins []plugins.TcpFilterChainPlugin
+	var udpListenerPlugins []plugins.UdpListenerPlugin
+	var networkFilterPlugins []plugins.NetworkFilterPlugin
 	var httpFilterPlugins []plugins.HttpFilterPlugin
 	var httpConnectionManagerPlugins []plugins.HttpConnectionManagerPlugin
 	var virtualHostPlugins []plugins.VirtualHostPlugin
@@ -183,6 +206,16 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 			tcpFilterChainPlugins = append(tcpFilterChainPlugins, tcpFilterChainPlugin)
 		}
 
+		udpListenerPlugin, ok := plugin.(plugins.UdpListenerPlugin)
+		if ok {
+			udpListenerPlugins = append(udpListenerPlugins, udpListenerPlugin)
+		}
+
+		networkFilterPlugin, ok := plugin.(plugins.NetworkFilterPlugin)
+		if ok {
+			networkFilterPlugins = append(networkFilterPlugins, networkFilterPlugin)
+		}
+
 		httpFilterPlugin, ok := plugin.(plugins.HttpFilterPlugin)
 		if ok {
 			httpFilterPlugins = append(httpFilterPlugins, httpFilterPlugin)
@@ -233,6 +266,8 @@ func NewPluginRegistry(registeredPlugins []plugins.Plugin) *pluginRegistry {
 		plugins:                      allPlugins,
 		listenerPlugins:              listenerPlugins,
 		tcpFilterChainPlugins:        tcpFilterChainPlugins,
+		udpListenerPlugins:           udpListenerPlugins,
+		networkFilterPlugins:         networkFilterPlugins,
 		httpFilterPlugins:            httpFilterPlugins,
 		httpConnectionManagerPlugins: httpConnectionManagerPlugins,
 		virtualHostPlugins:           virtualHostPlugins,
@@ -260,6 +295,16 @@ func (p *pluginRegistry) GetTcpFilterChainPlugins() []plugins.TcpFilterChainPlug
 	return p.tcpFilterChainPlugins
 }
 
+// GetUdpListenerPlugins returns the plugins that were registered which act on UdpListener.
+func (p *pluginRegistry) GetUdpListenerPlugins() []plugins.UdpListenerPlugin {
+	return p.udpListenerPlugins
+}
+
+// GetNetworkFilterPlugins returns the plugins that were registered which act on NetworkFilter.
+func (p *pluginRegistry) GetNetworkFilterPlugins() []plugins.NetworkFilterPlugin {
+	return p.networkFilterPlugins
+}
+
 // GetHttpFilterPlugins returns the plugins that were registered which act on HttpFilter.
 func (p *pluginRegistry) GetHttpFilterPlugins() []plugins.HttpFilterPlugin {
 	return p.httpFilterPlugins
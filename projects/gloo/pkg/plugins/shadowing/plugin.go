@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 )
 
 var (
@@ -21,9 +22,10 @@ const (
 )
 
 var (
-	InvalidRouteActionError  = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
-	UnspecifiedUpstreamError = eris.New("invalid plugin spec: must specify an upstream ref")
-	InvalidNumeratorError    = func(num float32) error {
+	InvalidRouteActionError     = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
+	UnspecifiedUpstreamError    = eris.New("invalid plugin spec: must specify an upstream ref")
+	UnspecifiedDestinationError = eris.New("invalid plugin spec: must specify a destination for each mirror")
+	InvalidNumeratorError       = func(num float32) error {
 		return eris.Errorf("shadow percentage must be between 0 and 100, received %v", num)
 	}
 )
@@ -64,21 +66,94 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 }
 
 func applyShadowSpec(out *envoy_config_route_v3.RouteAction, spec *shadowing.RouteShadowing) error {
-	if spec.GetUpstream() == nil {
+	if spec.GetUpstream() == nil && len(spec.GetMirrors()) == 0 {
 		return UnspecifiedUpstreamError
 	}
-	if spec.GetPercentage() < 0 || spec.GetPercentage() > 100 {
-		return InvalidNumeratorError(spec.GetPercentage())
-	}
-	out.RequestMirrorPolicies = []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
-		{
+
+	var mirrorPolicies []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy
+	if spec.GetUpstream() != nil {
+		if spec.GetPercentage() < 0 || spec.GetPercentage() > 100 {
+			return InvalidNumeratorError(spec.GetPercentage())
+		}
+		mirrorPolicies = append(mirrorPolicies, &envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
 			Cluster:         translator.UpstreamToClusterName(spec.GetUpstream()),
 			RuntimeFraction: getFractionalPercent(spec.GetPercentage()),
-		},
+		})
+	}
+
+	for _, mirror := range spec.GetMirrors() {
+		mirrorPolicy, err := translateMirror(mirror)
+		if err != nil {
+			return err
+		}
+		mirrorPolicies = append(mirrorPolicies, mirrorPolicy)
 	}
+
+	out.RequestMirrorPolicies = mirrorPolicies
 	return nil
 }
 
+func translateMirror(mirror *shadowing.RequestMirror) (*envoy_config_route_v3.RouteAction_RequestMirrorPolicy, error) {
+	usRef, err := upstreams.DestinationToUpstreamRef(toDestination(mirror))
+	if err != nil {
+		return nil, UnspecifiedDestinationError
+	}
+
+	out := &envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
+		Cluster:      translator.UpstreamToClusterName(usRef),
+		TraceSampled: mirror.GetTraceSampled(),
+	}
+
+	// without a runtime fraction, envoy shadows all of the traffic
+	if mirror.GetPercentage() == nil && mirror.GetRuntimeKey() == "" {
+		return out, nil
+	}
+
+	percentage := float32(100)
+	if mirror.GetPercentage() != nil {
+		percentage = mirror.GetPercentage().GetValue()
+	}
+	if percentage < 0 || percentage > 100 {
+		return nil, InvalidNumeratorError(percentage)
+	}
+	out.RuntimeFraction = getFractionalPercent(percentage)
+	out.GetRuntimeFraction().RuntimeKey = mirror.GetRuntimeKey()
+
+	return out, nil
+}
+
+// toDestination converts the mirror destination into a route destination, which can be resolved to an upstream
+func toDestination(mirror *shadowing.RequestMirror) *v1.Destination {
+	switch dest := mirror.GetDestinationType().(type) {
+	case *shadowing.RequestMirror_Upstream:
+		return &v1.Destination{
+			DestinationType: &v1.Destination_Upstream{
+				Upstream: dest.Upstream,
+			},
+		}
+	case *shadowing.RequestMirror_Kube:
+		return &v1.Destination{
+			DestinationType: &v1.Destination_Kube{
+				Kube: &v1.KubernetesServiceDestination{
+					Ref:  dest.Kube.GetRef(),
+					Port: dest.Kube.GetPort(),
+				},
+			},
+		}
+	case *shadowing.RequestMirror_Consul:
+		return &v1.Destination{
+			DestinationType: &v1.Destination_Consul{
+				Consul: &v1.ConsulServiceDestination{
+					ServiceName: dest.Consul.GetServiceName(),
+					Tags:        dest.Consul.GetTags(),
+					DataCenters: dest.Consul.GetDataCenters(),
+				},
+			},
+		}
+	}
+	return &v1.Destination{}
+}
+
 func getFractionalPercent(numerator float32) *envoy_config_core_v3.RuntimeFractionalPercent {
 	return &envoy_config_core_v3.RuntimeFractionalPercent{
 		DefaultValue: common.ToEnvoyPercentage(numerator),
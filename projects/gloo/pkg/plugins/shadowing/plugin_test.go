@@ -3,11 +3,15 @@ package shadowing
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
 	. "github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -142,6 +146,94 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
 	})
 
+	It("should shadow traffic to multiple destinations", func() {
+		p := NewPlugin()
+
+		upRef := &core.ResourceRef{
+			Name:      "some-upstream",
+			Namespace: "default",
+		}
+		kubeDest := &v1.KubernetesServiceDestination{
+			Ref:  &core.ResourceRef{Name: "analytics", Namespace: "default"},
+			Port: 8080,
+		}
+		consulDest := &v1.ConsulServiceDestination{
+			ServiceName: "reviews",
+			Tags:        []string{"v2"},
+		}
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Upstream:   upRef,
+					Percentage: 50,
+					Mirrors: []*shadowing.RequestMirror{
+						{
+							DestinationType: &shadowing.RequestMirror_Kube{
+								Kube: &shadowing.KubernetesServiceDestination{
+									Ref:  kubeDest.GetRef(),
+									Port: kubeDest.GetPort(),
+								},
+							},
+							TraceSampled: &wrappers.BoolValue{Value: false},
+						},
+						{
+							DestinationType: &shadowing.RequestMirror_Consul{
+								Consul: &shadowing.ConsulServiceDestination{
+									ServiceName: consulDest.GetServiceName(),
+									Tags:        consulDest.GetTags(),
+								},
+							},
+							Percentage: &wrappers.FloatValue{Value: 25},
+							RuntimeKey: "reviews_shadow",
+						},
+					},
+				},
+			},
+		}
+		out := &envoy_config_route_v3.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		mirrorPolicies := out.GetRoute().GetRequestMirrorPolicies()
+		Expect(mirrorPolicies).To(HaveLen(3))
+
+		Expect(mirrorPolicies[0].GetCluster()).To(Equal("some-upstream_default"))
+		checkFraction(mirrorPolicies[0].GetRuntimeFraction(), 50)
+
+		Expect(mirrorPolicies[1].GetCluster()).To(Equal(translator.UpstreamToClusterName(kubernetes.DestinationToUpstreamRef(kubeDest))))
+		Expect(mirrorPolicies[1].GetRuntimeFraction()).To(BeNil())
+		Expect(mirrorPolicies[1].GetTraceSampled().GetValue()).To(BeFalse())
+
+		Expect(mirrorPolicies[2].GetCluster()).To(Equal(translator.UpstreamToClusterName(consul.DestinationToUpstreamRef(consulDest))))
+		checkFraction(mirrorPolicies[2].GetRuntimeFraction(), 25)
+		Expect(mirrorPolicies[2].GetRuntimeFraction().GetRuntimeKey()).To(Equal("reviews_shadow"))
+		Expect(mirrorPolicies[2].GetTraceSampled()).To(BeNil())
+	})
+
+	It("should error when a mirror is invalid", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Mirrors: []*shadowing.RequestMirror{{
+						Percentage: &wrappers.FloatValue{Value: 10},
+					}},
+				},
+			},
+		}
+		out := &envoy_config_route_v3.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).To(HaveInErrorChain(UnspecifiedDestinationError))
+
+		in.GetOptions().GetShadowing().GetMirrors()[0].DestinationType = &shadowing.RequestMirror_Upstream{
+			Upstream: &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+		}
+		in.GetOptions().GetShadowing().GetMirrors()[0].Percentage = &wrappers.FloatValue{Value: 101}
+		err = p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).To(HaveInErrorChain(InvalidNumeratorError(101)))
+	})
+
 })
 
 func checkFraction(frac *envoy_config_core_v3.RuntimeFractionalPercent, percentage float32) {
@@ -0,0 +1,154 @@
+package static
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_network_dns_resolver_cares_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/network/dns_resolver/cares/v3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	CaresDnsResolverName = "envoy.network.dns_resolver.cares"
+
+	defaultDnsResolverPort = 53
+)
+
+var (
+	LogicalDnsMultipleHostsError = func(hosts int) error {
+		return errors.Errorf("upstreams with the LOGICAL_DNS discovery type must have a single host, found %d", hosts)
+	}
+	InvalidDnsResolverError = func(resolver string) error {
+		return errors.Errorf("dns resolver %s must be an IP address, optionally followed by a port", resolver)
+	}
+
+	lookupFamilies = map[dns.DnsOptions_LookupFamily]envoy_config_cluster_v3.Cluster_DnsLookupFamily{
+		dns.DnsOptions_V4_ONLY:      envoy_config_cluster_v3.Cluster_V4_ONLY,
+		dns.DnsOptions_V6_ONLY:      envoy_config_cluster_v3.Cluster_V6_ONLY,
+		dns.DnsOptions_AUTO:         envoy_config_cluster_v3.Cluster_AUTO,
+		dns.DnsOptions_V4_PREFERRED: envoy_config_cluster_v3.Cluster_V4_PREFERRED,
+		dns.DnsOptions_ALL:          envoy_config_cluster_v3.Cluster_ALL,
+	}
+)
+
+// mergeDnsOptions returns the DNS options of the upstream, where the fields it does not set are read from the defaults
+func mergeDnsOptions(defaults, upstream *dns.DnsOptions) *dns.DnsOptions {
+	if defaults == nil {
+		return upstream
+	}
+	if upstream == nil {
+		return defaults
+	}
+
+	merged := upstream.Clone().(*dns.DnsOptions)
+	if merged.GetDiscoveryType() == dns.DnsOptions_UNSPECIFIED_DISCOVERY_TYPE {
+		merged.DiscoveryType = defaults.GetDiscoveryType()
+	}
+	if merged.GetLookupFamily() == dns.DnsOptions_UNSPECIFIED_LOOKUP_FAMILY {
+		merged.LookupFamily = defaults.GetLookupFamily()
+	}
+	if merged.GetDnsRefreshRate() == nil {
+		merged.DnsRefreshRate = defaults.GetDnsRefreshRate()
+	}
+	if merged.GetRespectDnsTtl() == nil {
+		merged.RespectDnsTtl = defaults.GetRespectDnsTtl()
+	}
+	if len(merged.GetResolvers()) == 0 {
+		merged.Resolvers = defaults.GetResolvers()
+	}
+	return merged
+}
+
+func applyDnsOptions(in *dns.DnsOptions, hosts int, out *envoy_config_cluster_v3.Cluster) error {
+	discoveryType := envoy_config_cluster_v3.Cluster_STRICT_DNS
+	if in.GetDiscoveryType() == dns.DnsOptions_LOGICAL_DNS {
+		// envoy only resolves the first host of logical dns clusters
+		if hosts > 1 {
+			return LogicalDnsMultipleHostsError(hosts)
+		}
+		discoveryType = envoy_config_cluster_v3.Cluster_LOGICAL_DNS
+	}
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: discoveryType,
+	}
+
+	// default to V4_ONLY, to fix issue where ipv6 addr cannot bind
+	out.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
+	if lookupFamily, ok := lookupFamilies[in.GetLookupFamily()]; ok {
+		out.DnsLookupFamily = lookupFamily
+	}
+
+	if refreshRate := in.GetDnsRefreshRate(); refreshRate != nil {
+		if refreshRate.AsDuration() <= time.Millisecond {
+			return errors.Errorf("dns refresh rate must be greater than 1ms: %v", refreshRate.AsDuration())
+		}
+		out.DnsRefreshRate = refreshRate
+	}
+	out.RespectDnsTtl = in.GetRespectDnsTtl().GetValue()
+
+	if len(in.GetResolvers()) > 0 {
+		resolverConfig, err := convertResolvers(in.GetResolvers())
+		if err != nil {
+			return err
+		}
+		out.TypedDnsResolverConfig = resolverConfig
+	}
+
+	return nil
+}
+
+func convertResolvers(resolvers []string) (*envoy_config_core_v3.TypedExtensionConfig, error) {
+	resolverConfig := &envoy_extensions_network_dns_resolver_cares_v3.CaresDnsResolverConfig{}
+	for _, resolver := range resolvers {
+		address, err := parseResolver(resolver)
+		if err != nil {
+			return nil, err
+		}
+		resolverConfig.Resolvers = append(resolverConfig.GetResolvers(), address)
+	}
+
+	typedConfig, err := utils.MessageToAny(resolverConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TypedExtensionConfig{
+		Name:        CaresDnsResolverName,
+		TypedConfig: typedConfig,
+	}, nil
+}
+
+func parseResolver(resolver string) (*envoy_config_core_v3.Address, error) {
+	host, port := resolver, uint64(defaultDnsResolverPort)
+	if net.ParseIP(resolver) == nil {
+		var portStr string
+		var err error
+		host, portStr, err = net.SplitHostPort(resolver)
+		if err != nil {
+			return nil, InvalidDnsResolverError(resolver)
+		}
+		port, err = strconv.ParseUint(portStr, 10, 16)
+		if err != nil || port == 0 {
+			return nil, InvalidDnsResolverError(resolver)
+		}
+	}
+	if net.ParseIP(host) == nil {
+		return nil, InvalidDnsResolverError(resolver)
+	}
+
+	return &envoy_config_core_v3.Address{
+		Address: &envoy_config_core_v3.Address_SocketAddress{
+			SocketAddress: &envoy_config_core_v3.SocketAddress{
+				Protocol: envoy_config_core_v3.SocketAddress_UDP,
+				Address:  host,
+				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+					PortValue: uint32(port),
+				},
+			},
+		},
+	}, nil
+}
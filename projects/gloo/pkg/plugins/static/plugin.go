@@ -186,13 +186,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	// the upstream has a DNS name. We need Envoy to resolve the DNS name
 	if hostname != "" {
-		// set the type to strict dns
-		out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
-			Type: envoy_config_cluster_v3.Cluster_STRICT_DNS,
+		dnsOptions := mergeDnsOptions(p.settings.GetGloo().GetStaticUpstreamDnsOptions(), spec.GetDnsOptions())
+		if err := applyDnsOptions(dnsOptions, len(spec.GetHosts()), out); err != nil {
+			return err
 		}
-
-		// fix issue where ipv6 addr cannot bind
-		out.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
 	}
 
 	return nil
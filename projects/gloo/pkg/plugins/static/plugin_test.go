@@ -1,9 +1,13 @@
 package static
 
 import (
+	"fmt"
+	"time"
+
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_extensions_network_dns_resolver_cares_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/network/dns_resolver/cares/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -11,10 +15,12 @@ import (
 	. "github.com/onsi/gomega"
 	core1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var _ = Describe("Plugin", func() {
@@ -146,6 +152,91 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 
+	Context("dns options", func() {
+
+		It("uses V4_ONLY by default", func() {
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetDnsLookupFamily()).To(Equal(envoy_config_cluster_v3.Cluster_V4_ONLY))
+			Expect(out.GetDnsRefreshRate()).To(BeNil())
+			Expect(out.GetTypedDnsResolverConfig()).To(BeNil())
+		})
+
+		It("applies the dns options of the upstream", func() {
+			upstreamSpec.DnsOptions = &dns.DnsOptions{
+				DiscoveryType:  dns.DnsOptions_LOGICAL_DNS,
+				LookupFamily:   dns.DnsOptions_V4_PREFERRED,
+				DnsRefreshRate: durationpb.New(30 * time.Second),
+				RespectDnsTtl:  &wrappers.BoolValue{Value: true},
+				Resolvers:      []string{"10.0.0.10", "10.0.0.11:5353", "[fd00::10]:53"},
+			}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_LOGICAL_DNS))
+			Expect(out.GetDnsLookupFamily()).To(Equal(envoy_config_cluster_v3.Cluster_V4_PREFERRED))
+			Expect(out.GetDnsRefreshRate().AsDuration()).To(Equal(30 * time.Second))
+			Expect(out.GetRespectDnsTtl()).To(BeTrue())
+
+			Expect(out.GetTypedDnsResolverConfig().GetName()).To(Equal(CaresDnsResolverName))
+			resolverConfig := utils.MustAnyToMessage(out.GetTypedDnsResolverConfig().GetTypedConfig()).(*envoy_extensions_network_dns_resolver_cares_v3.CaresDnsResolverConfig)
+			var resolvers []string
+			for _, resolver := range resolverConfig.GetResolvers() {
+				socketAddress := resolver.GetSocketAddress()
+				resolvers = append(resolvers, fmt.Sprintf("%s:%d", socketAddress.GetAddress(), socketAddress.GetPortValue()))
+			}
+			Expect(resolvers).To(Equal([]string{"10.0.0.10:53", "10.0.0.11:5353", "fd00::10:53"}))
+		})
+
+		It("uses the defaults of the settings for the fields the upstream does not set", func() {
+			initParams.Settings = &v1.Settings{
+				Gloo: &v1.GlooOptions{
+					StaticUpstreamDnsOptions: &dns.DnsOptions{
+						LookupFamily:   dns.DnsOptions_ALL,
+						DnsRefreshRate: durationpb.New(time.Minute),
+					},
+				},
+			}
+			p.Init(initParams)
+			upstreamSpec.DnsOptions = &dns.DnsOptions{
+				DnsRefreshRate: durationpb.New(10 * time.Second),
+			}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STRICT_DNS))
+			Expect(out.GetDnsLookupFamily()).To(Equal(envoy_config_cluster_v3.Cluster_ALL))
+			Expect(out.GetDnsRefreshRate().AsDuration()).To(Equal(10 * time.Second))
+		})
+
+		It("errors if the dns refresh rate is not greater than 1ms", func() {
+			upstreamSpec.DnsOptions = &dns.DnsOptions{
+				DnsRefreshRate: durationpb.New(time.Millisecond),
+			}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError("dns refresh rate must be greater than 1ms: 1ms"))
+		})
+
+		It("ignores the dns options if all hosts are IPs", func() {
+			upstreamSpec.Hosts[0].Addr = "1.2.3.4"
+			upstreamSpec.DnsOptions = &dns.DnsOptions{DiscoveryType: dns.DnsOptions_LOGICAL_DNS}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STATIC))
+		})
+
+		It("errors when a LOGICAL_DNS upstream has several hosts", func() {
+			upstreamSpec.Hosts = append(upstreamSpec.Hosts, &v1static.Host{Addr: "test.solo.io", Port: 1234})
+			upstreamSpec.DnsOptions = &dns.DnsOptions{DiscoveryType: dns.DnsOptions_LOGICAL_DNS}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(LogicalDnsMultipleHostsError(2).Error()))
+		})
+
+		It("errors on resolvers which are not IP addresses", func() {
+			upstreamSpec.DnsOptions = &dns.DnsOptions{Resolvers: []string{"dns.solo.io:53"}}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(InvalidDnsResolverError("dns.solo.io:53").Error()))
+		})
+	})
+
 	Context("health check config", func() {
 		It("health check config gets propagated", func() {
 			upstreamSpec.Hosts[0].HealthCheckConfig = &v1static.Host_HealthCheckConfig{
@@ -0,0 +1,266 @@
+package tap
+
+import (
+	"context"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_extensions_common_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_extensions_filters_http_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_extensions_transport_sockets_raw_buffer_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	envoy_extensions_transport_sockets_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tap/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "tap"
+	FilterName    = "envoy.filters.http.tap"
+)
+
+var (
+	// the requests should be captured as they are received, before any other filter modifies them
+	pluginStage = plugins.BeforeStage(plugins.FaultStage)
+
+	MissingSinkError    = eris.New("a sink must be specified for tap")
+	AdminSinkMatchError = eris.New("match and max buffered bytes cannot be set on a tap with an admin sink, " +
+		"they are specified by the tap sessions")
+	TcpMatchError      = eris.New("match conditions are not supported when tapping tcp listeners")
+	NoListenerTapError = eris.New("the tap cannot be enabled on a route, the listener has no tap")
+
+	formats = map[tap.Tap_Format]envoy_config_tap_v3.OutputSink_Format{
+		tap.Tap_JSON_BODY_AS_BYTES:            envoy_config_tap_v3.OutputSink_JSON_BODY_AS_BYTES,
+		tap.Tap_JSON_BODY_AS_STRING:           envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING,
+		tap.Tap_PROTO_BINARY:                  envoy_config_tap_v3.OutputSink_PROTO_BINARY,
+		tap.Tap_PROTO_BINARY_LENGTH_DELIMITED: envoy_config_tap_v3.OutputSink_PROTO_BINARY_LENGTH_DELIMITED,
+		tap.Tap_PROTO_TEXT:                    envoy_config_tap_v3.OutputSink_PROTO_TEXT,
+	}
+)
+
+type plugin struct {
+	// whether the tap of each HTTP listener only captures the routes which enable it
+	selectsRoutes map[*v1.HttpListener]bool
+}
+
+func NewPlugin() *plugin {
+	return &plugin{selectsRoutes: map[*v1.HttpListener]bool{}}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.selectsRoutes = map[*v1.HttpListener]bool{}
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	tapConfig := listener.GetOptions().GetTap()
+	if tapConfig == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	commonConfig, err := TranslateTap(params.Ctx, tapConfig, true)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_extensions_filters_http_tap_v3.Tap{
+		CommonConfig: commonConfig,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+// ProcessRoute disables the tap filter of the listener on the routes whose traffic must not be captured.
+// Envoy selects the filters of a request by its initial route, so the tap follows the route selected for the request.
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	listener := params.HttpListener
+	if listener.GetOptions().GetTap() == nil {
+		// disabling the tap is a no-op, but the traffic of a route enabling it would not be captured
+		if in.GetOptions().GetTap().GetValue() {
+			return NoListenerTapError
+		}
+		return nil
+	}
+
+	captured := in.GetOptions().GetTap().GetValue()
+	if in.GetOptions().GetTap() == nil {
+		captured = !p.listenerSelectsRoutes(listener)
+	}
+	if captured {
+		return nil
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, &envoy_config_route_v3.FilterConfig{Disabled: true})
+}
+
+// listenerSelectsRoutes returns whether a route of the listener enables the tap,
+// in which case only the traffic of the routes which enable it is captured.
+func (p *plugin) listenerSelectsRoutes(listener *v1.HttpListener) bool {
+	if selects, ok := p.selectsRoutes[listener]; ok {
+		return selects
+	}
+	selects := false
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetTap().GetValue() {
+				selects = true
+			}
+		}
+	}
+	p.selectsRoutes[listener] = selects
+	return selects
+}
+
+// WrapTransportSocket wraps the transport socket of a TCP filter chain with a tap transport socket,
+// which captures the data sent and received on the connections of the filter chain.
+// A nil transport socket stands for the default plaintext transport socket.
+func WrapTransportSocket(
+	ctx context.Context,
+	in *tap.Tap,
+	transportSocket *envoy_config_core_v3.TransportSocket,
+) (*envoy_config_core_v3.TransportSocket, error) {
+	commonConfig, err := TranslateTap(ctx, in, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if transportSocket == nil {
+		rawBuffer, err := utils.MessageToAny(&envoy_extensions_transport_sockets_raw_buffer_v3.RawBuffer{})
+		if err != nil {
+			return nil, err
+		}
+		transportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketRawBuffer,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: rawBuffer},
+		}
+	}
+
+	config := &envoy_extensions_transport_sockets_tap_v3.Tap{
+		CommonConfig:    commonConfig,
+		TransportSocket: transportSocket,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTap,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
+// TranslateTap converts the tap options into the configuration shared by the tap filter and the tap transport socket.
+// Match conditions are only supported for HTTP traffic.
+func TranslateTap(ctx context.Context, in *tap.Tap, http bool) (*envoy_extensions_common_tap_v3.CommonExtensionConfig, error) {
+	if in.GetMatch() != nil && !http {
+		return nil, TcpMatchError
+	}
+
+	switch sink := in.GetSink().(type) {
+	case *tap.Tap_AdminSink_:
+		if in.GetMatch() != nil || in.GetMaxBufferedBytes() != nil {
+			return nil, AdminSinkMatchError
+		}
+		return &envoy_extensions_common_tap_v3.CommonExtensionConfig{
+			ConfigType: &envoy_extensions_common_tap_v3.CommonExtensionConfig_AdminConfig{
+				AdminConfig: &envoy_extensions_common_tap_v3.AdminConfig{
+					ConfigId: sink.AdminSink.GetConfigId(),
+				},
+			},
+		}, nil
+	case *tap.Tap_FileSink_:
+		return &envoy_extensions_common_tap_v3.CommonExtensionConfig{
+			ConfigType: &envoy_extensions_common_tap_v3.CommonExtensionConfig_StaticConfig{
+				StaticConfig: &envoy_config_tap_v3.TapConfig{
+					Match: TranslateMatch(ctx, in.GetMatch()),
+					OutputConfig: &envoy_config_tap_v3.OutputConfig{
+						Sinks: []*envoy_config_tap_v3.OutputSink{{
+							Format: formats[sink.FileSink.GetFormat()],
+							OutputSinkType: &envoy_config_tap_v3.OutputSink_FilePerTap{
+								FilePerTap: &envoy_config_tap_v3.FilePerTapSink{
+									PathPrefix: sink.FileSink.GetPathPrefix(),
+								},
+							},
+						}},
+						MaxBufferedRxBytes: in.GetMaxBufferedBytes(),
+						MaxBufferedTxBytes: in.GetMaxBufferedBytes(),
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, MissingSinkError
+}
+
+// TranslateMatch combines the match conditions into a single predicate, which matches everything if there are no conditions.
+func TranslateMatch(ctx context.Context, in *tap.Tap_Match) *envoy_config_common_matcher_v3.MatchPredicate {
+	requestHeaders := translator.EnvoyHeaderMatcher(ctx, in.GetRequestHeaders())
+	if in.GetPathPrefix() != "" {
+		requestHeaders = append(requestHeaders, &envoy_config_route_v3.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: in.GetPathPrefix()},
+				},
+			},
+		})
+	}
+	responseHeaders := translator.EnvoyHeaderMatcher(ctx, in.GetResponseHeaders())
+
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+	if len(requestHeaders) > 0 {
+		rules = append(rules, &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+				HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{Headers: requestHeaders},
+			},
+		})
+	}
+	if len(responseHeaders) > 0 {
+		rules = append(rules, &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpResponseHeadersMatch{
+				HttpResponseHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{Headers: responseHeaders},
+			},
+		})
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AnyMatch{AnyMatch: true},
+		}
+	case 1:
+		return rules[0]
+	}
+	return &envoy_config_common_matcher_v3.MatchPredicate{
+		Rule: &envoy_config_common_matcher_v3.MatchPredicate_AndMatch{
+			AndMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{Rules: rules},
+		},
+	}
+}
@@ -0,0 +1,274 @@
+package tap_test
+
+import (
+	"context"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_extensions_common_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_extensions_filters_http_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_extensions_transport_sockets_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tap/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloomatchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctx       context.Context
+		params    plugins.Params
+		tapConfig *tap.Tap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		params = plugins.Params{Ctx: ctx}
+		tapConfig = &tap.Tap{
+			Match: &tap.Tap_Match{
+				RequestHeaders: []*gloomatchers.HeaderMatcher{{Name: "x-debug", Value: "true"}},
+				PathPrefix:     "/api",
+			},
+			MaxBufferedBytes: &wrappers.UInt32Value{Value: 4096},
+			Sink: &tap.Tap_FileSink_{
+				FileSink: &tap.Tap_FileSink{
+					PathPrefix: "/tmp/tap/trace",
+					Format:     tap.Tap_JSON_BODY_AS_STRING,
+				},
+			},
+		}
+	})
+
+	Context("HttpFilters", func() {
+
+		It("does not add a filter without tap options", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds a tap filter with a static config", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Tap: tapConfig},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+
+			cfg := &envoy_extensions_filters_http_tap_v3.Tap{}
+			Expect(translator.ParseTypedConfig(filters[0].HttpFilter, cfg)).NotTo(HaveOccurred())
+			Expect(cfg).To(matchers.MatchProto(&envoy_extensions_filters_http_tap_v3.Tap{
+				CommonConfig: &envoy_extensions_common_tap_v3.CommonExtensionConfig{
+					ConfigType: &envoy_extensions_common_tap_v3.CommonExtensionConfig_StaticConfig{
+						StaticConfig: &envoy_config_tap_v3.TapConfig{
+							Match: &envoy_config_common_matcher_v3.MatchPredicate{
+								Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+									HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+										Headers: []*envoy_config_route_v3.HeaderMatcher{
+											{
+												Name: "x-debug",
+												HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+													ExactMatch: "true",
+												},
+											},
+											{
+												Name: ":path",
+												HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+													StringMatch: &envoy_type_matcher_v3.StringMatcher{
+														MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/api"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							OutputConfig: &envoy_config_tap_v3.OutputConfig{
+								Sinks: []*envoy_config_tap_v3.OutputSink{{
+									Format: envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING,
+									OutputSinkType: &envoy_config_tap_v3.OutputSink_FilePerTap{
+										FilePerTap: &envoy_config_tap_v3.FilePerTapSink{PathPrefix: "/tmp/tap/trace"},
+									},
+								}},
+								MaxBufferedRxBytes: &wrappers.UInt32Value{Value: 4096},
+								MaxBufferedTxBytes: &wrappers.UInt32Value{Value: 4096},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("adds a tap filter with an admin config", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Tap: &tap.Tap{
+					Sink: &tap.Tap_AdminSink_{AdminSink: &tap.Tap_AdminSink{ConfigId: "http"}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+
+			cfg := &envoy_extensions_filters_http_tap_v3.Tap{}
+			Expect(translator.ParseTypedConfig(filters[0].HttpFilter, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.GetCommonConfig().GetAdminConfig().GetConfigId()).To(Equal("http"))
+		})
+
+		It("errors without a sink", func() {
+			tapConfig.Sink = nil
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Tap: tapConfig},
+			})
+			Expect(err).To(MatchError(MissingSinkError))
+		})
+
+		It("errors when an admin sink has match conditions", func() {
+			tapConfig.Sink = &tap.Tap_AdminSink_{AdminSink: &tap.Tap_AdminSink{ConfigId: "http"}}
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Tap: tapConfig},
+			})
+			Expect(err).To(MatchError(AdminSinkMatchError))
+		})
+	})
+
+	Context("ProcessRoute", func() {
+
+		var (
+			p            plugins.RoutePlugin
+			listener     *v1.HttpListener
+			tappedRoute  *v1.Route
+			defaultRoute *v1.Route
+		)
+
+		BeforeEach(func() {
+			p = NewPlugin()
+			tappedRoute = &v1.Route{Name: "tapped"}
+			defaultRoute = &v1.Route{Name: "default"}
+			listener = &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Tap: tapConfig},
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{tappedRoute, defaultRoute},
+				}},
+			}
+		})
+
+		processRoute := func(in *v1.Route) *envoy_config_route_v3.Route {
+			out := &envoy_config_route_v3.Route{}
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params, HttpListener: listener},
+			}
+			Expect(p.ProcessRoute(routeParams, in, out)).NotTo(HaveOccurred())
+			return out
+		}
+
+		disabled := func(out *envoy_config_route_v3.Route) bool {
+			config, ok := out.GetTypedPerFilterConfig()[FilterName]
+			if !ok {
+				return false
+			}
+			filterConfig := &envoy_config_route_v3.FilterConfig{}
+			Expect(config.UnmarshalTo(filterConfig)).NotTo(HaveOccurred())
+			return filterConfig.GetDisabled()
+		}
+
+		It("captures every route by default", func() {
+			Expect(disabled(processRoute(tappedRoute))).To(BeFalse())
+			Expect(disabled(processRoute(defaultRoute))).To(BeFalse())
+		})
+
+		It("disables the tap on the routes which disable it", func() {
+			tappedRoute.Options = &v1.RouteOptions{Tap: &wrappers.BoolValue{Value: false}}
+			Expect(disabled(processRoute(tappedRoute))).To(BeTrue())
+			Expect(disabled(processRoute(defaultRoute))).To(BeFalse())
+		})
+
+		It("only captures the routes which enable the tap", func() {
+			tappedRoute.Options = &v1.RouteOptions{Tap: &wrappers.BoolValue{Value: true}}
+			Expect(disabled(processRoute(tappedRoute))).To(BeFalse())
+			Expect(disabled(processRoute(defaultRoute))).To(BeTrue())
+		})
+
+		It("ignores the routes of listeners without a tap", func() {
+			listener.Options = nil
+			tappedRoute.Options = &v1.RouteOptions{Tap: &wrappers.BoolValue{Value: true}}
+			Expect(processRoute(defaultRoute).GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+
+		It("errors on the routes which enable the tap when the listener has no tap", func() {
+			listener.Options = nil
+			tappedRoute.Options = &v1.RouteOptions{Tap: &wrappers.BoolValue{Value: true}}
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params, HttpListener: listener},
+			}
+			err := p.ProcessRoute(routeParams, tappedRoute, &envoy_config_route_v3.Route{})
+			Expect(err).To(MatchError(NoListenerTapError))
+		})
+	})
+
+	Context("TranslateTap", func() {
+
+		It("matches everything without match conditions", func() {
+			tapConfig.Match = nil
+			cfg, err := TranslateTap(ctx, tapConfig, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetStaticConfig().GetMatch().GetAnyMatch()).To(BeTrue())
+		})
+
+		It("requires both request and response matches", func() {
+			tapConfig.Match = &tap.Tap_Match{
+				RequestHeaders:  []*gloomatchers.HeaderMatcher{{Name: "x-debug"}},
+				ResponseHeaders: []*gloomatchers.HeaderMatcher{{Name: ":status", Value: "500"}},
+			}
+			cfg, err := TranslateTap(ctx, tapConfig, true)
+			Expect(err).NotTo(HaveOccurred())
+			rules := cfg.GetStaticConfig().GetMatch().GetAndMatch().GetRules()
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].GetHttpRequestHeadersMatch().GetHeaders()[0].GetName()).To(Equal("x-debug"))
+			Expect(rules[1].GetHttpResponseHeadersMatch().GetHeaders()[0].GetName()).To(Equal(":status"))
+		})
+
+		It("does not support match conditions on tcp listeners", func() {
+			_, err := TranslateTap(ctx, tapConfig, false)
+			Expect(err).To(MatchError(TcpMatchError))
+		})
+	})
+
+	Context("WrapTransportSocket", func() {
+
+		BeforeEach(func() {
+			tapConfig.Match = nil
+		})
+
+		It("wraps the raw buffer transport socket by default", func() {
+			transportSocket, err := WrapTransportSocket(ctx, tapConfig, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transportSocket.GetName()).To(Equal(wellknown.TransportSocketTap))
+
+			cfg := &envoy_extensions_transport_sockets_tap_v3.Tap{}
+			Expect(translator.ParseTypedConfig(transportSocket, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.GetCommonConfig().GetStaticConfig().GetOutputConfig().GetSinks()[0].GetFilePerTap().GetPathPrefix()).To(Equal("/tmp/tap/trace"))
+			Expect(cfg.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketRawBuffer))
+		})
+
+		It("wraps the given transport socket", func() {
+			tlsSocket := &envoy_config_core_v3.TransportSocket{Name: wellknown.TransportSocketTls}
+			transportSocket, err := WrapTransportSocket(ctx, tapConfig, tlsSocket)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg := &envoy_extensions_transport_sockets_tap_v3.Tap{}
+			Expect(translator.ParseTypedConfig(transportSocket, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.GetTransportSocket()).To(matchers.MatchProto(tlsSocket))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
 	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -88,6 +89,14 @@ func (p *plugin) CreateTcpFilterChains(params plugins.Params, parentListener *v1
 			multiErr.Errors = append(multiErr.Errors, err)
 			continue
 		}
+
+		if tapConfig := tcpListenerOptions.GetTap(); tapConfig != nil {
+			filterChain.TransportSocket, err = tap.WrapTransportSocket(params.Ctx, tapConfig, filterChain.GetTransportSocket())
+			if err != nil {
+				multiErr.Errors = append(multiErr.Errors, err)
+				continue
+			}
+		}
 		filterChains = append(filterChains, filterChain)
 	}
 	return filterChains, multiErr.ErrorOrNil()
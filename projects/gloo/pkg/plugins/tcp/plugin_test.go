@@ -9,7 +9,9 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_extensions_transport_sockets_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tap/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -17,6 +19,7 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
@@ -339,6 +342,33 @@ var _ = Describe("Plugin", func() {
 			Expect(filterChains[0].TransportSocketConnectTimeout.Seconds).To(Equal(int64(3)))
 			Expect(filterChains[0].TransportSocketConnectTimeout.Nanos).To(Equal(int32(0)))
 		})
+
+		It("wraps the transport socket with a tap transport socket", func() {
+			tcpListener.GetOptions().Tap = &tap.Tap{
+				Sink: &tap.Tap_AdminSink_{
+					AdminSink: &tap.Tap_AdminSink{ConfigId: "tcp"},
+				},
+			}
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_ForwardSniClusterName{
+						ForwardSniClusterName: &empty.Empty{},
+					},
+				},
+			})
+
+			filterChains, err := createFilterChains()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+
+			Expect(filterChains[0].GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketTap))
+			var cfg envoy_extensions_transport_sockets_tap_v3.Tap
+			err = translatorutil.ParseTypedConfig(filterChains[0].GetTransportSocket(), &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetCommonConfig().GetAdminConfig().GetConfigId()).To(Equal("tcp"))
+			Expect(cfg.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketRawBuffer))
+		})
 	})
 
 })
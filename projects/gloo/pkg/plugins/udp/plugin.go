@@ -0,0 +1,140 @@
+package udp
+
+import (
+	xds_core_v3 "github.com/cncf/xds/go/xds/core/v3"
+	xds_matcher_v3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp_proxy"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UdpListenerPlugin = new(plugin)
+)
+
+const (
+	ExtensionName        = "udp"
+	DefaultUdpStatPrefix = "udp"
+
+	// UdpProxyFilterName is the name of Envoy's UDP proxy listener filter
+	UdpProxyFilterName = "envoy.filters.udp_listener.udp_proxy"
+	// the name of the action which routes every datagram to the upstream cluster
+	udpProxyRouteActionName = "route"
+)
+
+var (
+	NoUpstreamError = func(listenerName string) error {
+		return eris.Errorf("no upstream was specified for udp listener %v", listenerName)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) CreateUdpListenerFilters(params plugins.Params, parentListener *v1.Listener, in *v1.UdpListener) ([]*envoy_config_listener_v3.ListenerFilter, error) {
+	if in.GetUpstream() == nil {
+		return nil, NoUpstreamError(parentListener.GetName())
+	}
+
+	statPrefix := in.GetStatPrefix()
+	if statPrefix == "" {
+		statPrefix = DefaultUdpStatPrefix
+	}
+
+	routeSpecifier, err := routeToCluster(translatorutil.UpstreamToClusterName(in.GetUpstream()))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &envoyudp.UdpProxyConfig{
+		StatPrefix:     statPrefix,
+		RouteSpecifier: routeSpecifier,
+	}
+
+	if udpSettings := in.GetOptions().GetUdpProxySettings(); udpSettings != nil {
+		cfg.IdleTimeout = udpSettings.GetIdleTimeout()
+		cfg.UseOriginalSrcIp = udpSettings.GetUseOriginalSrcIp()
+		cfg.HashPolicies = convertHashPolicies(udpSettings.GetHashPolicies())
+	}
+
+	accessLogConfig, err := als.ProcessAccessLogPlugins(parentListener.GetOptions().GetAccessLoggingService(), cfg.GetAccessLog())
+	if err != nil {
+		return nil, err
+	}
+	cfg.AccessLog = accessLogConfig
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	typedConfig, err := utils.MessageToAny(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return []*envoy_config_listener_v3.ListenerFilter{{
+		Name: UdpProxyFilterName,
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}}, nil
+}
+
+// routeToCluster returns a route specifier which proxies every datagram to the given cluster
+func routeToCluster(clusterName string) (*envoyudp.UdpProxyConfig_Matcher, error) {
+	route, err := utils.MessageToAny(&envoyudp.Route{
+		Cluster: clusterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoyudp.UdpProxyConfig_Matcher{
+		Matcher: &xds_matcher_v3.Matcher{
+			OnNoMatch: &xds_matcher_v3.Matcher_OnMatch{
+				OnMatch: &xds_matcher_v3.Matcher_OnMatch_Action{
+					Action: &xds_core_v3.TypedExtensionConfig{
+						Name:        udpProxyRouteActionName,
+						TypedConfig: route,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func convertHashPolicies(in []*udp_proxy.UdpProxySettings_HashPolicy) []*envoyudp.UdpProxyConfig_HashPolicy {
+	var out []*envoyudp.UdpProxyConfig_HashPolicy
+	for _, hashPolicy := range in {
+		switch policy := hashPolicy.GetPolicySpecifier().(type) {
+		case *udp_proxy.UdpProxySettings_HashPolicy_SourceIp:
+			out = append(out, &envoyudp.UdpProxyConfig_HashPolicy{
+				PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{
+					SourceIp: policy.SourceIp,
+				},
+			})
+		case *udp_proxy.UdpProxySettings_HashPolicy_Key:
+			out = append(out, &envoyudp.UdpProxyConfig_HashPolicy{
+				PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_Key{
+					Key: policy.Key,
+				},
+			})
+		}
+	}
+	return out
+}
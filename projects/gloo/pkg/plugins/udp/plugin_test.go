@@ -0,0 +1,146 @@
+package udp_test
+
+import (
+	"time"
+
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp_proxy"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params      plugins.Params
+		listener    *v1.Listener
+		udpListener *v1.UdpListener
+		upstreamRef *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		upstreamRef = &core.ResourceRef{Name: "dns", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Snapshot: &v1snap.ApiSnapshot{},
+		}
+		udpListener = &v1.UdpListener{
+			Upstream: upstreamRef,
+		}
+		listener = &v1.Listener{
+			Name: "udp",
+			ListenerType: &v1.Listener_UdpListener{
+				UdpListener: udpListener,
+			},
+		}
+	})
+
+	getUdpProxyConfig := func() *envoyudp.UdpProxyConfig {
+		listenerFilters, err := NewPlugin().CreateUdpListenerFilters(params, listener, udpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listenerFilters).To(HaveLen(1))
+		Expect(listenerFilters[0].GetName()).To(Equal(UdpProxyFilterName))
+
+		cfg := &envoyudp.UdpProxyConfig{}
+		Expect(translatorutil.ParseTypedConfig(listenerFilters[0], cfg)).NotTo(HaveOccurred())
+		return cfg
+	}
+
+	It("routes every datagram to the upstream cluster", func() {
+		cfg := getUdpProxyConfig()
+		Expect(cfg.GetStatPrefix()).To(Equal(DefaultUdpStatPrefix))
+
+		onNoMatch := cfg.GetMatcher().GetOnNoMatch()
+		Expect(onNoMatch).NotTo(BeNil())
+		Expect(cfg.GetMatcher().GetMatcherType()).To(BeNil())
+
+		route := &envoyudp.Route{}
+		Expect(translatorutil.ParseTypedConfig(onNoMatch.GetAction(), route)).NotTo(HaveOccurred())
+		Expect(route.GetCluster()).To(Equal(translatorutil.UpstreamToClusterName(upstreamRef)))
+	})
+
+	It("uses the stat prefix of the listener", func() {
+		udpListener.StatPrefix = "dns"
+		Expect(getUdpProxyConfig().GetStatPrefix()).To(Equal("dns"))
+	})
+
+	It("copies the udp proxy settings", func() {
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp_proxy.UdpProxySettings{
+				IdleTimeout:      prototime.DurationToProto(10 * time.Second),
+				UseOriginalSrcIp: true,
+				HashPolicies: []*udp_proxy.UdpProxySettings_HashPolicy{{
+					PolicySpecifier: &udp_proxy.UdpProxySettings_HashPolicy_SourceIp{SourceIp: true},
+				}},
+			},
+		}
+
+		cfg := getUdpProxyConfig()
+		Expect(cfg.GetIdleTimeout()).To(matchers.MatchProto(prototime.DurationToProto(10 * time.Second)))
+		Expect(cfg.GetUseOriginalSrcIp()).To(BeTrue())
+		Expect(cfg.GetHashPolicies()).To(HaveLen(1))
+		Expect(cfg.GetHashPolicies()[0]).To(matchers.MatchProto(&envoyudp.UdpProxyConfig_HashPolicy{
+			PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{SourceIp: true},
+		}))
+	})
+
+	It("supports hashing on a key", func() {
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp_proxy.UdpProxySettings{
+				HashPolicies: []*udp_proxy.UdpProxySettings_HashPolicy{{
+					PolicySpecifier: &udp_proxy.UdpProxySettings_HashPolicy_Key{Key: "session"},
+				}},
+			},
+		}
+
+		Expect(getUdpProxyConfig().GetHashPolicies()[0].GetKey()).To(Equal("session"))
+	})
+
+	It("configures access logging from the listener options", func() {
+		listener.Options = &v1.ListenerOptions{
+			AccessLoggingService: &als.AccessLoggingService{
+				AccessLog: []*als.AccessLog{{
+					OutputDestination: &als.AccessLog_FileSink{
+						FileSink: &als.FileSink{
+							Path: "/dev/stdout",
+							OutputFormat: &als.FileSink_StringFormat{
+								StringFormat: "",
+							},
+						},
+					},
+				}},
+			},
+		}
+
+		Expect(getUdpProxyConfig().GetAccessLog()).To(HaveLen(1))
+	})
+
+	It("rejects invalid udp proxy settings", func() {
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp_proxy.UdpProxySettings{
+				HashPolicies: []*udp_proxy.UdpProxySettings_HashPolicy{{
+					PolicySpecifier: &udp_proxy.UdpProxySettings_HashPolicy_Key{Key: ""},
+				}},
+			},
+		}
+
+		_, err := NewPlugin().CreateUdpListenerFilters(params, listener, udpListener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("errors without an upstream", func() {
+		udpListener.Upstream = nil
+
+		_, err := NewPlugin().CreateUdpListenerFilters(params, listener, udpListener)
+		Expect(err).To(MatchError(NoUpstreamError("udp")))
+	})
+
+})
@@ -0,0 +1,133 @@
+package upstreamproxyprotocol
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoy_extensions_transport_sockets_raw_buffer_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/proxyprotocol"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin         = new(plugin)
+	_ plugins.UpstreamPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "upstream_proxy_protocol"
+
+	TransportSocketName = "envoy.transport_sockets.upstream_proxy_protocol"
+)
+
+var (
+	Http3Error = eris.New("the proxy protocol cannot be used by upstreams which use http3, " +
+		"as the header can only be sent on TCP connections")
+	HttpConnectError = eris.New("the proxy protocol cannot be used by upstreams which tunnel their connections " +
+		"through an http connect proxy")
+	PassThroughTlvsVersionError = eris.New("the proxy protocol can only pass TLVs through with version V2")
+)
+
+// Wraps the transport sockets of the upstream cluster with a proxy protocol transport socket.
+// The plugin must run after the plugins which set the transport sockets, e.g. to originate TLS.
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	proxyProtocol := in.GetProxyProtocol()
+	if proxyProtocol == nil {
+		return nil
+	}
+
+	if in.GetUseHttp3().GetValue() {
+		return Http3Error
+	}
+	if in.GetHttpProxyHostname() != nil {
+		return HttpConnectError
+	}
+
+	config, err := translateProxyProtocol(proxyProtocol)
+	if err != nil {
+		return err
+	}
+
+	out.TransportSocket, err = wrapTransportSocket(config, out.GetTransportSocket())
+	if err != nil {
+		return err
+	}
+	// the hosts of static upstreams can have their own transport sockets, e.g. to use a different sni
+	for _, match := range out.GetTransportSocketMatches() {
+		match.TransportSocket, err = wrapTransportSocket(config, match.GetTransportSocket())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func translateProxyProtocol(in *proxy_protocol.UpstreamProxyProtocol) (*envoy_config_core_v3.ProxyProtocolConfig, error) {
+	if in.GetPassThroughTlvs() != nil && in.GetVersion() != proxy_protocol.UpstreamProxyProtocol_V2 {
+		return nil, PassThroughTlvsVersionError
+	}
+
+	passThroughTlvs, err := proxyprotocol.ConvertPassThroughTlvs(in.GetPassThroughTlvs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_config_core_v3.ProxyProtocolConfig{
+		Version:         envoy_config_core_v3.ProxyProtocolConfig_Version(in.GetVersion()),
+		PassThroughTlvs: passThroughTlvs,
+	}, nil
+}
+
+// wrapTransportSocket wraps a transport socket with a proxy protocol transport socket, which sends the proxy protocol
+// header before the data of the wrapped socket. A nil transport socket stands for the default plaintext transport socket.
+func wrapTransportSocket(
+	config *envoy_config_core_v3.ProxyProtocolConfig,
+	transportSocket *envoy_config_core_v3.TransportSocket,
+) (*envoy_config_core_v3.TransportSocket, error) {
+	if transportSocket == nil {
+		rawBuffer, err := utils.MessageToAny(&envoy_extensions_transport_sockets_raw_buffer_v3.RawBuffer{})
+		if err != nil {
+			return nil, err
+		}
+		transportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketRawBuffer,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: rawBuffer},
+		}
+	}
+
+	upstreamProxyProtocol := &envoy_extensions_transport_sockets_proxy_protocol_v3.ProxyProtocolUpstreamTransport{
+		Config:          config,
+		TransportSocket: transportSocket,
+	}
+	if err := upstreamProxyProtocol.Validate(); err != nil {
+		return nil, err
+	}
+
+	typedConfig, err := utils.MessageToAny(upstreamProxyProtocol)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       TransportSocketName,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
@@ -0,0 +1,118 @@
+package upstreamproxyprotocol_test
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamproxyprotocol"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        plugins.UpstreamPlugin
+		params   plugins.Params
+		upstream *v1.Upstream
+		out      *envoy_config_cluster_v3.Cluster
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{})
+		upstream = &v1.Upstream{
+			ProxyProtocol: &proxy_protocol.UpstreamProxyProtocol{},
+		}
+		out = &envoy_config_cluster_v3.Cluster{}
+	})
+
+	unwrap := func(transportSocket *envoy_config_core_v3.TransportSocket) *envoy_extensions_transport_sockets_proxy_protocol_v3.ProxyProtocolUpstreamTransport {
+		ExpectWithOffset(1, transportSocket.GetName()).To(Equal(TransportSocketName))
+		return utils.MustAnyToMessage(transportSocket.GetTypedConfig()).(*envoy_extensions_transport_sockets_proxy_protocol_v3.ProxyProtocolUpstreamTransport)
+	}
+
+	tlsTransportSocket := func(sni string) *envoy_config_core_v3.TransportSocket {
+		typedConfig, err := utils.MessageToAny(&envoyauth.UpstreamTlsContext{Sni: sni})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	}
+
+	It("does nothing without the proxy protocol option", func() {
+		upstream.ProxyProtocol = nil
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTransportSocket()).To(BeNil())
+	})
+
+	It("wraps the plaintext transport socket", func() {
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		config := unwrap(out.GetTransportSocket())
+		Expect(config.GetConfig().GetVersion()).To(Equal(envoy_config_core_v3.ProxyProtocolConfig_V1))
+		Expect(config.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketRawBuffer))
+	})
+
+	It("wraps the tls transport sockets of the cluster and of its hosts", func() {
+		upstream.ProxyProtocol = &proxy_protocol.UpstreamProxyProtocol{
+			Version: proxy_protocol.UpstreamProxyProtocol_V2,
+			PassThroughTlvs: &proxy_protocol.PassThroughTlvs{
+				MatchType: proxy_protocol.PassThroughTlvs_INCLUDE,
+				TlvTypes:  []uint32{0xE0},
+			},
+		}
+		out.TransportSocket = tlsTransportSocket("cluster.solo.io")
+		out.TransportSocketMatches = []*envoy_config_cluster_v3.Cluster_TransportSocketMatch{{
+			Name:            "host",
+			TransportSocket: tlsTransportSocket("host.solo.io"),
+		}}
+
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedConfig := &envoy_config_core_v3.ProxyProtocolConfig{
+			Version: envoy_config_core_v3.ProxyProtocolConfig_V2,
+			PassThroughTlvs: &envoy_config_core_v3.ProxyProtocolPassThroughTLVs{
+				MatchType: envoy_config_core_v3.ProxyProtocolPassThroughTLVs_INCLUDE,
+				TlvType:   []uint32{0xE0},
+			},
+		}
+		config := unwrap(out.GetTransportSocket())
+		Expect(config.GetConfig()).To(matchers.MatchProto(expectedConfig))
+		Expect(config.GetTransportSocket()).To(matchers.MatchProto(tlsTransportSocket("cluster.solo.io")))
+
+		hostConfig := unwrap(out.GetTransportSocketMatches()[0].GetTransportSocket())
+		Expect(hostConfig.GetConfig()).To(matchers.MatchProto(expectedConfig))
+		Expect(hostConfig.GetTransportSocket()).To(matchers.MatchProto(tlsTransportSocket("host.solo.io")))
+	})
+
+	It("errors when TLVs are passed through with version V1", func() {
+		upstream.ProxyProtocol.PassThroughTlvs = &proxy_protocol.PassThroughTlvs{}
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(PassThroughTlvsVersionError))
+	})
+
+	It("errors when the upstream uses http3", func() {
+		upstream.UseHttp3 = &wrappers.BoolValue{Value: true}
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(Http3Error))
+	})
+
+	It("errors when the upstream uses an http connect proxy", func() {
+		upstream.HttpProxyHostname = &wrappers.StringValue{Value: "proxy.solo.io:443"}
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(HttpConnectError))
+	})
+})
@@ -0,0 +1,15 @@
+package upstreamproxyprotocol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/reporters"
+	. "github.com/onsi/gomega"
+)
+
+func TestUpstreamProxyProtocol(t *testing.T) {
+	RegisterFailHandler(Fail)
+	junitReporter := reporters.NewJUnitReporter("junit.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "Upstream Proxy Protocol Suite", []Reporter{junitReporter})
+}
@@ -4,6 +4,9 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
 
+// FunctionDiscoveryAnnotation enables or disables function discovery for an upstream
+const FunctionDiscoveryAnnotation = "discovery.solo.io/function_discovery"
+
 // for use by UDS plugins
 // copies parts of the UpstreamSpec that are not
 // set by discovery but may be set by the user or function discovery
@@ -47,6 +50,10 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 		desired.UseHttp2 = original.GetUseHttp2()
 	}
 
+	if desired.GetUseHttp3() == nil {
+		desired.UseHttp3 = original.GetUseHttp3()
+	}
+
 	if desired.GetInitialConnectionWindowSize() == nil {
 		desired.InitialConnectionWindowSize = original.GetInitialConnectionWindowSize()
 	}
@@ -63,6 +70,14 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 		desired.HttpProxyHostname = original.GetHttpProxyHostname()
 	}
 
+	if desired.GetProxyProtocol() == nil {
+		desired.ProxyProtocol = original.GetProxyProtocol()
+	}
+
+	if desired.GetPreferSameZone() == nil {
+		desired.PreferSameZone = original.GetPreferSameZone()
+	}
+
 	if desiredSubsetMutator, ok := desired.GetUpstreamType().(v1.SubsetSpecMutator); ok {
 		if desiredSubsetMutator.GetSubsetSpec() == nil {
 			desiredSubsetMutator.SetSubsetSpec(original.GetUpstreamType().(v1.SubsetSpecGetter).GetSubsetSpec())
@@ -72,4 +87,23 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 	if desired.GetIgnoreHealthOnHostRemoval() == nil {
 		desired.IgnoreHealthOnHostRemoval = original.GetIgnoreHealthOnHostRemoval()
 	}
+
+	// the function discovery status is written by function discovery
+	if originalStatus := original.GetDiscoveryMetadata().GetFunctionDiscoveryStatus(); originalStatus != nil &&
+		desired.GetDiscoveryMetadata().GetFunctionDiscoveryStatus() == nil {
+		if desired.GetDiscoveryMetadata() == nil {
+			desired.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+		}
+		desired.GetDiscoveryMetadata().FunctionDiscoveryStatus = originalStatus
+	}
+
+	// the user may opt the upstream out of function discovery
+	if value, ok := original.GetMetadata().GetAnnotations()[FunctionDiscoveryAnnotation]; ok && desired.GetMetadata() != nil {
+		if _, ok := desired.GetMetadata().GetAnnotations()[FunctionDiscoveryAnnotation]; !ok {
+			if desired.GetMetadata().GetAnnotations() == nil {
+				desired.GetMetadata().Annotations = map[string]string{}
+			}
+			desired.GetMetadata().GetAnnotations()[FunctionDiscoveryAnnotation] = value
+		}
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
 	envoycore_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
@@ -17,8 +18,22 @@ import (
 var _ = Describe("UpdateUpstream", func() {
 
 	It("should preserve config when updating upstreams", func() {
-		desired := &gloov1.Upstream{}
+		desired := &gloov1.Upstream{
+			Metadata:          &core.Metadata{Name: "us", Namespace: "ns"},
+			DiscoveryMetadata: &gloov1.DiscoveryMetadata{Labels: map[string]string{"app": "us"}},
+		}
 		original := &gloov1.Upstream{
+			Metadata: &core.Metadata{
+				Name:      "us",
+				Namespace: "ns",
+				Annotations: map[string]string{
+					"discovery.solo.io/function_discovery":             "disabled",
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				},
+			},
+			DiscoveryMetadata: &gloov1.DiscoveryMetadata{
+				FunctionDiscoveryStatus: &gloov1.FunctionDiscoveryStatus{FunctionType: "rest", Error: "unreachable"},
+			},
 			SslConfig:                               &gloov1.UpstreamSslConfig{Sni: "testsni"},
 			CircuitBreakers:                         &gloov1.CircuitBreakerConfig{MaxConnections: &wrappers.UInt32Value{Value: 6}},
 			LoadBalancerConfig:                      &gloov1.LoadBalancerConfig{HealthyPanicThreshold: &wrappers.DoubleValue{Value: 7}},
@@ -27,8 +42,11 @@ var _ = Describe("UpdateUpstream", func() {
 			OutlierDetection:                        &cluster.OutlierDetection{Consecutive_5Xx: &wrappers.UInt32Value{Value: 9}},
 			Failover:                                &gloov1.Failover{PrioritizedLocalities: []*gloov1.Failover_PrioritizedLocality{{}}},
 			UseHttp2:                                &wrappers.BoolValue{Value: true},
+			UseHttp3:                                &wrappers.BoolValue{Value: true},
 			HttpProxyHostname:                       &wrappers.StringValue{Value: "hostname"},
 			OverrideStreamErrorOnInvalidHttpMessage: &wrappers.BoolValue{Value: true},
+			ProxyProtocol:                           &proxy_protocol.UpstreamProxyProtocol{Version: proxy_protocol.UpstreamProxyProtocol_V2},
+			PreferSameZone:                          &gloov1.PreferSameZone{MinEndpoints: &wrappers.UInt64Value{Value: 3}},
 		}
 		utils.UpdateUpstream(original, desired)
 		Expect(desired.SslConfig).To(Equal(original.SslConfig))
@@ -39,8 +57,33 @@ var _ = Describe("UpdateUpstream", func() {
 		Expect(desired.OutlierDetection).To(Equal(original.OutlierDetection))
 		Expect(desired.Failover).To(Equal(original.Failover))
 		Expect(desired.UseHttp2).To(Equal(original.UseHttp2))
+		Expect(desired.UseHttp3).To(Equal(original.UseHttp3))
 		Expect(desired.HttpProxyHostname).To(Equal(original.HttpProxyHostname))
 		Expect(desired.OverrideStreamErrorOnInvalidHttpMessage).To(Equal(original.OverrideStreamErrorOnInvalidHttpMessage))
+		Expect(desired.ProxyProtocol).To(Equal(original.ProxyProtocol))
+		Expect(desired.PreferSameZone).To(Equal(original.PreferSameZone))
+		Expect(desired.DiscoveryMetadata.FunctionDiscoveryStatus).To(Equal(original.DiscoveryMetadata.FunctionDiscoveryStatus))
+		Expect(desired.DiscoveryMetadata.Labels).To(Equal(map[string]string{"app": "us"}))
+		Expect(desired.Metadata.Annotations).To(Equal(map[string]string{"discovery.solo.io/function_discovery": "disabled"}))
+	})
+
+	It("should keep the annotations set by discovery", func() {
+		desired := &gloov1.Upstream{
+			Metadata: &core.Metadata{
+				Name:        "us",
+				Namespace:   "ns",
+				Annotations: map[string]string{"discovered": "true"},
+			},
+		}
+		original := &gloov1.Upstream{
+			Metadata: &core.Metadata{
+				Name:        "us",
+				Namespace:   "ns",
+				Annotations: map[string]string{"discovered": "false", "discovery.solo.io/function_discovery": "disabled"},
+			},
+		}
+		utils.UpdateUpstream(original, desired)
+		Expect(desired.Metadata.Annotations).To(Equal(map[string]string{"discovered": "true", "discovery.solo.io/function_discovery": "disabled"}))
 	})
 
 	It("should update config when one is desired", func() {
@@ -101,7 +144,7 @@ var _ = Describe("UpdateUpstream", func() {
 		// This should happen very rarely, and should be used as an indication that the `UpdateUpstream` function
 		// most likely needs to change.
 		Expect(reflect.TypeOf(gloov1.Upstream{}).NumField()).To(
-			Equal(24),
+			Equal(27),
 			"wrong number of fields found",
 		)
 	})
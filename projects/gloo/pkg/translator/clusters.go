@@ -6,6 +6,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
@@ -245,6 +246,10 @@ func validateCluster(c *envoy_config_cluster_v3.Cluster) error {
 			return eris.Errorf("cluster type %v specified but LoadAssignment was empty", clusterType.String())
 		}
 	}
+	// circuit breakers are configured by the user, e.g. a retry budget percentage over 100
+	if err := c.GetCircuitBreakers().Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -258,6 +263,7 @@ func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoy_config_cluster_
 				MaxPendingRequests: cfg.GetMaxPendingRequests(),
 				MaxRequests:        cfg.GetMaxRequests(),
 				MaxRetries:         cfg.GetMaxRetries(),
+				RetryBudget:        getRetryBudget(cfg.GetRetryBudget()),
 			}}
 			return envoyCfg
 		}
@@ -265,6 +271,19 @@ func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoy_config_cluster_
 	return nil
 }
 
+func getRetryBudget(cfg *v1.RetryBudget) *envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget {
+	if cfg == nil {
+		return nil
+	}
+	retryBudget := &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+		MinRetryConcurrency: cfg.GetMinRetryConcurrency(),
+	}
+	if budgetPercent := cfg.GetBudgetPercent(); budgetPercent != nil {
+		retryBudget.BudgetPercent = &envoy_type_v3.Percent{Value: budgetPercent.GetValue()}
+	}
+	return retryBudget
+}
+
 func getHttp2options(us *v1.Upstream) *envoy_config_core_v3.Http2ProtocolOptions {
 	if us.GetUseHttp2().GetValue() {
 		return &envoy_config_core_v3.Http2ProtocolOptions{}
@@ -6,4 +6,11 @@ import (
 
 var (
 	ClusterConnectionTimeout = time.Second * 5
+
+	// how long clients may cache the advertisement of HTTP/3 by default
+	defaultAltSvcMaxAge = time.Hour * 24
+)
+
+const (
+	altSvcHeader = "alt-svc"
 )
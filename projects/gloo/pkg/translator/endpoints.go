@@ -1,10 +1,16 @@
 package translator
 
 import (
+	"fmt"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.opencensus.io/trace"
 
@@ -14,6 +20,17 @@ import (
 const EnvoyLb = "envoy.lb"
 const SoloAnnotations = "io.solo.annotations"
 
+// LocalClusterName is the name of the cluster of the Envoy proxies of a Proxy, which the bootstrap configuration of
+// the proxies sets as their local_cluster_name for zone aware routing.
+const LocalClusterName = "gloo_local_cluster"
+
+var NoZonedLocalClusterWarning = func(proxy *v1.Proxy) string {
+	return fmt.Sprintf("preferSameZone has no effect for proxy %s, as the endpoints of its Kubernetes service %s.%s "+
+		"have no zone. The service must be discovered as an upstream, with localityFromNodeLabels enabled in the "+
+		"kubernetes settings, and the proxies must be deployed with gatewayProxy zoneAwareRouting enabled",
+		proxy.GetMetadata().GetName(), proxy.GetMetadata().GetName(), proxy.GetMetadata().GetNamespace())
+}
+
 // Endpoints
 
 func (t *translatorInstance) computeClusterEndpoints(
@@ -42,13 +59,141 @@ func (t *translatorInstance) computeClusterEndpoints(
 	return clusterEndpointAssignments
 }
 
+// computeLocalClusterEndpoints returns the load assignment of the local cluster of the proxy, from the endpoints of the
+// Kubernetes upstream of its service, named after the proxy. It is only returned when the proxy routes to upstreams
+// that prefer the zone of the proxy, which get a warning when the local cluster has no zone, as Envoy then ignores
+// their zone aware configuration.
+func computeLocalClusterEndpoints(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	reports reporter.ResourceReports,
+) *envoy_config_endpoint_v3.ClusterLoadAssignment {
+	zonedUpstreams := preferSameZoneUpstreams(params, proxy)
+	if len(zonedUpstreams) == 0 {
+		return nil
+	}
+
+	var localUpstream *v1.Upstream
+	for _, upstream := range params.Snapshot.Upstreams {
+		kubeSpec := upstream.GetKube()
+		if kubeSpec.GetServiceName() != proxy.GetMetadata().GetName() ||
+			kubeSpec.GetServiceNamespace() != proxy.GetMetadata().GetNamespace() {
+			continue
+		}
+		// a service has an upstream per port, with the same pods
+		if localUpstream == nil || upstream.GetMetadata().GetName() < localUpstream.GetMetadata().GetName() {
+			localUpstream = upstream
+		}
+	}
+
+	loadAssignment := &envoy_config_endpoint_v3.ClusterLoadAssignment{}
+	if localUpstream != nil {
+		loadAssignment = loadAssignmentForUpstream(localUpstream, upstreamRefKeyToEndpoints[localUpstream.GetMetadata().Ref().Key()])
+	}
+	loadAssignment.ClusterName = LocalClusterName
+
+	zoned := false
+	for _, localityEndpoints := range loadAssignment.GetEndpoints() {
+		if localityEndpoints.GetLocality().GetZone() != "" {
+			zoned = true
+			break
+		}
+	}
+	if !zoned {
+		for _, upstream := range zonedUpstreams {
+			reports.AddWarning(upstream, NoZonedLocalClusterWarning(proxy))
+		}
+	}
+
+	return loadAssignment
+}
+
+// preferSameZoneUpstreams returns the upstreams with preferSameZone which the proxy routes to
+func preferSameZoneUpstreams(params plugins.Params, proxy *v1.Proxy) []*v1.Upstream {
+	routed := map[string]bool{}
+	addDestinations := func(destinations ...*v1.Destination) {
+		for _, destination := range destinations {
+			ref, err := usconversion.DestinationToUpstreamRef(destination)
+			if err != nil {
+				continue
+			}
+			routed[ref.Key()] = true
+		}
+	}
+	addWeightedDestinations := func(destinations []*v1.WeightedDestination) {
+		for _, destination := range destinations {
+			addDestinations(destination.GetDestination())
+		}
+	}
+	addUpstreamGroup := func(ref *core.ResourceRef) {
+		if upstreamGroup, err := params.Snapshot.UpstreamGroups.Find(ref.GetNamespace(), ref.GetName()); err == nil {
+			addWeightedDestinations(upstreamGroup.GetDestinations())
+		}
+	}
+
+	for _, listener := range proxy.GetListeners() {
+		for _, virtualHost := range utils.GetVirtualHostsForListener(listener) {
+			for _, route := range virtualHost.GetRoutes() {
+				switch dest := route.GetRouteAction().GetDestination().(type) {
+				case *v1.RouteAction_Single:
+					addDestinations(dest.Single)
+				case *v1.RouteAction_Multi:
+					addWeightedDestinations(dest.Multi.GetDestinations())
+				case *v1.RouteAction_UpstreamGroup:
+					addUpstreamGroup(dest.UpstreamGroup)
+				}
+			}
+		}
+		for _, tcpHost := range tcpHostsForListener(listener) {
+			switch dest := tcpHost.GetDestination().GetDestination().(type) {
+			case *v1.TcpHost_TcpAction_Single:
+				addDestinations(dest.Single)
+			case *v1.TcpHost_TcpAction_Multi:
+				addWeightedDestinations(dest.Multi.GetDestinations())
+			case *v1.TcpHost_TcpAction_UpstreamGroup:
+				addUpstreamGroup(dest.UpstreamGroup)
+			}
+		}
+	}
+
+	var upstreams []*v1.Upstream
+	for _, upstream := range params.Snapshot.Upstreams {
+		if upstream.GetPreferSameZone() != nil && routed[upstream.GetMetadata().Ref().Key()] {
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
+func tcpHostsForListener(listener *v1.Listener) []*v1.TcpHost {
+	var tcpHosts []*v1.TcpHost
+	switch typedListener := listener.GetListenerType().(type) {
+	case *v1.Listener_TcpListener:
+		tcpHosts = typedListener.TcpListener.GetTcpHosts()
+	case *v1.Listener_HybridListener:
+		for _, matchedListener := range typedListener.HybridListener.GetMatchedListeners() {
+			tcpHosts = append(tcpHosts, matchedListener.GetTcpListener().GetTcpHosts()...)
+		}
+	}
+	return tcpHosts
+}
+
 func loadAssignmentForUpstream(
 	upstream *v1.Upstream,
 	clusterEndpoints []*v1.Endpoint,
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.GetMetadata().Ref())
-	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	// group the endpoints by locality, in the order in which the localities are first seen
+	var localityEndpoints []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	localityIndexes := map[localityKey]int{}
+	seen := map[*v1.Endpoint]bool{}
 	for _, addr := range clusterEndpoints {
+		// an endpoint which lists the upstream several times is only sent once
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
 		metadata := getLbMetadata(upstream, addr.GetMetadata().GetLabels(), "")
 		metadata = addAnnotations(metadata, addr.GetMetadata().GetAnnotations())
 		var healthCheckConfig *envoy_config_endpoint_v3.Endpoint_HealthCheckConfig
@@ -76,15 +221,56 @@ func loadAssignmentForUpstream(
 					Hostname:          addr.GetHostname(),
 				},
 			},
+			HealthStatus: envoy_config_core_v3.HealthStatus(addr.GetHealthStatus()),
+		}
+
+		key := localityKey{
+			region:  addr.GetLocality().GetRegion(),
+			zone:    addr.GetLocality().GetZone(),
+			subZone: addr.GetLocality().GetSubZone(),
+		}
+		index, ok := localityIndexes[key]
+		if !ok {
+			index = len(localityEndpoints)
+			localityIndexes[key] = index
+			localityEndpoints = append(localityEndpoints, &envoy_config_endpoint_v3.LocalityLbEndpoints{
+				Locality: convertLocality(addr.GetLocality()),
+			})
+		}
+		localityEndpoints[index].LbEndpoints = append(localityEndpoints[index].GetLbEndpoints(), &lbEndpoint)
+	}
+
+	if len(localityEndpoints) == 0 {
+		localityEndpoints = []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}}
+	}
+	// Envoy does not send requests to the localities without a weight when the weights of the localities are used,
+	// so weigh them by their endpoints, as without locality weighted load balancing
+	if upstream.GetLoadBalancerConfig().GetLocalityWeightedLbConfig() != nil {
+		for _, endpoints := range localityEndpoints {
+			if len(endpoints.GetLbEndpoints()) > 0 {
+				endpoints.LoadBalancingWeight = &wrappers.UInt32Value{Value: uint32(len(endpoints.GetLbEndpoints()))}
+			}
 		}
-		endpoints = append(endpoints, &lbEndpoint)
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityEndpoints,
+	}
+}
+
+type localityKey struct {
+	region, zone, subZone string
+}
+
+func convertLocality(locality *v1.Locality) *envoy_config_core_v3.Locality {
+	if locality == nil {
+		return nil
+	}
+	return &envoy_config_core_v3.Locality{
+		Region:  locality.GetRegion(),
+		Zone:    locality.GetZone(),
+		SubZone: locality.GetSubZone(),
 	}
 }
 
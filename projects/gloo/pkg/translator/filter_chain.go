@@ -33,6 +33,8 @@ var _ FilterChainTranslator = new(httpFilterChainTranslator)
 type tcpFilterChainTranslator struct {
 	// List of TcpFilterChainPlugins to process
 	plugins []plugins.TcpFilterChainPlugin
+	// List of NetworkFilterPlugins to process
+	networkPlugins []plugins.NetworkFilterPlugin
 	// The parent Listener, this is only used to associate errors with the parent resource
 	parentListener *v1.Listener
 	// The TcpListener used to generate the list of FilterChains
@@ -60,7 +62,16 @@ func (t *tcpFilterChainTranslator) ComputeFilterChains(params plugins.Params) []
 		filterChains = append(filterChains, pluginFilterChains...)
 	}
 
-	// 2. Apply SourcePrefixRange to FilterChainMatch, if defined
+	// 2. Run the network filter plugins, and add the resulting filters in front of
+	// the filters produced by the tcp filter chain plugins
+	networkFilters := t.computeNetworkFilters(params)
+	if len(networkFilters) > 0 {
+		for _, fc := range filterChains {
+			fc.Filters = append(cloneNetworkFilters(networkFilters), fc.GetFilters()...)
+		}
+	}
+
+	// 3. Apply SourcePrefixRange to FilterChainMatch, if defined
 	if len(t.sourcePrefixRanges) > 0 {
 		for _, fc := range filterChains {
 			applySourcePrefixRangesToFilterChain(fc, t.sourcePrefixRanges)
@@ -70,6 +81,37 @@ func (t *tcpFilterChainTranslator) ComputeFilterChains(params plugins.Params) []
 	return filterChains
 }
 
+func (t *tcpFilterChainTranslator) computeNetworkFilters(params plugins.Params) []*envoy_config_listener_v3.Filter {
+	var networkFilters []plugins.StagedNetworkFilter
+	for _, plug := range t.networkPlugins {
+		stagedFilters, err := plug.NetworkFiltersTCP(params, t.listener)
+		if err != nil {
+			validation.AppendTCPListenerError(t.report,
+				validationapi.TcpListenerReport_Error_ProcessingError,
+				fmt.Sprintf("listener %s: %s", t.parentListener.GetName(), err.Error()))
+			continue
+		}
+
+		for _, nf := range stagedFilters {
+			if nf.NetworkFilter == nil {
+				continue
+			}
+			networkFilters = append(networkFilters, nf)
+		}
+	}
+
+	return sortNetworkFilters(networkFilters)
+}
+
+// each FilterChain must own its filters, as they may be mutated further down the line
+func cloneNetworkFilters(filters []*envoy_config_listener_v3.Filter) []*envoy_config_listener_v3.Filter {
+	cloned := make([]*envoy_config_listener_v3.Filter, 0, len(filters))
+	for _, f := range filters {
+		cloned = append(cloned, proto.Clone(f).(*envoy_config_listener_v3.Filter))
+	}
+	return cloned
+}
+
 // An httpFilterChainTranslator configures a single set of NetworkFilters
 // and then creates duplicate filter chains for each provided SslConfig.
 type httpFilterChainTranslator struct {
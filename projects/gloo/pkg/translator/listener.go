@@ -6,13 +6,37 @@ import (
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	errors "github.com/rotisserie/eris"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
+const (
+	// QuicListenerSuffix is appended to the name of a listener to name the UDP listener serving HTTP/3 on its behalf
+	QuicListenerSuffix = "-quic"
+
+	// The ALPN negotiated by HTTP/3 clients
+	http3Alpn = "h3"
+)
+
+var (
+	Http3RequiresHttpListenerError = errors.New("http3 is only supported on listeners which serve http traffic")
+	UdpListenerSslError            = errors.New("ssl is not supported on udp listeners")
+	UdpListenerProxyProtocolError  = errors.New("proxy protocol is not supported on udp listeners")
+	Http3RequiresSslError          = func(listenerName string) error {
+		return errors.Errorf("http3 requires TLS, but listener %s has no http filter chains with ssl configured", listenerName)
+	}
+)
+
 type ListenerTranslator interface {
 	// A single Gloo Listener produces a single Envoy listener
 	// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/listeners/listeners#arch-overview-listeners
@@ -21,6 +45,7 @@ type ListenerTranslator interface {
 
 var _ ListenerTranslator = new(emptyListenerTranslator)
 var _ ListenerTranslator = new(listenerTranslatorInstance)
+var _ ListenerTranslator = new(udpListenerTranslatorInstance)
 
 type emptyListenerTranslator struct {
 }
@@ -44,7 +69,7 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 
 	out := &envoy_config_listener_v3.Listener{
 		Name:         l.listener.GetName(),
-		Address:      l.computeListenerAddress(),
+		Address:      computeListenerAddress(l.listener, envoy_config_core_v3.SocketAddress_TCP, l.report),
 		FilterChains: filterChains,
 	}
 
@@ -60,11 +85,203 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 	return out
 }
 
-// computeListenerAddress returns the Address that this listener will listen for traffic
-func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core_v3.Address {
-	_, isIpv4Address, err := IsIpv4Address(l.listener.GetBindAddress())
+// A udpListenerTranslatorInstance produces a single Envoy listener bound to a UDP address.
+// UDP listeners have no FilterChains, the datagrams are proxied by the ListenerFilters produced by the UdpListenerPlugins.
+type udpListenerTranslatorInstance struct {
+	plugins         []plugins.UdpListenerPlugin
+	listenerPlugins []plugins.ListenerPlugin
+	listener        *v1.Listener
+	report          *validationapi.ListenerReport
+	udpReport       *validationapi.UdpListenerReport
+}
+
+func (u *udpListenerTranslatorInstance) ComputeListener(params plugins.Params) *envoy_config_listener_v3.Listener {
+	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_listener."+u.listener.GetName())
+
+	if len(u.listener.GetSslConfigurations()) > 0 {
+		validation.AppendListenerError(u.report,
+			validationapi.ListenerReport_Error_SSLConfigError,
+			UdpListenerSslError.Error())
+	}
+	if u.listener.GetUseProxyProto().GetValue() || u.listener.GetOptions().GetProxyProtocol() != nil {
+		validation.AppendListenerError(u.report,
+			validationapi.ListenerReport_Error_ProcessingError,
+			UdpListenerProxyProtocolError.Error())
+	}
+
+	udpListener := u.listener.GetUdpListener()
+	if err := ValidateUdpDestination(params.Snapshot, udpListener.GetUpstream()); err != nil {
+		validation.AppendUDPListenerError(u.udpReport,
+			validationapi.UdpListenerReport_Error_InvalidDestinationError,
+			fmt.Sprintf("listener %s: %s", u.listener.GetName(), err.Error()))
+		return nil
+	}
+
+	var listenerFilters []*envoy_config_listener_v3.ListenerFilter
+	for _, plug := range u.plugins {
+		pluginListenerFilters, err := plug.CreateUdpListenerFilters(params, u.listener, udpListener)
+		if err != nil {
+			validation.AppendUDPListenerError(u.udpReport,
+				validationapi.UdpListenerReport_Error_ProcessingError,
+				fmt.Sprintf("listener %s: %s", u.listener.GetName(), err.Error()))
+			continue
+		}
+		listenerFilters = append(listenerFilters, pluginListenerFilters...)
+	}
+
+	out := &envoy_config_listener_v3.Listener{
+		Name:            u.listener.GetName(),
+		Address:         computeListenerAddress(u.listener, envoy_config_core_v3.SocketAddress_UDP, u.report),
+		ListenerFilters: listenerFilters,
+	}
+
+	// run the Listener Plugins
+	for _, listenerPlugin := range u.listenerPlugins {
+		if err := listenerPlugin.ProcessListener(params, u.listener, out); err != nil {
+			validation.AppendListenerError(u.report,
+				validationapi.ListenerReport_Error_ProcessingError,
+				err.Error())
+		}
+	}
+
+	return out
+}
+
+// A QuicListenerTranslator produces the UDP listener which serves HTTP/3 on behalf of a Gloo Listener.
+// It is derived from the Envoy listener computed for the same Gloo Listener, so that both listeners
+// share the same filter chains, TLS configuration and routes.
+type QuicListenerTranslator interface {
+	ComputeQuicListener(params plugins.Params, tcpListener *envoy_config_listener_v3.Listener) *envoy_config_listener_v3.Listener
+}
+
+var _ QuicListenerTranslator = new(emptyQuicListenerTranslator)
+var _ QuicListenerTranslator = new(quicListenerTranslatorInstance)
+
+type emptyQuicListenerTranslator struct {
+}
+
+func (e *emptyQuicListenerTranslator) ComputeQuicListener(params plugins.Params, tcpListener *envoy_config_listener_v3.Listener) *envoy_config_listener_v3.Listener {
+	return nil
+}
+
+type quicListenerTranslatorInstance struct {
+	listener *v1.Listener
+	report   *validationapi.ListenerReport
+}
+
+func (q *quicListenerTranslatorInstance) ComputeQuicListener(params plugins.Params, tcpListener *envoy_config_listener_v3.Listener) *envoy_config_listener_v3.Listener {
+	if tcpListener == nil {
+		return nil
+	}
+
+	// Only the secure http filter chains can be served over QUIC
+	var filterChains []*envoy_config_listener_v3.FilterChain
+	for _, filterChain := range tcpListener.GetFilterChains() {
+		quicFilterChain, err := newQuicFilterChain(filterChain)
+		if err != nil {
+			validation.AppendListenerError(q.report,
+				validationapi.ListenerReport_Error_ProcessingError,
+				err.Error())
+			continue
+		}
+		if quicFilterChain != nil {
+			filterChains = append(filterChains, quicFilterChain)
+		}
+	}
+	if len(filterChains) == 0 {
+		validation.AppendListenerError(q.report,
+			validationapi.ListenerReport_Error_SSLConfigError,
+			Http3RequiresSslError(q.listener.GetName()).Error())
+		return nil
+	}
+
+	address := proto.Clone(tcpListener.GetAddress()).(*envoy_config_core_v3.Address)
+	address.GetSocketAddress().Protocol = envoy_config_core_v3.SocketAddress_UDP
+
+	http3 := q.listener.GetHttp3()
+	return &envoy_config_listener_v3.Listener{
+		Name:         q.listener.GetName() + QuicListenerSuffix,
+		Address:      address,
+		FilterChains: filterChains,
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{
+			QuicOptions: &envoy_config_listener_v3.QuicProtocolOptions{
+				QuicProtocolOptions: &envoy_config_core_v3.QuicProtocolOptions{
+					MaxConcurrentStreams:        http3.GetMaxConcurrentStreams(),
+					InitialStreamWindowSize:     http3.GetInitialStreamWindowSize(),
+					InitialConnectionWindowSize: http3.GetInitialConnectionWindowSize(),
+				},
+				IdleTimeout:            http3.GetIdleTimeout(),
+				CryptoHandshakeTimeout: http3.GetCryptoHandshakeTimeout(),
+			},
+		},
+	}
+}
+
+// newQuicFilterChain converts a filter chain terminating TLS in front of an HttpConnectionManager
+// into the equivalent filter chain for a QUIC listener.
+// Returns nil if the filter chain cannot be served over QUIC.
+func newQuicFilterChain(filterChain *envoy_config_listener_v3.FilterChain) (*envoy_config_listener_v3.FilterChain, error) {
+	if filterChain.GetTransportSocket().GetName() != wellknown.TransportSocketTls {
+		return nil, nil
+	}
+
+	out := proto.Clone(filterChain).(*envoy_config_listener_v3.FilterChain)
+	// the connect timeout only applies to TCP connections
+	out.TransportSocketConnectTimeout = nil
+
+	hasHttpConnectionManager := false
+	for _, filter := range out.GetFilters() {
+		if filter.GetName() != wellknown.HTTPConnectionManager {
+			continue
+		}
+		hcm := &envoyhttp.HttpConnectionManager{}
+		if err := ParseTypedConfig(filter, hcm); err != nil {
+			return nil, err
+		}
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		hcm.Http3ProtocolOptions = &envoy_config_core_v3.Http3ProtocolOptions{}
+		typedConfig, err := utils.MessageToAny(hcm)
+		if err != nil {
+			return nil, err
+		}
+		filter.ConfigType = &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: typedConfig}
+		hasHttpConnectionManager = true
+	}
+	if !hasHttpConnectionManager {
+		return nil, nil
+	}
+
+	downstreamTlsContext := &envoyauth.DownstreamTlsContext{}
+	if err := ParseTypedConfig(out.GetTransportSocket(), downstreamTlsContext); err != nil {
+		return nil, err
+	}
+	if downstreamTlsContext.GetCommonTlsContext() == nil {
+		downstreamTlsContext.CommonTlsContext = &envoyauth.CommonTlsContext{}
+	}
+	downstreamTlsContext.GetCommonTlsContext().AlpnProtocols = []string{http3Alpn}
+
+	typedConfig, err := utils.MessageToAny(&envoy_extensions_transport_sockets_quic_v3.QuicDownstreamTransport{
+		DownstreamTlsContext: downstreamTlsContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.TransportSocket = &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketQuic,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+	return out, nil
+}
+
+// computeListenerAddress returns the Address that the listener will listen for traffic
+func computeListenerAddress(
+	listener *v1.Listener,
+	protocol envoy_config_core_v3.SocketAddress_Protocol,
+	report *validationapi.ListenerReport,
+) *envoy_config_core_v3.Address {
+	_, isIpv4Address, err := IsIpv4Address(listener.GetBindAddress())
 	if err != nil {
-		validation.AppendListenerError(l.report,
+		validation.AppendListenerError(report,
 			validationapi.ListenerReport_Error_ProcessingError,
 			err.Error(),
 		)
@@ -73,10 +290,10 @@ func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core
 	return &envoy_config_core_v3.Address{
 		Address: &envoy_config_core_v3.Address_SocketAddress{
 			SocketAddress: &envoy_config_core_v3.SocketAddress{
-				Protocol: envoy_config_core_v3.SocketAddress_TCP,
-				Address:  l.listener.GetBindAddress(),
+				Protocol: protocol,
+				Address:  listener.GetBindAddress(),
 				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
-					PortValue: l.listener.GetBindPort(),
+					PortValue: listener.GetBindPort(),
 				},
 				// As of Envoy 1.22: https://www.envoyproxy.io/docs/envoy/latest/version_history/v1.22/v1.22.0.html
 				// the Ipv4Compat flag can only be set on Ipv6 address and Ipv4-mapped Ipv6 address.
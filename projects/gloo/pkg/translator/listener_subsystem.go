@@ -8,6 +8,7 @@ import (
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
@@ -16,7 +17,7 @@ import (
 // Gloo sends resources to Envoy via xDS. The components of the Listener subsystem that Gloo configures are:
 // 1. Listeners
 // 2. RouteConfiguration
-// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener), and each of these types
+// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener, UdpListener), and each of these types
 // affect how resources are generated, we abstract those implementation details behind abstract translators.
 // The ListenerSubsystemTranslatorFactory returns a ListenerTranslator and RouteConfigurationTranslator for a given Gloo Listener
 type ListenerSubsystemTranslatorFactory struct {
@@ -50,12 +51,37 @@ func (l *ListenerSubsystemTranslatorFactory) GetTranslators(ctx context.Context,
 
 	case *v1.Listener_AggregateListener:
 		return l.GetAggregateListenerTranslators(ctx, proxy, listener, listenerReport)
+
+	case *v1.Listener_UdpListener:
+		return l.GetUdpListenerTranslators(ctx, listener, listenerReport)
 	default:
 		// This case should never occur
 		return &emptyListenerTranslator{}, &emptyRouteConfigurationTranslator{}
 	}
 }
 
+// GetQuicListenerTranslator returns the translator for the UDP listener which serves HTTP/3 for a given Gloo Listener.
+// QUIC listeners are only produced for Gloo Listeners which enable http3. They reuse the secure http filter chains
+// of the Envoy listener produced by the ListenerTranslator, so they are supported on every listener type that serves http traffic.
+func (l *ListenerSubsystemTranslatorFactory) GetQuicListenerTranslator(ctx context.Context, listener *v1.Listener, listenerReport *validationapi.ListenerReport) QuicListenerTranslator {
+	if listener.GetHttp3() == nil {
+		return &emptyQuicListenerTranslator{}
+	}
+
+	switch listener.GetListenerType().(type) {
+	case *v1.Listener_HttpListener, *v1.Listener_HybridListener, *v1.Listener_AggregateListener:
+		return &quicListenerTranslatorInstance{
+			listener: listener,
+			report:   listenerReport,
+		}
+	default:
+		validation.AppendListenerError(listenerReport,
+			validationapi.ListenerReport_Error_ProcessingError,
+			Http3RequiresHttpListenerError.Error())
+		return &emptyQuicListenerTranslator{}
+	}
+}
+
 func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx context.Context, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
 	ListenerTranslator,
 	RouteConfigurationTranslator,
@@ -76,6 +102,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		listener,
 		listener.GetHttpListener(),
 		httpListenerReport,
+		l.pluginRegistry.GetNetworkFilterPlugins(),
 		l.pluginRegistry.GetHttpFilterPlugins(),
 		l.pluginRegistry.GetHttpConnectionManagerPlugins(),
 		routeConfigurationName)
@@ -135,6 +162,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetTcpListenerTranslators(ctx conte
 	// meaning that a single TcpListener produces many FilterChains
 	filterChainTranslator := &tcpFilterChainTranslator{
 		plugins:            l.pluginRegistry.GetTcpFilterChainPlugins(),
+		networkPlugins:     l.pluginRegistry.GetNetworkFilterPlugins(),
 		parentListener:     listener,
 		listener:           listener.GetTcpListener(),
 		report:             tcpListenerReport,
@@ -155,6 +183,31 @@ func (l *ListenerSubsystemTranslatorFactory) GetTcpListenerTranslators(ctx conte
 	return listenerTranslator, routeConfigurationTranslator
 }
 
+func (l *ListenerSubsystemTranslatorFactory) GetUdpListenerTranslators(ctx context.Context, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
+	ListenerTranslator,
+	RouteConfigurationTranslator,
+) {
+	udpListenerReport := listenerReport.GetUdpListenerReport()
+	if udpListenerReport == nil {
+		contextutils.LoggerFrom(ctx).DPanic("internal error: listener report was not udp type")
+	}
+
+	// This translator produces a single Listener
+	// Unlike the other listener types, a UdpListener does not produce FilterChains
+	listenerTranslator := &udpListenerTranslatorInstance{
+		plugins:         l.pluginRegistry.GetUdpListenerPlugins(),
+		listenerPlugins: l.pluginRegistry.GetListenerPlugins(),
+		listener:        listener,
+		report:          listenerReport,
+		udpReport:       udpListenerReport,
+	}
+
+	// A UdpListener does not produce any RouteConfiguration
+	routeConfigurationTranslator := &emptyRouteConfigurationTranslator{}
+
+	return listenerTranslator, routeConfigurationTranslator
+}
+
 func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx context.Context, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
 	ListenerTranslator,
 	RouteConfigurationTranslator,
@@ -194,6 +247,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 				listener,
 				listenerType.HttpListener,
 				httpListenerReport,
+				l.pluginRegistry.GetNetworkFilterPlugins(),
 				l.pluginRegistry.GetHttpFilterPlugins(),
 				l.pluginRegistry.GetHttpConnectionManagerPlugins(),
 				routeConfigurationName)
@@ -231,6 +285,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 			// meaning that a single TcpListener produces many FilterChains
 			filterChainTranslator = &tcpFilterChainTranslator{
 				plugins:            l.pluginRegistry.GetTcpFilterChainPlugins(),
+				networkPlugins:     l.pluginRegistry.GetNetworkFilterPlugins(),
 				parentListener:     listener,
 				listener:           listenerType.TcpListener,
 				report:             hybridListenerReport.GetMatchedListenerReports()[utils.MatchedRouteConfigName(listener, matcher)].GetTcpListenerReport(),
@@ -303,6 +358,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 			listener,
 			httpListener,
 			httpListenerReport,
+			l.pluginRegistry.GetNetworkFilterPlugins(),
 			l.pluginRegistry.GetHttpFilterPlugins(),
 			l.pluginRegistry.GetHttpConnectionManagerPlugins(),
 			routeConfigurationName)
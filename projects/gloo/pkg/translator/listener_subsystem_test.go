@@ -3,9 +3,13 @@ package translator_test
 import (
 	"context"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -18,17 +22,20 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
 	routerV1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/router"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	corsplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
 	hcmplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	udpplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	sslutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	gloovalidation "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	gloohelpers "github.com/solo-io/gloo/test/helpers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 // Allow each test to define its own set of assertions
@@ -545,6 +552,236 @@ var _ = Describe("Listener Subsystem", func() {
 			},
 		),
 	)
+
+	Context("GetQuicListenerTranslator", func() {
+
+		var (
+			listener    *v1.Listener
+			proxy       *v1.Proxy
+			proxyReport *validation.ProxyReport
+			params      plugins.Params
+		)
+
+		BeforeEach(func() {
+			listener = &v1.Listener{
+				Name:        "http-listener",
+				BindAddress: gatewaydefaults.GatewayBindAddress,
+				BindPort:    defaults.HttpsPort,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: []*v1.VirtualHost{{
+							Name: "virtual-host",
+						}},
+					},
+				},
+				SslConfigurations: []*v1.SslConfig{{
+					SniDomains: []string{"sni-domain"},
+					SslSecrets: &v1.SslConfig_SecretRef{
+						SecretRef: createTLSSecret().GetMetadata().Ref(),
+					},
+				}},
+				Http3: &quic.Http3{
+					MaxConcurrentStreams: &wrappers.UInt32Value{Value: 50},
+					IdleTimeout:          &duration.Duration{Seconds: 60},
+					AltSvcMaxAge:         &duration.Duration{Seconds: 3600},
+				},
+			}
+			params = plugins.Params{
+				Ctx: ctx,
+				Snapshot: &gloov1snap.ApiSnapshot{
+					Secrets: v1.SecretList{createTLSSecret()},
+				},
+			}
+		})
+
+		translate := func() (*envoy_config_listener_v3.Listener, []*envoy_config_route_v3.RouteConfiguration) {
+			proxy = &v1.Proxy{
+				Metadata: &core.Metadata{
+					Name:      "proxy",
+					Namespace: defaults.GlooSystem,
+				},
+				Listeners: []*v1.Listener{listener},
+			}
+			proxyReport = gloovalidation.MakeReport(proxy)
+			listenerReport := proxyReport.GetListenerReports()[0]
+
+			listenerTranslator, routeConfigurationTranslator := translatorFactory.GetTranslators(ctx, proxy, listener, listenerReport)
+			routeConfigs := routeConfigurationTranslator.ComputeRouteConfiguration(params)
+			envoyListener := listenerTranslator.ComputeListener(params)
+
+			quicListenerTranslator := translatorFactory.GetQuicListenerTranslator(ctx, listener, listenerReport)
+			return quicListenerTranslator.ComputeQuicListener(params, envoyListener), routeConfigs
+		}
+
+		It("derives a QUIC listener from the secure http filter chains", func() {
+			quicListener, routeConfigs := translate()
+			Expect(gloovalidation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+
+			Expect(quicListener.GetName()).To(Equal("http-listener" + translator.QuicListenerSuffix))
+			Expect(quicListener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+			Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(defaults.HttpsPort))
+			Expect(quicListener.GetUdpListenerConfig().GetQuicOptions()).To(MatchProto(&envoy_config_listener_v3.QuicProtocolOptions{
+				QuicProtocolOptions: &envoy_config_core_v3.QuicProtocolOptions{
+					MaxConcurrentStreams: &wrappers.UInt32Value{Value: 50},
+				},
+				IdleTimeout: &duration.Duration{Seconds: 60},
+			}))
+
+			Expect(quicListener.GetFilterChains()).To(HaveLen(1))
+			filterChain := quicListener.GetFilterChains()[0]
+			Expect(filterChain.GetFilterChainMatch().GetServerNames()).To(ConsistOf("sni-domain"))
+
+			By("with a QUIC transport socket")
+			Expect(filterChain.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketQuic))
+			quicTransport := &envoy_quic_v3.QuicDownstreamTransport{}
+			Expect(translator.ParseTypedConfig(filterChain.GetTransportSocket(), quicTransport)).NotTo(HaveOccurred())
+			Expect(quicTransport.GetDownstreamTlsContext().GetCommonTlsContext().GetAlpnProtocols()).To(ConsistOf("h3"))
+
+			By("with an HTTP/3 hcm network filter")
+			hcm := &envoy_http_connection_manager_v3.HttpConnectionManager{}
+			Expect(translator.ParseTypedConfig(filterChain.GetFilters()[0], hcm)).NotTo(HaveOccurred())
+			Expect(hcm.GetCodecType()).To(Equal(envoy_http_connection_manager_v3.HttpConnectionManager_HTTP3))
+			Expect(hcm.GetHttp3ProtocolOptions()).NotTo(BeNil())
+
+			By("advertising HTTP/3 on the route configuration")
+			Expect(routeConfigs).To(HaveLen(1))
+			Expect(hcm.GetRds().GetRouteConfigName()).To(Equal(routeConfigs[0].GetName()))
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()).To(HaveLen(1))
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()[0].GetHeader()).To(MatchProto(&envoy_config_core_v3.HeaderValue{
+				Key:   "alt-svc",
+				Value: `h3=":8443"; ma=3600`,
+			}))
+		})
+
+		It("advertises the exposed port when it differs from the bind port", func() {
+			listener.GetHttp3().AltSvcPort = &wrappers.UInt32Value{Value: 443}
+			quicListener, routeConfigs := translate()
+			Expect(gloovalidation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+
+			// the QUIC listener is still bound to the port of the listener
+			Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(defaults.HttpsPort))
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()).To(HaveLen(1))
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()[0].GetHeader()).To(MatchProto(&envoy_config_core_v3.HeaderValue{
+				Key:   "alt-svc",
+				Value: `h3=":443"; ma=3600`,
+			}))
+		})
+
+		It("does not advertise HTTP/3 when alt-svc is disabled", func() {
+			listener.GetHttp3().DisableAltSvc = true
+			quicListener, routeConfigs := translate()
+			Expect(gloovalidation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+			Expect(quicListener).NotTo(BeNil())
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()).To(BeEmpty())
+		})
+
+		It("requires TLS", func() {
+			listener.SslConfigurations = nil
+			quicListener, routeConfigs := translate()
+			Expect(quicListener).To(BeNil())
+			Expect(routeConfigs[0].GetResponseHeadersToAdd()).To(BeEmpty())
+
+			proxyErr := gloovalidation.GetProxyError(proxyReport)
+			Expect(proxyErr).To(HaveOccurred())
+			Expect(proxyErr.Error()).To(ContainSubstring(translator.Http3RequiresSslError("http-listener").Error()))
+		})
+
+		It("is not supported on tcp listeners", func() {
+			listener.ListenerType = &v1.Listener_TcpListener{
+				TcpListener: &v1.TcpListener{},
+			}
+			quicListener, _ := translate()
+			Expect(quicListener).To(BeNil())
+
+			proxyErr := gloovalidation.GetProxyError(proxyReport)
+			Expect(proxyErr).To(HaveOccurred())
+			Expect(proxyErr.Error()).To(ContainSubstring(translator.Http3RequiresHttpListenerError.Error()))
+		})
+	})
+
+	Context("GetUdpListenerTranslators", func() {
+
+		var (
+			listener    *v1.Listener
+			proxyReport *validation.ProxyReport
+			params      plugins.Params
+		)
+
+		BeforeEach(func() {
+			pluginRegistry := registry.NewPluginRegistry([]plugins.Plugin{
+				udpplugin.NewPlugin(),
+			})
+			translatorFactory = translator.NewListenerSubsystemTranslatorFactory(pluginRegistry, sslutils.NewSslConfigTranslator())
+
+			listener = &v1.Listener{
+				Name:        "udp-listener",
+				BindAddress: gatewaydefaults.GatewayBindAddress,
+				BindPort:    5353,
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{
+						Upstream: &core.ResourceRef{Name: "dns", Namespace: defaults.GlooSystem},
+					},
+				},
+			}
+			params = plugins.Params{
+				Ctx: ctx,
+				Snapshot: &gloov1snap.ApiSnapshot{
+					Upstreams: v1.UpstreamList{{
+						Metadata: &core.Metadata{Name: "dns", Namespace: defaults.GlooSystem},
+					}},
+				},
+			}
+		})
+
+		translate := func() (*envoy_config_listener_v3.Listener, []*envoy_config_route_v3.RouteConfiguration) {
+			proxy := &v1.Proxy{
+				Metadata: &core.Metadata{
+					Name:      "proxy",
+					Namespace: defaults.GlooSystem,
+				},
+				Listeners: []*v1.Listener{listener},
+			}
+			proxyReport = gloovalidation.MakeReport(proxy)
+			listenerReport := proxyReport.GetListenerReports()[0]
+
+			listenerTranslator, routeConfigurationTranslator := translatorFactory.GetTranslators(ctx, proxy, listener, listenerReport)
+			return listenerTranslator.ComputeListener(params), routeConfigurationTranslator.ComputeRouteConfiguration(params)
+		}
+
+		It("produces a UDP listener with a udp_proxy listener filter", func() {
+			envoyListener, routeConfigs := translate()
+			Expect(gloovalidation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+			Expect(routeConfigs).To(BeEmpty())
+
+			Expect(envoyListener.GetName()).To(Equal("udp-listener"))
+			Expect(envoyListener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+			Expect(envoyListener.GetAddress().GetSocketAddress().GetPortValue()).To(BeEquivalentTo(5353))
+			Expect(envoyListener.GetFilterChains()).To(BeEmpty())
+			Expect(envoyListener.GetListenerFilters()).To(HaveLen(1))
+			Expect(envoyListener.GetListenerFilters()[0].GetName()).To(Equal(udpplugin.UdpProxyFilterName))
+		})
+
+		It("reports missing upstreams", func() {
+			params.Snapshot.Upstreams = nil
+			envoyListener, _ := translate()
+			Expect(envoyListener).To(BeNil())
+
+			udpListenerReport := proxyReport.GetListenerReports()[0].GetUdpListenerReport()
+			Expect(udpListenerReport.GetErrors()).To(HaveLen(1))
+			Expect(udpListenerReport.GetErrors()[0].GetType()).To(Equal(validation.UdpListenerReport_Error_InvalidDestinationError))
+		})
+
+		It("reports ssl configurations", func() {
+			listener.SslConfigurations = []*v1.SslConfig{{
+				SniDomains: []string{"sni-domain"},
+			}}
+			translate()
+
+			proxyErr := gloovalidation.GetProxyError(proxyReport)
+			Expect(proxyErr).To(HaveOccurred())
+			Expect(proxyErr.Error()).To(ContainSubstring(translator.UdpListenerSslError.Error()))
+		})
+	})
 })
 
 func createTLSSecret() *v1.Secret {
@@ -34,6 +34,8 @@ type httpNetworkFilterTranslator struct {
 	listener *v1.HttpListener
 	// The report where warnings/errors are persisted
 	report *validationapi.HttpListenerReport
+	// List of NetworkFilterPlugins to process
+	networkPlugins []plugins.NetworkFilterPlugin
 	// The implementation for generating the HttpConnectionManager NetworkFilter
 	hcmNetworkFilterTranslator *hcmNetworkFilterTranslator
 }
@@ -42,13 +44,15 @@ func NewHttpListenerNetworkFilterTranslator(
 	parentListener *v1.Listener,
 	listener *v1.HttpListener,
 	report *validationapi.HttpListenerReport,
+	networkPlugins []plugins.NetworkFilterPlugin,
 	plugins []plugins.HttpFilterPlugin,
 	hcmPlugins []plugins.HttpConnectionManagerPlugin,
 	routeConfigName string,
 ) *httpNetworkFilterTranslator {
 	return &httpNetworkFilterTranslator{
-		listener: listener,
-		report:   report,
+		listener:       listener,
+		report:         report,
+		networkPlugins: networkPlugins,
 		hcmNetworkFilterTranslator: &hcmNetworkFilterTranslator{
 			parentListener:  parentListener,
 			listener:        listener,
@@ -67,10 +71,20 @@ func (n *httpNetworkFilterTranslator) ComputeNetworkFilters(params plugins.Param
 	}
 
 	var networkFilters []plugins.StagedNetworkFilter
-	// We used to support a ListenerFilterPlugin interface, which was used to generate
-	// a list of NetworkFilters. That plugin wasn't implemented in the codebase so it
-	// was removed. If we want to support other network filters, we would process
-	// those plugins here.
+	for _, plug := range n.networkPlugins {
+		stagedFilters, err := plug.NetworkFiltersHTTP(params, n.listener)
+		if err != nil {
+			validation.AppendHTTPListenerError(n.report, validationapi.HttpListenerReport_Error_ProcessingError, err.Error())
+		}
+
+		for _, nf := range stagedFilters {
+			if nf.NetworkFilter == nil {
+				log.Warnf("plugin %v implements NetworkFiltersHTTP() but returned nil", plug.Name())
+				continue
+			}
+			networkFilters = append(networkFilters, nf)
+		}
+	}
 
 	// Check that we don't refer to nonexistent auth config
 	// TODO (sam-heilbron)
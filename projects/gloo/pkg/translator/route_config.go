@@ -15,6 +15,7 @@ import (
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/api_conversion"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -40,6 +41,7 @@ var (
 	validPathRegexCharacters = "^(?:([A-Za-z0-9/:@._~!$&'()*+,:=;-]*|[%][0-9a-fA-F]{2}))*$"
 
 	NoDestinationSpecifiedError       = errors.New("must specify at least one weighted destination for multi destination routes")
+	NoUpstreamSpecifiedError          = errors.New("must specify an upstream for udp listeners")
 	SubsetsMisconfiguredErr           = errors.New("route has a subset config, but the upstream does not")
 	CompilingRoutePathRegexError      = errors.Errorf("error compiling route path regex: %s", validPathRegexCharacters)
 	ValidRoutePatternError            = errors.Errorf("must only contain valid characters matching pattern %s", validPathRegexCharacters)
@@ -49,6 +51,9 @@ var (
 	PathEndsWithInvalidCharactersError = func(s, invalid string) error {
 		return errors.Errorf("path [%s] cannot end with [%s]", s, invalid)
 	}
+	EmptyStringMatchError = func(kind, name string) error {
+		return errors.Errorf("the prefix, suffix or contains match of the %s matcher [%s] cannot be empty", kind, name)
+	}
 )
 
 var (
@@ -90,6 +95,32 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plug
 		Name:                           h.routeConfigName,
 		VirtualHosts:                   h.computeVirtualHosts(params),
 		MaxDirectResponseBodySizeBytes: h.parentListener.GetRouteOptions().GetMaxDirectResponseBodySizeBytes(),
+		ResponseHeadersToAdd:           h.computeAltSvcHeaders(),
+	}}
+}
+
+// computeAltSvcHeaders advertises HTTP/3 to the clients of a secure listener which also serves HTTP/3,
+// so that they can switch to HTTP/3 for their subsequent requests.
+func (h *httpRouteConfigurationTranslator) computeAltSvcHeaders() []*envoy_config_core_v3.HeaderValueOption {
+	http3 := h.parentListener.GetHttp3()
+	if http3 == nil || http3.GetDisableAltSvc() || !h.requireTlsOnVirtualHosts {
+		return nil
+	}
+
+	maxAge := defaultAltSvcMaxAge
+	if http3.GetAltSvcMaxAge() != nil {
+		maxAge = http3.GetAltSvcMaxAge().AsDuration()
+	}
+	// the port exposed to clients may differ from the bind port, e.g. behind a service
+	port := h.parentListener.GetBindPort()
+	if http3.GetAltSvcPort() != nil {
+		port = http3.GetAltSvcPort().GetValue()
+	}
+	return []*envoy_config_core_v3.HeaderValueOption{{
+		Header: &envoy_config_core_v3.HeaderValue{
+			Key:   altSvcHeader,
+			Value: fmt.Sprintf(`h3=":%d"; ma=%d`, port, int64(maxAge.Seconds())),
+		},
 	}}
 }
 
@@ -207,6 +238,13 @@ func initRoutes(
 				generatedName,
 			)
 		}
+		if err := validateStringMatches(matcher); err != nil {
+			validation.AppendRouteError(routeReport,
+				validationapi.RouteReport_Error_InvalidMatcherError,
+				err.Error(),
+				generatedName,
+			)
+		}
 		match := GlooMatcherToEnvoyMatcher(params.Params.Ctx, matcher)
 		out[i] = &envoy_config_route_v3.Route{
 			Match: &match,
@@ -241,7 +279,7 @@ func validateEnvoyRoute(r *envoy_config_route_v3.Route, routeReport *validationa
 // utility function to transform gloo matcher to envoy route matcher
 func GlooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) envoy_config_route_v3.RouteMatch {
 	match := envoy_config_route_v3.RouteMatch{
-		Headers:         envoyHeaderMatcher(ctx, matcher.GetHeaders()),
+		Headers:         EnvoyHeaderMatcher(ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(ctx, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -549,10 +587,10 @@ func checkThatSubsetMatchesUpstream(params plugins.Params, dest *v1.Destination)
 	if dest.GetSubset() == nil {
 		return nil
 	}
-	if len(dest.GetSubset().GetValues()) == 0 {
+	if len(dest.GetSubset().GetValues()) == 0 && len(dest.GetSubset().GetMetadataKeys()) == 0 {
 		return nil
 	}
-	routeSubset := dest.GetSubset().GetValues()
+	routeSubset := getSubsetKeys(dest.GetSubset())
 
 	ref, err := usconversion.DestinationToUpstreamRef(dest)
 	if err != nil {
@@ -595,6 +633,19 @@ Outerloop:
 	return nil
 }
 
+// getSubsetKeys returns the keys of the static values of the subset, and the keys whose values come from the
+// dynamic metadata of the request
+func getSubsetKeys(subset *v1.Subset) map[string]struct{} {
+	keys := make(map[string]struct{}, len(subset.GetValues())+len(subset.GetMetadataKeys()))
+	for k := range subset.GetValues() {
+		keys[k] = struct{}{}
+	}
+	for _, k := range subset.GetMetadataKeys() {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
+
 func getSubsets(upstream *v1.Upstream) *v1plugins.SubsetSpec {
 
 	specGetter, ok := upstream.GetUpstreamType().(v1.SubsetSpecGetter)
@@ -624,21 +675,97 @@ func setEnvoyPathMatcher(ctx context.Context, in *matchers.Matcher, out *envoy_c
 	}
 }
 
-func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
+// validateStringMatches returns an error if a prefix, suffix or contains match of the header and query parameter
+// matchers is empty, as envoy rejects the whole route configuration with such a match
+func validateStringMatches(matcher *matchers.Matcher) error {
+	for _, header := range matcher.GetHeaders() {
+		var value string
+		switch specifier := header.GetHeaderMatchSpecifier().(type) {
+		case *matchers.HeaderMatcher_PrefixMatch:
+			value = specifier.PrefixMatch
+		case *matchers.HeaderMatcher_SuffixMatch:
+			value = specifier.SuffixMatch
+		case *matchers.HeaderMatcher_ContainsMatch:
+			value = specifier.ContainsMatch
+		default:
+			continue
+		}
+		if value == "" {
+			return EmptyStringMatchError("header", header.GetName())
+		}
+	}
+	for _, queryParameter := range matcher.GetQueryParameters() {
+		var value string
+		switch specifier := queryParameter.GetQueryParameterMatchSpecifier().(type) {
+		case *matchers.QueryParameterMatcher_PrefixMatch:
+			value = specifier.PrefixMatch
+		case *matchers.QueryParameterMatcher_SuffixMatch:
+			value = specifier.SuffixMatch
+		case *matchers.QueryParameterMatcher_ContainsMatch:
+			value = specifier.ContainsMatch
+		default:
+			continue
+		}
+		if value == "" {
+			return EmptyStringMatchError("query parameter", queryParameter.GetName())
+		}
+	}
+	return nil
+}
+
+// EnvoyHeaderMatcher converts gloo header matchers into envoy header matchers
+func EnvoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
 	var out []*envoy_config_route_v3.HeaderMatcher
 	for _, matcher := range in {
 
 		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
 			Name: matcher.GetName(),
 		}
-		if matcher.GetValue() == "" {
+		switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+		case *matchers.HeaderMatcher_PrefixMatch:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: specifier.PrefixMatch},
+					IgnoreCase:   matcher.GetIgnoreCase(),
+				},
+			}
+		case *matchers.HeaderMatcher_SuffixMatch:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: specifier.SuffixMatch},
+					IgnoreCase:   matcher.GetIgnoreCase(),
+				},
+			}
+		case *matchers.HeaderMatcher_ContainsMatch:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Contains{Contains: specifier.ContainsMatch},
+					IgnoreCase:   matcher.GetIgnoreCase(),
+				},
+			}
+		case *matchers.HeaderMatcher_RangeMatch:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_RangeMatch{
+				RangeMatch: api_conversion.ToEnvoyInt64Range(specifier.RangeMatch),
+			}
+		case *matchers.HeaderMatcher_PresentMatch:
 			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
-				PresentMatch: true,
+				PresentMatch: specifier.PresentMatch,
 			}
-		} else {
-			if matcher.GetRegex() {
+		default:
+			if matcher.GetValue() == "" {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+					PresentMatch: true,
+				}
+			} else if matcher.GetRegex() {
 				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
+					SafeRegexMatch: regexutils.NewRegex(ctx, caseInsensitiveRegex(matcher.GetValue(), matcher.GetIgnoreCase())),
+				}
+			} else if matcher.GetIgnoreCase() {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+					StringMatch: &envoy_type_matcher_v3.StringMatcher{
+						MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: matcher.GetValue()},
+						IgnoreCase:   true,
+					},
 				}
 			} else {
 				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
@@ -663,34 +790,50 @@ func envoyQueryMatcher(ctx context.Context, in []*matchers.QueryParameterMatcher
 			Name: matcher.GetName(),
 		}
 
-		if matcher.GetValue() == "" {
-			envoyMatch.QueryParameterMatchSpecifier = &envoy_config_route_v3.QueryParameterMatcher_PresentMatch{
-				PresentMatch: true,
-			}
-		} else {
-			if matcher.GetRegex() {
-				envoyMatch.QueryParameterMatchSpecifier = &envoy_config_route_v3.QueryParameterMatcher_StringMatch{
-					StringMatch: &envoy_type_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
-							SafeRegex: regexutils.NewRegex(ctx, matcher.GetValue()),
-						},
-					},
+		stringMatch := &envoy_type_matcher_v3.StringMatcher{
+			IgnoreCase: matcher.GetIgnoreCase(),
+		}
+		switch specifier := matcher.GetQueryParameterMatchSpecifier().(type) {
+		case *matchers.QueryParameterMatcher_PrefixMatch:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: specifier.PrefixMatch}
+		case *matchers.QueryParameterMatcher_SuffixMatch:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: specifier.SuffixMatch}
+		case *matchers.QueryParameterMatcher_ContainsMatch:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Contains{Contains: specifier.ContainsMatch}
+		default:
+			if matcher.GetValue() == "" {
+				envoyMatch.QueryParameterMatchSpecifier = &envoy_config_route_v3.QueryParameterMatcher_PresentMatch{
+					PresentMatch: true,
+				}
+			} else if matcher.GetRegex() {
+				stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+					SafeRegex: regexutils.NewRegex(ctx, caseInsensitiveRegex(matcher.GetValue(), matcher.GetIgnoreCase())),
 				}
 			} else {
-				envoyMatch.QueryParameterMatchSpecifier = &envoy_config_route_v3.QueryParameterMatcher_StringMatch{
-					StringMatch: &envoy_type_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{
-							Exact: matcher.GetValue(),
-						},
-					},
+				stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{
+					Exact: matcher.GetValue(),
 				}
 			}
 		}
+
+		if stringMatch.GetMatchPattern() != nil {
+			envoyMatch.QueryParameterMatchSpecifier = &envoy_config_route_v3.QueryParameterMatcher_StringMatch{
+				StringMatch: stringMatch,
+			}
+		}
 		out = append(out, envoyMatch)
 	}
 	return out
 }
 
+// envoy ignores the ignore_case setting of regex string matchers, so the regex itself is made case-insensitive
+func caseInsensitiveRegex(regex string, ignoreCase bool) string {
+	if !ignoreCase {
+		return regex
+	}
+	return "(?i)" + regex
+}
+
 // returns an error if any of the virtualhost domains overlap
 // Visible for testing
 func ValidateVirtualHostDomains(virtualHosts []*v1.VirtualHost, httpListenerReport *validationapi.HttpListenerReport) {
@@ -772,6 +915,17 @@ func ValidateTcpRouteDestinations(snap *v1snap.ApiSnapshot, action *v1.TcpHost_T
 	return errors.Errorf("must specify either 'singleDestination', 'multipleDestinations', 'upstreamGroup' or 'forwardSniClusterName' for action")
 }
 
+// ValidateUdpDestination checks that the upstream targeted by a UdpListener exists
+func ValidateUdpDestination(snap *v1snap.ApiSnapshot, upstreamRef *core.ResourceRef) error {
+	if upstreamRef == nil {
+		return NoUpstreamSpecifiedError
+	}
+	if _, err := snap.Upstreams.Find(upstreamRef.Strings()); err != nil {
+		return pluginutils.NewUpstreamNotFoundErr(*upstreamRef)
+	}
+	return nil
+}
+
 func validateUpstreamGroup(snap *v1snap.ApiSnapshot, ref *core.ResourceRef) error {
 
 	upstreamGroup, err := snap.UpstreamGroups.Find(ref.GetNamespace(), ref.GetName())
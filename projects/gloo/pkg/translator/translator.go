@@ -144,6 +144,9 @@ func (t *translatorInstance) translateClusterSubsystemComponents(params plugins.
 	logger.Debugf("computing envoy endpoints for proxy: %v", proxy.GetMetadata().GetName())
 
 	endpoints := t.computeClusterEndpoints(params, upstreamRefKeyToEndpoints, reports)
+	if localClusterEndpoints := computeLocalClusterEndpoints(params, proxy, upstreamRefKeyToEndpoints, reports); localClusterEndpoints != nil {
+		endpoints = append(endpoints, localClusterEndpoints)
+	}
 
 	upstreamMap := make(map[string]struct{}, len(params.Snapshot.Upstreams))
 	// make sure to call EndpointPlugin with empty endpoint
@@ -244,6 +247,13 @@ func (t *translatorInstance) translateListenerSubsystemComponents(params plugins
 			if len(envoyRouteConfiguration) > 0 {
 				routeConfigs = append(routeConfigs, envoyRouteConfiguration...)
 			}
+
+			// 3. Compute the QUIC Listener, if HTTP/3 is enabled
+			// It shares the RouteConfiguration of the Listener it is derived from
+			quicListenerTranslator := t.listenerTranslatorFactory.GetQuicListenerTranslator(params.Ctx, listener, listenerReport)
+			if quicListener := quicListenerTranslator.ComputeQuicListener(params, envoyListener); quicListener != nil {
+				listeners = append(listeners, quicListener)
+			}
 		}
 	}
 
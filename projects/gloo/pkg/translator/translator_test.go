@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/onsi/ginkgo/extensions/table"
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
@@ -25,6 +26,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_network_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
@@ -40,6 +42,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	gloo_envoy_core "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	envoytype_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -52,6 +55,7 @@ import (
 	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
@@ -852,6 +856,84 @@ var _ = Describe("Translator", func() {
 			Expect(headerMatch.InvertMatch).To(Equal(true))
 		})
 
+		It("should translate header matcher with prefix and ignore case to a case-insensitive string match", func() {
+
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:                 "test",
+					HeaderMatchSpecifier: &matchers.HeaderMatcher_PrefixMatch{PrefixMatch: "Test"},
+					IgnoreCase:           true,
+				},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetStringMatch()).To(MatchProto(&envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "Test"},
+				IgnoreCase:   true,
+			}))
+		})
+
+		It("should translate header matcher with contains to a string match", func() {
+
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:                 "test",
+					HeaderMatchSpecifier: &matchers.HeaderMatcher_ContainsMatch{ContainsMatch: "value"},
+				},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetStringMatch()).To(MatchProto(&envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Contains{Contains: "value"},
+			}))
+		})
+
+		It("should translate header matcher with range to a range match", func() {
+
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:                 "test",
+					HeaderMatchSpecifier: &matchers.HeaderMatcher_RangeMatch{RangeMatch: &envoytype_gloo.Int64Range{Start: 1, End: 10}},
+				},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetRangeMatch()).To(MatchProto(&envoy_type_v3.Int64Range{Start: 1, End: 10}))
+		})
+
+		It("should translate header matcher with present match false to an absent match", func() {
+
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:                 "test",
+					HeaderMatchSpecifier: &matchers.HeaderMatcher_PresentMatch{PresentMatch: false},
+				},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetHeaderMatchSpecifier()).To(Equal(&envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: false}))
+		})
+
+		It("should translate header matcher with regex and ignore case to a case-insensitive regex", func() {
+
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:       "test",
+					Value:      "test.*",
+					Regex:      true,
+					IgnoreCase: true,
+				},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetSafeRegexMatch().GetRegex()).To(Equal("(?i)test.*"))
+		})
+
 		It("should default to '/' prefix matcher if none is provided", func() {
 			matcher = nil
 			translate()
@@ -901,6 +983,83 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("route query parameter match", func() {
+		It("should translate query parameter matcher with suffix and ignore case to a case-insensitive string match", func() {
+
+			matcher.QueryParameters = []*matchers.QueryParameterMatcher{
+				{
+					Name:                         "test",
+					QueryParameterMatchSpecifier: &matchers.QueryParameterMatcher_SuffixMatch{SuffixMatch: "Value"},
+					IgnoreCase:                   true,
+				},
+			}
+			translate()
+
+			queryMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.QueryParameters[0]
+			Expect(queryMatch.GetName()).To(Equal("test"))
+			Expect(queryMatch.GetStringMatch()).To(MatchProto(&envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: "Value"},
+				IgnoreCase:   true,
+			}))
+		})
+
+		It("should translate query parameter matcher with value and ignore case to a case-insensitive exact match", func() {
+
+			matcher.QueryParameters = []*matchers.QueryParameterMatcher{
+				{
+					Name:       "test",
+					Value:      "Value",
+					IgnoreCase: true,
+				},
+			}
+			translate()
+
+			queryMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.QueryParameters[0]
+			Expect(queryMatch.GetStringMatch()).To(MatchProto(&envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "Value"},
+				IgnoreCase:   true,
+			}))
+		})
+
+		It("should translate query parameter matcher with no value to a present match", func() {
+
+			matcher.QueryParameters = []*matchers.QueryParameterMatcher{
+				{
+					Name: "test",
+				},
+			}
+			translate()
+
+			queryMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.QueryParameters[0]
+			Expect(queryMatch.GetPresentMatch()).To(BeTrue())
+		})
+
+		It("should error when a string match is empty", func() {
+			invalidMatcherName := fmt.Sprintf("%s-route-0", virtualHostName)
+
+			matcher.QueryParameters = []*matchers.QueryParameterMatcher{
+				{
+					Name:                         "test",
+					QueryParameterMatchSpecifier: &matchers.QueryParameterMatcher_SuffixMatch{},
+				},
+			}
+			_, errs, _ := translator.Translate(params, proxy)
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(fmt.Sprintf("Route Error: InvalidMatcherError. Reason: %s. Route Name: %s",
+				EmptyStringMatchError("query parameter", "test"), invalidMatcherName))))
+
+			matcher.QueryParameters = nil
+			matcher.Headers = []*matchers.HeaderMatcher{
+				{
+					Name:                 "test",
+					HeaderMatchSpecifier: &matchers.HeaderMatcher_ContainsMatch{},
+				},
+			}
+			_, errs, _ = translator.Translate(params, proxy)
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(fmt.Sprintf("Route Error: InvalidMatcherError. Reason: %s. Route Name: %s",
+				EmptyStringMatchError("header", "test"), invalidMatcherName))))
+		})
+	})
+
 	Context("non route_routeaction routes", func() {
 		var options *v1.RouteOptions
 		BeforeEach(func() {
@@ -1303,6 +1462,44 @@ var _ = Describe("Translator", func() {
 			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
 		})
 
+		It("should translate retry budget on upstream", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent:       &wrappers.DoubleValue{Value: 25},
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+				},
+			}
+
+			expectedCircuitBreakers := &envoy_config_cluster_v3.CircuitBreakers{
+				Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+					{
+						RetryBudget: &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+							BudgetPercent:       &envoy_type_v3.Percent{Value: 25},
+							MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+						},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
+		})
+
+		It("should error on an invalid retry budget", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent: &wrappers.DoubleValue{Value: 150},
+				},
+			}
+
+			_, errs, _ := translator.Translate(params, proxy)
+			err := errs.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cluster was configured improperly"))
+		})
+
 		It("should override circuit breakers on upstream", func() {
 
 			settings.Gloo = &v1.GlooOptions{}
@@ -1699,6 +1896,128 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group the endpoints by locality and set their health status", func() {
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints[0].Locality = &v1.Locality{Zone: "us-east-1a"}
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints,
+				&v1.Endpoint{
+					Metadata:     &core.Metadata{Name: "test-draining", Namespace: "gloo-system"},
+					Upstreams:    []*core.ResourceRef{ref},
+					Address:      "1.2.3.5",
+					Port:         1234,
+					Locality:     &v1.Locality{Zone: "us-east-1a"},
+					HealthStatus: v3.HealthStatus_DRAINING,
+				},
+				&v1.Endpoint{
+					Metadata:  &core.Metadata{Name: "test-other-zone", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.6",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "us-east-1b"},
+				},
+			)
+			translate()
+
+			endpoints := snapshot.GetResources(types.EndpointTypeV3)
+			claConfiguration = endpoints.Items[getEndpointClusterName(upstream)].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(2))
+
+			Expect(claConfiguration.Endpoints[0].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Zone: "us-east-1a"}))
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_UNKNOWN))
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()[1].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_DRAINING))
+
+			Expect(claConfiguration.Endpoints[1].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Zone: "us-east-1b"}))
+			Expect(claConfiguration.Endpoints[1].GetLbEndpoints()).To(HaveLen(1))
+		})
+
+		It("should weigh the localities by their endpoints with locality weighted load balancing", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{LocalityWeightedLbConfig: &empty.Empty{}},
+			}
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints,
+				&v1.Endpoint{
+					Metadata:  &core.Metadata{Name: "test-other-zone", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{upstream.Metadata.Ref()},
+					Address:   "1.2.3.6",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "us-east-1b"},
+				},
+			)
+			translate()
+
+			endpoints := snapshot.GetResources(types.EndpointTypeV3)
+			claConfiguration = endpoints.Items[getEndpointClusterName(upstream)].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[0].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(1))
+			Expect(claConfiguration.Endpoints[1].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(1))
+		})
+
+		It("should send an endpoint which lists the upstream several times once", func() {
+			params.Snapshot.Endpoints[0].Upstreams = append(params.Snapshot.Endpoints[0].Upstreams, upstream.Metadata.Ref())
+			translate()
+
+			endpoints := snapshot.GetResources(types.EndpointTypeV3)
+			claConfiguration = endpoints.Items[getEndpointClusterName(upstream)].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[0].GetLoadBalancingWeight()).To(BeNil())
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()).To(HaveLen(1))
+		})
+
+		Context("local cluster", func() {
+
+			BeforeEach(func() {
+				upstream.PreferSameZone = &v1.PreferSameZone{}
+			})
+
+			It("should group the endpoints of the service of the proxy by zone", func() {
+				// the service of the proxy is named after it
+				upstream.UpstreamType = &v1.Upstream_Kube{
+					Kube: &v1kubernetes.UpstreamSpec{
+						ServiceName:      proxy.GetMetadata().GetName(),
+						ServiceNamespace: proxy.GetMetadata().GetNamespace(),
+					},
+				}
+				params.Snapshot.Endpoints[0].Locality = &v1.Locality{Zone: "us-east-1a"}
+
+				snap, reports, _ := translator.Translate(params, proxy)
+				Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+				endpoints := snap.GetResources(types.EndpointTypeV3)
+				Expect(endpoints.Items).To(HaveKey(LocalClusterName))
+				claConfiguration = endpoints.Items[LocalClusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+				Expect(claConfiguration.ClusterName).To(Equal(LocalClusterName))
+				Expect(claConfiguration.Endpoints).To(HaveLen(1))
+				Expect(claConfiguration.Endpoints[0].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Zone: "us-east-1a"}))
+				Expect(claConfiguration.Endpoints[0].GetLbEndpoints()).To(HaveLen(1))
+			})
+
+			It("should be empty, and warn the upstreams that prefer the same zone, without the service of the proxy", func() {
+				snap, reports, _ := translator.Translate(params, proxy)
+				Expect(reports.Validate()).NotTo(HaveOccurred())
+				Expect(reports[upstream].Warnings).To(ConsistOf(NoZonedLocalClusterWarning(proxy)))
+
+				endpoints := snap.GetResources(types.EndpointTypeV3)
+				Expect(endpoints.Items).To(HaveKey(LocalClusterName))
+				claConfiguration = endpoints.Items[LocalClusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+				Expect(claConfiguration.Endpoints).To(BeEmpty())
+			})
+
+			It("should not be sent, nor warn, when the proxy does not route to upstreams that prefer the same zone", func() {
+				upstream.PreferSameZone = nil
+				unrouted := &v1.Upstream{
+					Metadata:       &core.Metadata{Name: "unrouted", Namespace: "gloo-system"},
+					UpstreamType:   &v1.Upstream_Static{Static: &v1static.UpstreamSpec{Hosts: []*v1static.Host{{Addr: "1.2.3.4", Port: 80}}}},
+					PreferSameZone: &v1.PreferSameZone{},
+				}
+				params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, unrouted)
+
+				snap, reports, _ := translator.Translate(params, proxy)
+				Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+				Expect(snap.GetResources(types.EndpointTypeV3).Items).NotTo(HaveKey(LocalClusterName))
+			})
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -1827,6 +2146,30 @@ var _ = Describe("Translator", func() {
 				Expect(fields).To(HaveKey("testkey"))
 				Expect(fields["testkey"]).To(MatchProto(sv("testvalue")))
 			})
+
+			It("should accept subset keys from the request metadata", func() {
+				routes[0].GetRouteAction().GetSingle().Subset = &v1.Subset{
+					MetadataKeys: []string{"testkey"},
+				}
+				translateWithEndpoints()
+
+				Expect(routeConfiguration.VirtualHosts[0].Routes[0].GetRoute().GetMetadataMatch()).To(BeNil())
+			})
+
+			It("should keep the values of the subset keys which can come from the request metadata", func() {
+				routes[0].GetRouteAction().GetSingle().Subset.MetadataKeys = []string{"testkey"}
+				translateWithEndpoints()
+
+				metadataMatch := routeConfiguration.VirtualHosts[0].Routes[0].GetRoute().GetMetadataMatch()
+				fields := metadataMatch.FilterMetadata["envoy.lb"].Fields
+				Expect(fields["testkey"]).To(MatchProto(sv("testvalue")))
+			})
+		})
+
+		It("should error the route when the subset keys from the request metadata do not match the upstream", func() {
+			routes[0].GetRouteAction().GetSingle().Subset.MetadataKeys = []string{"tenant"}
+			_, errs, _ := translator.Translate(params, proxy)
+			Expect(errs.Validate()).To(MatchError(ContainSubstring("route has a subset config, but none of the subsets in the upstream match it")))
 		})
 
 		It("should create empty value if missing labels on the endpoint are provided in the upstream", func() {
@@ -2637,6 +2980,33 @@ var _ = Describe("Translator", func() {
 			Expect(clusterSpec).To(Equal("test_gloo-system"))
 			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
 		})
+
+		It("adds the local rate limit network filter in front of the tcp proxy", func() {
+			proxy.GetListeners()[1].GetTcpListener().Options = &v1.TcpListenerOptions{
+				LocalRatelimit: &local_ratelimit.NetworkLocalRateLimit{
+					TokenBucket: &local_ratelimit.TokenBucket{
+						MaxTokens:    10,
+						FillInterval: &duration.Duration{Seconds: 1},
+					},
+				},
+			}
+			translate()
+			listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+			val, found := listeners["tcp-listener"]
+			Expect(found).To(BeTrue())
+			listener, ok := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(ok).To(BeTrue())
+			Expect(listener.GetFilterChains()).To(HaveLen(1))
+			fc := listener.GetFilterChains()[0]
+			Expect(fc.Filters).To(HaveLen(2))
+
+			var rateLimitCfg envoy_network_local_ratelimit_v3.LocalRateLimit
+			Expect(fc.Filters[0].GetName()).To(Equal("envoy.filters.network.local_ratelimit"))
+			Expect(ParseTypedConfig(fc.Filters[0], &rateLimitCfg)).NotTo(HaveOccurred())
+			Expect(rateLimitCfg.GetTokenBucket().GetMaxTokens()).To(Equal(uint32(10)))
+
+			Expect(fc.Filters[1].GetName()).To(Equal(wellknown.TCPProxy))
+		})
 	})
 
 	Context("Hybrid", func() {
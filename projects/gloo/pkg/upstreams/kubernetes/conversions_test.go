@@ -56,4 +56,26 @@ var _ = Describe("Conversions", func() {
 		Expect(usList[1].GetKube().ServiceNamespace).To(Equal("ns-1"))
 		Expect(usList[1].GetKube().ServicePort).To(BeEquivalentTo(8081))
 	})
+
+	It("converts ExternalName services to static upstreams", func() {
+		svc := skkube.NewService("ns-1", "svc-1")
+		svc.Spec = corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: "svc-1.example.com",
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "port-1",
+					Port:       8080,
+					TargetPort: intstr.FromInt(8080),
+				},
+			},
+		}
+		usList := KubeServicesToUpstreams(context.TODO(), skkube.ServiceList{svc})
+		Expect(usList).To(HaveLen(1))
+		Expect(usList[0].Metadata.Name).To(Equal(upstreamNamePrefix + "ns-1-svc-1-8080"))
+		Expect(usList[0].GetKube()).To(BeNil())
+		Expect(usList[0].GetStatic().GetHosts()).To(HaveLen(1))
+		Expect(usList[0].GetStatic().GetHosts()[0].GetAddr()).To(Equal("svc-1.example.com"))
+		Expect(usList[0].GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(8080))
+	})
 })
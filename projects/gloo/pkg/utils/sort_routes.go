@@ -18,6 +18,10 @@ import (
 // Matchers sort according to the following rules:
 // 1. exact path < regex path < prefix path
 // 2. lexicographically greater path string < lexicographically smaller path string
+// 3. matchers using the prefix, suffix, contains, range, presence or case-insensitive header and query parameter
+// matches < other matchers, whose order is kept so that existing routes match as before
+// 4. among the former, more header and query parameter matchers < fewer header and query parameter matchers
+// 5. among the former, more specific header and query parameter matchers < less specific ones (see parameterMatchersPriority)
 func SortRoutesByPath(routes []*v1.Route) {
 	sort.SliceStable(routes, func(i, j int) bool {
 		smallest1 := getSmallestOrDefaultMatcher(routes[i].GetMatchers())
@@ -54,8 +58,112 @@ func lessMatcher(m1, m2 *matchers.Matcher) bool {
 	if pathTypePriority(m1) != pathTypePriority(m2) {
 		return pathTypePriority(m1) < pathTypePriority(m2)
 	}
+	if PathAsString(m1) != PathAsString(m2) {
+		return PathAsString(m1) > PathAsString(m2)
+	}
 	// all else being equal
-	return PathAsString(m1) > PathAsString(m2)
+	extended1, extended2 := usesExtendedParameterMatchers(m1), usesExtendedParameterMatchers(m2)
+	if extended1 != extended2 {
+		return extended1
+	}
+	return extended1 && lessParameterMatchers(m1, m2)
+}
+
+// returns true if any header or query parameter matcher of the matcher uses a prefix, suffix, contains, range or
+// presence match, or is case-insensitive
+func usesExtendedParameterMatchers(m *matchers.Matcher) bool {
+	for _, h := range m.GetHeaders() {
+		if h.GetHeaderMatchSpecifier() != nil || h.GetIgnoreCase() {
+			return true
+		}
+	}
+	for _, q := range m.GetQueryParameters() {
+		if q.GetQueryParameterMatchSpecifier() != nil || q.GetIgnoreCase() {
+			return true
+		}
+	}
+	return false
+}
+
+func lessParameterMatchers(m1, m2 *matchers.Matcher) bool {
+	count1 := len(m1.GetHeaders()) + len(m1.GetQueryParameters())
+	count2 := len(m2.GetHeaders()) + len(m2.GetQueryParameters())
+	if count1 != count2 {
+		return count1 > count2
+	}
+	priority1, ignoreCase1 := parameterMatchersPriority(m1)
+	priority2, ignoreCase2 := parameterMatchersPriority(m2)
+	if priority1 != priority2 {
+		return priority1 < priority2
+	}
+	return ignoreCase1 < ignoreCase2
+}
+
+// returns the sum of the priorities of the header and query parameter matchers of the matcher,
+// and the number of them which are case-insensitive
+func parameterMatchersPriority(m *matchers.Matcher) (int, int) {
+	var priority, ignoreCase int
+	for _, h := range m.GetHeaders() {
+		priority += headerMatchPriority(h)
+		if h.GetIgnoreCase() {
+			ignoreCase++
+		}
+	}
+	for _, q := range m.GetQueryParameters() {
+		priority += queryParameterMatchPriority(q)
+		if q.GetIgnoreCase() {
+			ignoreCase++
+		}
+	}
+	return priority, ignoreCase
+}
+
+const (
+	// order matters here. iota assigns each const = 0, 1, 2 etc.
+	parameterPriorityExact = iota
+	parameterPriorityRange
+	parameterPrioritySubstring
+	parameterPriorityRegex
+	parameterPriorityPresent
+	parameterPriorityInverted
+)
+
+func headerMatchPriority(h *matchers.HeaderMatcher) int {
+	if h.GetInvertMatch() {
+		return parameterPriorityInverted
+	}
+	switch specifier := h.GetHeaderMatchSpecifier().(type) {
+	case *matchers.HeaderMatcher_RangeMatch:
+		return parameterPriorityRange
+	case *matchers.HeaderMatcher_PrefixMatch, *matchers.HeaderMatcher_SuffixMatch, *matchers.HeaderMatcher_ContainsMatch:
+		return parameterPrioritySubstring
+	case *matchers.HeaderMatcher_PresentMatch:
+		if !specifier.PresentMatch {
+			return parameterPriorityInverted
+		}
+		return parameterPriorityPresent
+	}
+	switch {
+	case h.GetValue() == "":
+		return parameterPriorityPresent
+	case h.GetRegex():
+		return parameterPriorityRegex
+	}
+	return parameterPriorityExact
+}
+
+func queryParameterMatchPriority(q *matchers.QueryParameterMatcher) int {
+	switch q.GetQueryParameterMatchSpecifier().(type) {
+	case *matchers.QueryParameterMatcher_PrefixMatch, *matchers.QueryParameterMatcher_SuffixMatch, *matchers.QueryParameterMatcher_ContainsMatch:
+		return parameterPrioritySubstring
+	}
+	switch {
+	case q.GetValue() == "":
+		return parameterPriorityPresent
+	case q.GetRegex():
+		return parameterPriorityRegex
+	}
+	return parameterPriorityExact
 }
 
 const (
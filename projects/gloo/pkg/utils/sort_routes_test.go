@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"time"
 
+	envoytype_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/test/helpers"
 
@@ -232,4 +233,43 @@ var _ = Describe("PathAsString", func() {
 		SortRoutesByPath(routes)
 		Expect(routes).To(Equal(sortedRoutes))
 	})
+
+	It("with equal paths, routes using the extended header and query parameter matchers are sorted first, by specificity", func() {
+		makeRoute := func(headers []*matchers.HeaderMatcher, queryParameters []*matchers.QueryParameterMatcher) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/foo"},
+					Headers:         headers,
+					QueryParameters: queryParameters,
+				}},
+			}
+		}
+		twoMatchers := makeRoute(
+			[]*matchers.HeaderMatcher{{Name: "a", Value: "b", Regex: true}},
+			[]*matchers.QueryParameterMatcher{{Name: "c"}},
+		)
+		exact := makeRoute([]*matchers.HeaderMatcher{{Name: "a", Value: "b"}}, nil)
+		exactIgnoreCase := makeRoute([]*matchers.HeaderMatcher{{Name: "a", Value: "b", IgnoreCase: true}}, nil)
+		rangeMatch := makeRoute([]*matchers.HeaderMatcher{{
+			Name:                 "a",
+			HeaderMatchSpecifier: &matchers.HeaderMatcher_RangeMatch{RangeMatch: &envoytype_gloo.Int64Range{Start: 1, End: 2}},
+		}}, nil)
+		prefix := makeRoute(nil, []*matchers.QueryParameterMatcher{{
+			Name:                         "a",
+			QueryParameterMatchSpecifier: &matchers.QueryParameterMatcher_PrefixMatch{PrefixMatch: "b"},
+		}})
+		regex := makeRoute([]*matchers.HeaderMatcher{{Name: "a", Value: "b", Regex: true}}, nil)
+		present := makeRoute([]*matchers.HeaderMatcher{{Name: "a"}}, nil)
+		absent := makeRoute([]*matchers.HeaderMatcher{{
+			Name:                 "a",
+			HeaderMatchSpecifier: &matchers.HeaderMatcher_PresentMatch{PresentMatch: false},
+		}}, nil)
+		none := makeRoute(nil, nil)
+
+		// the order of the other routes is kept, as it was before the extended matchers were introduced
+		sortedRoutes := []*v1.Route{exactIgnoreCase, rangeMatch, prefix, absent, none, present, regex, exact, twoMatchers}
+		routes := []*v1.Route{none, absent, present, regex, prefix, rangeMatch, exactIgnoreCase, exact, twoMatchers}
+		SortRoutesByPath(routes)
+		Expect(routes).To(Equal(sortedRoutes))
+	})
 })
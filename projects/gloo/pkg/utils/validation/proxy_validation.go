@@ -39,6 +39,12 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 					},
 				},
 			}
+		case *v1.Listener_UdpListener:
+			listenerReports[i] = &validation.ListenerReport{
+				ListenerTypeReport: &validation.ListenerReport_UdpListenerReport{
+					UdpListenerReport: &validation.UdpListenerReport{},
+				},
+			}
 		case *v1.Listener_HybridListener:
 			matchedListenerReports := make(map[string]*validation.MatchedListenerReport)
 			for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
@@ -189,6 +195,14 @@ func GetTcpHostErr(tcpHost *validation.TcpHostReport) []error {
 	return errs
 }
 
+func GetUdpListenerErr(udpListener *validation.UdpListenerReport) []error {
+	var errs []error
+	for _, errReport := range udpListener.GetErrors() {
+		errs = append(errs, mkErr("UdpListener", errReport.GetType().String(), errReport.GetReason()))
+	}
+	return errs
+}
+
 func GetProxyError(proxyRpt *validation.ProxyReport) error {
 	var errs []error
 	for _, listener := range proxyRpt.GetListenerReports() {
@@ -202,6 +216,9 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 		case *validation.ListenerReport_TcpListenerReport:
 			errs = append(errs, getTcpListenerReportErrs(listenerType.TcpListenerReport)...)
 
+		case *validation.ListenerReport_UdpListenerReport:
+			errs = append(errs, GetUdpListenerErr(listenerType.UdpListenerReport)...)
+
 		case *validation.ListenerReport_HybridListenerReport:
 			for _, mr := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
 				switch lrt := mr.GetListenerReportType().(type) {
@@ -307,6 +324,13 @@ func AppendTCPListenerError(httpListenerReport *validation.TcpListenerReport, er
 	})
 }
 
+func AppendUDPListenerError(udpListenerReport *validation.UdpListenerReport, errType validation.UdpListenerReport_Error_Type, reason string) {
+	udpListenerReport.Errors = append(udpListenerReport.GetErrors(), &validation.UdpListenerReport_Error{
+		Type:   errType,
+		Reason: reason,
+	})
+}
+
 func AppendRouteError(routeReport *validation.RouteReport, errType validation.RouteReport_Error_Type, reason string, routeName string) {
 	routeReport.Errors = append(routeReport.GetErrors(), &validation.RouteReport_Error{
 		Type:   errType,
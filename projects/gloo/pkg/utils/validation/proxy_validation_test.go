@@ -145,6 +145,28 @@ var _ = Describe("validation utils", func() {
 
 		})
 
+		It("generates a report which matches a udp proxy", func() {
+
+			proxy := &v1.Proxy{
+				Listeners: []*v1.Listener{{
+					ListenerType: &v1.Listener_UdpListener{
+						UdpListener: &v1.UdpListener{},
+					},
+				}},
+			}
+
+			rpt := MakeReport(proxy)
+			Expect(rpt.ListenerReports).To(HaveLen(1))
+			Expect(rpt.ListenerReports[0].GetUdpListenerReport()).NotTo(BeNil())
+
+			AppendUDPListenerError(rpt.ListenerReports[0].GetUdpListenerReport(),
+				validation.UdpListenerReport_Error_InvalidDestinationError,
+				"upstream not found")
+			err := GetProxyError(rpt)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("UdpListener Error: InvalidDestinationError. Reason: upstream not found"))
+		})
+
 		It("generates a report which matches a hybrid proxy", func() {
 
 			proxy := makeHybridProxy()
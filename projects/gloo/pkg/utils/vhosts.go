@@ -11,7 +11,7 @@ func GetVirtualHostsForListener(listener *v1.Listener) []*v1.VirtualHost {
 	switch typedListener := listener.GetListenerType().(type) {
 	case *v1.Listener_HttpListener:
 		virtualHosts = typedListener.HttpListener.GetVirtualHosts()
-	case *v1.Listener_TcpListener:
+	case *v1.Listener_TcpListener, *v1.Listener_UdpListener:
 		// VirtualHosts are an http-only concept
 		break
 	case *v1.Listener_HybridListener:
@@ -37,7 +37,7 @@ func GetVhostReportsFromListenerReport(listenerReport *validation.ListenerReport
 	switch listenerReportType := listenerReport.GetListenerTypeReport().(type) {
 	case *validation.ListenerReport_HttpListenerReport:
 		virtualHostReports = listenerReportType.HttpListenerReport.GetVirtualHostReports()
-	case *validation.ListenerReport_TcpListenerReport:
+	case *validation.ListenerReport_TcpListenerReport, *validation.ListenerReport_UdpListenerReport:
 		// VirtualHosts are an http-only concept
 		break
 	case *validation.ListenerReport_HybridListenerReport: